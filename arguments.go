@@ -258,9 +258,11 @@ func This[T any](v T, constraints ...Constraint[T]) ValidatorArgument {
 // ValidatorArgument is common implementation of [Argument] that is used to run validation
 // process on given argument.
 type ValidatorArgument struct {
-	isIgnored bool
-	validate  ValidateFunc
-	path      []PropertyPathElement
+	isIgnored   bool
+	isSensitive bool
+	label       string
+	validate    ValidateFunc
+	path        []PropertyPathElement
 }
 
 // At returns a copy of [ValidatorArgument] with appended property path suffix.
@@ -276,8 +278,38 @@ func (arg ValidatorArgument) When(condition bool) ValidatorArgument {
 	return arg
 }
 
+// Sensitive marks the argument as sensitive. Invalid values of violations produced by the argument
+// and values of the "{{ value }}" and "{{ comparedValue }}" template parameters are replaced
+// by [RedactedValue].
+// It is useful to prevent leaking of passwords and tokens into messages and logs.
+func (arg ValidatorArgument) Sensitive() ValidatorArgument {
+	arg.isSensitive = true
+	return arg
+}
+
+// WithLabel sets a human-readable label of the validated property. The label is translated
+// by the [Translator] and injected into violation messages via the "{{ label }}" template parameter.
+// It is applied only to the violations at the property path of the argument.
+func (arg ValidatorArgument) WithLabel(label string) ValidatorArgument {
+	arg.label = label
+	return arg
+}
+
 func (arg ValidatorArgument) setUp(ctx *executionContext) {
-	if !arg.isIgnored {
+	if arg.isIgnored {
+		return
+	}
+	if arg.isSensitive || arg.label != "" {
+		ctx.addValidation(func(ctx context.Context, validator *Validator) (*ViolationList, error) {
+			if arg.isSensitive {
+				validator = validator.sensitive()
+			}
+			if arg.label != "" {
+				validator = validator.withLabel(arg.label)
+			}
+			return arg.validate(ctx, validator)
+		}, arg.path...)
+	} else {
 		ctx.addValidation(arg.validate, arg.path...)
 	}
 }
@@ -291,6 +323,7 @@ type Checker struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters TemplateParameterList
 }
 
@@ -323,6 +356,7 @@ func (c Checker) WithError(err error) Checker {
 // for injecting its values into the final message.
 func (c Checker) WithMessage(template string, parameters ...TemplateParameter) Checker {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
@@ -337,6 +371,7 @@ func (c Checker) validate(ctx context.Context, validator *Validator) (*Violation
 	}
 
 	violation := validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(c.messageParameters...).
 		Create()
 
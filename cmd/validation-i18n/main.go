@@ -0,0 +1,229 @@
+// Command validation-i18n checks the translations of violation messages and extracts
+// message templates from the source code into a catalog skeleton.
+//
+// Usage:
+//
+//	validation-i18n check [-builtin languages] [-src dir] [-dir dir] [patterns...]
+//	validation-i18n extract [-format json|yaml|po] [-o file] [dir]
+//
+// The check command loads the templates of the built-in messages and the templates used in the source code
+// of the -src directory, the built-in translations listed by the -builtin flag (comma separated package names
+// or "all") and the translation files of the -dir directory matching the patterns. It reports missing and
+// unknown messages for every language, placeholder mismatches and missing plural forms.
+// The command exits with status 1 if any issues were found.
+//
+// The extract command writes the catalog of untranslated messages used in the WithMessage calls
+// (and similar methods) of the source code in the directory.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/muonsoft/validation/message/translations"
+	"github.com/muonsoft/validation/message/translations/chinese"
+	"github.com/muonsoft/validation/message/translations/english"
+	"github.com/muonsoft/validation/message/translations/french"
+	"github.com/muonsoft/validation/message/translations/german"
+	"github.com/muonsoft/validation/message/translations/italian"
+	"github.com/muonsoft/validation/message/translations/japanese"
+	"github.com/muonsoft/validation/message/translations/polish"
+	"github.com/muonsoft/validation/message/translations/portuguese"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/muonsoft/validation/message/translations/spanish"
+	"github.com/muonsoft/validation/message/translations/turkish"
+	"github.com/muonsoft/validation/message/translations/ukrainian"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var builtinTranslations = map[string]map[language.Tag]map[string]catalog.Message{
+	"chinese":    chinese.Messages,
+	"english":    english.Messages,
+	"french":     french.Messages,
+	"german":     german.Messages,
+	"italian":    italian.Messages,
+	"japanese":   japanese.Messages,
+	"polish":     polish.Messages,
+	"portuguese": portuguese.Messages,
+	"russian":    russian.Messages,
+	"spanish":    spanish.Messages,
+	"turkish":    turkish.Messages,
+	"ukrainian":  ukrainian.Messages,
+}
+
+var errUsage = errors.New("usage: validation-i18n check|extract [flags] [arguments]")
+
+const exitIssuesFound = 1
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, errUsage)
+		return 2
+	}
+
+	var err error
+	switch args[0] {
+	case "check":
+		var hasIssues bool
+		hasIssues, err = check(args[1:], stdout, stderr)
+		if err == nil && hasIssues {
+			return exitIssuesFound
+		}
+	case "extract":
+		err = extract(args[1:], stdout, stderr)
+	default:
+		err = errUsage
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	return 0
+}
+
+func check(args []string, stdout, stderr io.Writer) (bool, error) {
+	flags := flag.NewFlagSet("check", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	builtin := flags.String("builtin", "", `comma separated list of built-in translations to check or "all"`)
+	src := flags.String("src", "", "directory of the source code to extract custom message templates")
+	dir := flags.String("dir", ".", "base directory of the translation files")
+	err := flags.Parse(args)
+	if err != nil {
+		return false, err
+	}
+	if *builtin == "" && flags.NArg() == 0 {
+		return false, errors.New("check: no translations to check: use -builtin flag or pass file patterns")
+	}
+
+	templates := make([]string, 0, len(english.Messages[language.English]))
+	for template := range english.Messages[language.English] {
+		templates = append(templates, template)
+	}
+	if *src != "" {
+		extracted, err := translations.ExtractTemplates(os.DirFS(*src))
+		if err != nil {
+			return false, fmt.Errorf("check: %w", err)
+		}
+		for _, template := range extracted {
+			templates = append(templates, template.Template)
+		}
+	}
+
+	messages, err := builtinMessages(*builtin)
+	if err != nil {
+		return false, fmt.Errorf("check: %w", err)
+	}
+	if flags.NArg() > 0 {
+		files, err := translations.ReadFiles(os.DirFS(*dir), flags.Args()...)
+		if err != nil {
+			return false, fmt.Errorf("check: %w", err)
+		}
+		mergeMessages(messages, files)
+	}
+
+	report := translations.Check(unique(templates), messages)
+	if !report.HasIssues() {
+		fmt.Fprintln(stdout, "no issues found")
+		return false, nil
+	}
+	fmt.Fprint(stdout, report)
+
+	return true, nil
+}
+
+func builtinMessages(names string) (map[language.Tag]map[string]catalog.Message, error) {
+	messages := map[language.Tag]map[string]catalog.Message{}
+	if names == "" {
+		return messages, nil
+	}
+	if names == "all" {
+		for _, translation := range builtinTranslations {
+			mergeMessages(messages, translation)
+		}
+		return messages, nil
+	}
+
+	for _, name := range strings.Split(names, ",") {
+		translation, exists := builtinTranslations[strings.TrimSpace(name)]
+		if !exists {
+			return nil, fmt.Errorf(`unknown built-in translations "%s"`, name)
+		}
+		mergeMessages(messages, translation)
+	}
+
+	return messages, nil
+}
+
+func mergeMessages(to, from map[language.Tag]map[string]catalog.Message) {
+	for tag, messages := range from {
+		if to[tag] == nil {
+			to[tag] = make(map[string]catalog.Message, len(messages))
+		}
+		for key, msg := range messages {
+			to[tag][key] = msg
+		}
+	}
+}
+
+func unique(templates []string) []string {
+	sort.Strings(templates)
+	result := templates[:0]
+	for i, template := range templates {
+		if i == 0 || template != templates[i-1] {
+			result = append(result, template)
+		}
+	}
+
+	return result
+}
+
+func extract(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("extract", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("format", translations.FormatJSON, "format of the catalog skeleton: json, yaml or po")
+	output := flags.String("o", "", "output file (standard output by default)")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	dir := "."
+	if flags.NArg() > 0 {
+		dir = flags.Arg(0)
+	}
+
+	extracted, err := translations.ExtractTemplates(os.DirFS(dir))
+	if err != nil {
+		return fmt.Errorf("extract: %w", err)
+	}
+	templates := make([]string, len(extracted))
+	for i, template := range extracted {
+		templates[i] = template.Template
+	}
+
+	w := stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("extract: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+	err = translations.WriteSkeleton(w, *format, templates)
+	if err != nil {
+		return fmt.Errorf("extract: %w", err)
+	}
+
+	return nil
+}
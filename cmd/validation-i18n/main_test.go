@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Check(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "src", "app.go"),
+		"package app\n\nvar _ = it.IsNotBlank().WithMessage(\"Name is required.\")\n")
+	writeFile(t, filepath.Join(dir, "translations", "validators.ru.yaml"),
+		"\"Name is required.\": \"Имя обязательно.\"\n"+
+			"\"This value should be equal to {{ comparedValue }}.\": \"Значение должно быть {{ value }}.\"\n")
+	tests := []struct {
+		name     string
+		args     []string
+		code     int
+		expected string
+	}{
+		{
+			name:     "builtin translations",
+			args:     []string{"check", "-builtin", "all"},
+			expected: "no issues found\n",
+		},
+		{
+			name: "translation files",
+			args: []string{
+				"check", "-builtin", "russian",
+				"-src", filepath.Join(dir, "src"),
+				"-dir", filepath.Join(dir, "translations"), "*.yaml",
+			},
+			code: exitIssuesFound,
+			expected: "ru: message \"This value should be equal to {{ comparedValue }}.\": " +
+				"missing placeholders {{ comparedValue }}: unexpected placeholders {{ value }}\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			code := run(test.args, &stdout, &stderr)
+
+			assert.Equal(t, test.code, code, stderr.String())
+			assert.Equal(t, test.expected, stdout.String())
+		})
+	}
+}
+
+func TestRun_Extract(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "app.go"),
+		"package app\n\nvar _ = it.IsNotBlank().WithMessage(\"Name is required.\")\n")
+	var stdout, stderr bytes.Buffer
+
+	code := run([]string{"extract", "-format", "po", dir}, &stdout, &stderr)
+
+	assert.Equal(t, 0, code, stderr.String())
+	assert.Contains(t, stdout.String(), "msgid \"Name is required.\"\nmsgstr \"\"\n")
+}
+
+func TestRun_WhenInvalidArguments_ExpectUsageError(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	code := run([]string{"unknown"}, &stdout, &stderr)
+
+	assert.Equal(t, 2, code)
+	assert.Equal(t, errUsage.Error()+"\n", stderr.String())
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+	require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+}
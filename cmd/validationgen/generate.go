@@ -0,0 +1,715 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const header = "// Code generated by validationgen. DO NOT EDIT.\n\n"
+
+// generator writes the Validate methods of the struct types. The imports are collected
+// while the arguments are generated.
+type generator struct {
+	imports  map[string]bool
+	patterns []pattern
+	// helpers are the names of the functions written after the methods, see helperSources.
+	helpers map[string]bool
+	errs    []error
+}
+
+type pattern struct {
+	name  string
+	regex string
+}
+
+var (
+	errUnknownRule      = errors.New("unknown rule")
+	errUnknownParameter = errors.New("unknown parameter")
+	errInvalidParameter = errors.New("invalid parameter")
+)
+
+// generate returns the formatted source code of the Validate methods for the struct types of the package.
+func generate(p *pkg) ([]byte, error) {
+	g := &generator{
+		imports: map[string]bool{
+			"context":                        true,
+			"github.com/muonsoft/validation": true,
+		},
+		helpers: map[string]bool{},
+	}
+
+	var methods bytes.Buffer
+	for _, e := range p.enums {
+		g.writeEnum(&methods, e)
+	}
+	for _, s := range p.structs {
+		g.writeMethod(&methods, s)
+	}
+	if len(g.errs) > 0 {
+		return nil, errors.Join(g.errs...)
+	}
+
+	var source bytes.Buffer
+	source.WriteString(header)
+	fmt.Fprintf(&source, "package %s\n\n", p.name)
+	g.writeImports(&source)
+	if len(g.patterns) > 0 {
+		source.WriteString("var (\n")
+		for _, p := range g.patterns {
+			fmt.Fprintf(&source, "\t%s = regexp.MustCompile(%s)\n", p.name, strconv.Quote(p.regex))
+		}
+		source.WriteString(")\n\n")
+	}
+	source.Write(methods.Bytes())
+	g.writeHelpers(&source)
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+
+	return formatted, nil
+}
+
+// helperSources are the functions validating the slices and maps of pointers to structs.
+// The nil elements are skipped, because the Validate method of value receiver cannot be called on them.
+var helperSources = map[string]string{
+	validPointerSlice: `// validPointerSliceProperty validates the not nil elements of the slice by their Validate method.
+func validPointerSliceProperty[T any, P interface {
+	*T
+	validation.Validatable
+}](name string, values []P) validation.ValidatorArgument {
+	return validation.NewArgument(func(ctx context.Context, validator *validation.Validator) (*validation.ViolationList, error) {
+		violations := validation.NewViolationList()
+		for i, value := range values {
+			if value == nil {
+				continue
+			}
+			if err := violations.AppendFromError(value.Validate(ctx, validator.AtIndex(i))); err != nil {
+				return nil, err
+			}
+		}
+
+		return violations, nil
+	}).At(validation.PropertyName(name))
+}
+`,
+	validPointerMap: `// validPointerMapProperty validates the not nil elements of the map by their Validate method.
+func validPointerMapProperty[T any, P interface {
+	*T
+	validation.Validatable
+}](name string, values map[string]P) validation.ValidatorArgument {
+	return validation.NewArgument(func(ctx context.Context, validator *validation.Validator) (*validation.ViolationList, error) {
+		violations := validation.NewViolationList()
+		for key, value := range values {
+			if value == nil {
+				continue
+			}
+			if err := violations.AppendFromError(value.Validate(ctx, validator.AtProperty(key))); err != nil {
+				return nil, err
+			}
+		}
+
+		return violations, nil
+	}).At(validation.PropertyName(name))
+}
+`,
+}
+
+const (
+	validPointerSlice = "validPointerSliceProperty"
+	validPointerMap   = "validPointerMapProperty"
+)
+
+func (g *generator) writeHelpers(w *bytes.Buffer) {
+	for _, name := range []string{validPointerSlice, validPointerMap} {
+		if g.helpers[name] {
+			w.WriteString(helperSources[name])
+			w.WriteString("\n")
+		}
+	}
+}
+
+func (g *generator) writeImports(w *bytes.Buffer) {
+	imports := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+
+	w.WriteString("import (\n")
+	for _, path := range imports {
+		// standard packages are separated from the others
+		if strings.Contains(path, ".") {
+			continue
+		}
+		fmt.Fprintf(w, "\t%s\n", strconv.Quote(path))
+	}
+	w.WriteString("\n")
+	for _, path := range imports {
+		if strings.Contains(path, ".") {
+			fmt.Fprintf(w, "\t%s\n", strconv.Quote(path))
+		}
+	}
+	w.WriteString(")\n\n")
+}
+
+// writeEnum writes the list of the values, the IsValid method and the constructor of the choice constraint
+// for the enum. The choices of the constraint are rendered from the same list, so the new constants
+// are validated after the regeneration.
+func (g *generator) writeEnum(w *bytes.Buffer, e enumType) {
+	g.imports[itPackage] = true
+	receiver := string(unicode.ToLower(rune(e.name[0])))
+	values := strings.Join(e.values, ", ")
+
+	fmt.Fprintf(w, "// Values returns the values of the %s in order of declaration.\n", e.name)
+	fmt.Fprintf(w, "func (%s) Values() []%s {\n\treturn []%s{%s}\n}\n\n", e.name, e.name, e.name, values)
+	fmt.Fprintf(w, "// IsValid checks that the value is one of the %s constants.\n", e.name)
+	fmt.Fprintf(w, "func (%s %s) IsValid() bool {\n", receiver, e.name)
+	fmt.Fprintf(w, "\tswitch %s {\n\tcase %s:\n\t\treturn true\n\t}\n\n\treturn false\n}\n\n", receiver, values)
+	fmt.Fprintf(w, "// %s creates a constraint to check that the value is one of the %s constants.\n", enumConstructor(e.name), e.name)
+	fmt.Fprintf(w, "func %s() it.ChoiceConstraint[%s] {\n", enumConstructor(e.name), e.name)
+	fmt.Fprintf(w, "\treturn it.IsOneOf(%s)\n}\n\n", values)
+}
+
+func enumConstructor(name string) string {
+	return "Is" + string(unicode.ToUpper(rune(name[0]))) + name[1:]
+}
+
+func (g *generator) writeMethod(w *bytes.Buffer, s structType) {
+	receiver := string(unicode.ToLower(rune(s.name[0])))
+
+	fmt.Fprintf(w, "// Validate checks the %s by the rules of the struct tags.\n", s.name)
+	fmt.Fprintf(w, "func (%s %s) Validate(ctx context.Context, validator *validation.Validator) error {\n", receiver, s.name)
+	w.WriteString("\treturn validator.Validate(\n\t\tctx,\n")
+	for _, f := range s.fields {
+		arguments, err := g.arguments(s, f, receiver+"."+f.name)
+		if err != nil {
+			g.errs = append(g.errs, fmt.Errorf("%s: %s.%s: %w", f.position, s.name, f.name, err))
+			continue
+		}
+		for _, argument := range arguments {
+			if f.label != "" {
+				argument += fmt.Sprintf(".WithLabel(%s)", strconv.Quote(f.label))
+			}
+			fmt.Fprintf(w, "\t\t%s,\n", argument)
+		}
+	}
+	w.WriteString("\t)\n}\n\n")
+}
+
+// arguments returns the validation arguments for the field.
+func (g *generator) arguments(s structType, f field, value string) ([]string, error) {
+	if f.rules.isSkipped {
+		return nil, nil
+	}
+	t := f.typ
+	property := strconv.Quote(f.property)
+
+	switch t.kind {
+	case kindString, kindNumber, kindBool, kindTime:
+		if len(f.rules.each) > 0 {
+			return nil, fmt.Errorf(`tag "validateEach": %w`, errNotIterable)
+		}
+		rules, enum, err := enumArgument(f, f.rules.rules, t, "ComparableProperty", property, value)
+		if err != nil {
+			return nil, err
+		}
+		constraints, err := g.constraints(s, f, rules, t)
+		if err != nil {
+			return nil, err
+		}
+		arguments := make([]string, 0, 2)
+		if len(constraints) > 0 {
+			arguments = append(arguments, scalarArgument(t, property, value, constraints))
+		}
+		if enum != "" {
+			arguments = append(arguments, enum)
+		}
+		return arguments, nil
+	case kindStruct:
+		return g.structArguments(f, property, value)
+	}
+
+	return g.iterableArguments(s, f, property, value)
+}
+
+var errNotIterable = errors.New("the field is not a slice")
+
+// enumArgument returns the argument for the "enum" rule and the other rules. The values of the enums
+// are validated by the generated constructors of the choice constraints (e.g. IsStatus).
+func enumArgument(f field, rules []rule, t fieldType, function, property, value string) ([]rule, string, error) {
+	others := make([]rule, 0, len(rules))
+	argument := ""
+	for _, r := range rules {
+		if r.name != "enum" {
+			others = append(others, r)
+			continue
+		}
+		if !t.isEnum {
+			return nil, "", fmt.Errorf(`rule "enum": the type is not annotated by %s`, enumAnnotation)
+		}
+		if err := r.check(); err != nil {
+			return nil, "", err
+		}
+		if t.isPointer {
+			function = "Nil" + function
+		}
+		argument = fmt.Sprintf(
+			"validation.%s[%s](%s, %s, %s()%s)",
+			function, t.name, property, value, enumConstructor(t.name), groups(f.rules.groups),
+		)
+	}
+
+	return others, argument, nil
+}
+
+func scalarArgument(t fieldType, property, value string, constraints []string) string {
+	var function string
+	switch t.kind {
+	case kindString:
+		function = "StringProperty"
+	case kindNumber:
+		function = "NumberProperty[" + t.name + "]"
+	case kindBool:
+		function = "BoolProperty"
+	case kindTime:
+		function = "TimeProperty"
+	}
+	if t.isPointer {
+		function = "Nil" + function
+	}
+	// values of the named types are converted into the basic types
+	if t.isNamed && t.kind != kindNumber {
+		basic := "string"
+		if t.kind == kindBool {
+			basic = "bool"
+		}
+		if t.isPointer {
+			value = "(*" + basic + ")(" + value + ")"
+		} else {
+			value = basic + "(" + value + ")"
+		}
+	}
+
+	return fmt.Sprintf("validation.%s(%s, %s, %s)", function, property, value, strings.Join(constraints, ", "))
+}
+
+func (g *generator) structArguments(f field, property, value string) ([]string, error) {
+	arguments := make([]string, 0, 2)
+	for _, r := range f.rules.rules {
+		if r.name != "notNil" {
+			return nil, fmt.Errorf(`%w "%s" for struct`, errUnknownRule, r.name)
+		}
+		if !f.typ.isPointer {
+			return nil, fmt.Errorf(`rule "notNil": the field is not a pointer`)
+		}
+		arguments = append(arguments, g.nilArgument(f, property, value))
+	}
+	if len(f.rules.each) > 0 {
+		return nil, fmt.Errorf(`tag "validateEach": %w`, errNotIterable)
+	}
+	if !f.typ.isValidatable && !f.rules.isValid {
+		return arguments, nil
+	}
+
+	argument := fmt.Sprintf("validation.ValidProperty(%s, %s)", property, value)
+	if f.typ.isPointer {
+		argument += fmt.Sprintf(".When(%s != nil)", value)
+	}
+
+	return append(arguments, argument), nil
+}
+
+func (g *generator) iterableArguments(s structType, f field, property, value string) ([]string, error) {
+	t := f.typ
+	elem := *t.elem
+	isComparable := (elem.kind == kindString || elem.kind == kindNumber) && !elem.isPointer
+	arguments := make([]string, 0)
+
+	counts := make([]string, 0)
+	unique := ""
+	for _, r := range f.rules.rules {
+		switch r.name {
+		case "notNil":
+			if err := r.check(); err != nil {
+				return nil, err
+			}
+			arguments = append(arguments, g.nilArgument(f, property, value))
+		case "unique":
+			if t.kind != kindSlice || !isComparable {
+				return nil, fmt.Errorf(`rule "unique": the field is not a slice of strings or numbers`)
+			}
+			if err := r.check(); err != nil {
+				return nil, err
+			}
+			g.imports[itPackage] = true
+			unique = fmt.Sprintf(
+				"validation.ComparablesProperty[%s](%s, %s, it.HasUniqueValues[%s]()%s)",
+				elem.name, property, value, elem.name, groups(f.rules.groups),
+			)
+		default:
+			constraint, err := g.countableConstraint(r)
+			if err != nil {
+				return nil, err
+			}
+			counts = append(counts, constraint+groups(f.rules.groups))
+		}
+	}
+	if len(counts) > 0 {
+		arguments = append(arguments, fmt.Sprintf(
+			"validation.CountableProperty(%s, len(%s), %s)", property, value, strings.Join(counts, ", "),
+		))
+	}
+	if unique != "" {
+		arguments = append(arguments, unique)
+	}
+
+	each, enum, err := enumArgument(f, f.rules.each, elem, "EachComparableProperty", property, value)
+	if err != nil {
+		return nil, fmt.Errorf(`tag "validateEach": %w`, err)
+	}
+	if enum != "" {
+		if t.kind != kindSlice || elem.isPointer {
+			return nil, fmt.Errorf(`tag "validateEach": rule "enum": the field is not a slice of enums`)
+		}
+		arguments = append(arguments, enum)
+	}
+	if len(each) > 0 {
+		if t.kind != kindSlice || !isComparable || elem.kind == kindString && elem.isNamed {
+			return nil, fmt.Errorf(`tag "validateEach": the field is not a slice of strings or numbers`)
+		}
+		constraints, err := g.constraints(s, f, each, elem)
+		if err != nil {
+			return nil, err
+		}
+		function := "EachStringProperty"
+		if elem.kind == kindNumber {
+			function = "EachNumberProperty[" + elem.name + "]"
+		}
+		arguments = append(arguments, fmt.Sprintf(
+			"validation.%s(%s, %s, %s)", function, property, value, strings.Join(constraints, ", "),
+		))
+	}
+
+	if elem.kind == kindStruct && (elem.isValidatable || f.rules.isValid) {
+		function := "validation.ValidSliceProperty"
+		if t.kind == kindMap {
+			function = "validation.ValidMapProperty"
+		}
+		if elem.isPointer {
+			function = validPointerSlice
+			if t.kind == kindMap {
+				function = validPointerMap
+			}
+			g.helpers[function] = true
+		}
+		arguments = append(arguments, fmt.Sprintf("%s(%s, %s)", function, property, value))
+	}
+
+	return arguments, nil
+}
+
+func (g *generator) nilArgument(f field, property, value string) string {
+	g.imports[itPackage] = true
+
+	return fmt.Sprintf("validation.NilProperty(%s, %s == nil, it.IsNotNil()%s)", property, value, groups(f.rules.groups))
+}
+
+const itPackage = "github.com/muonsoft/validation/it"
+
+// constraints returns the constraints for the scalar value of the type.
+func (g *generator) constraints(s structType, f field, rules []rule, t fieldType) ([]string, error) {
+	constraints := make([]string, 0, len(rules))
+
+	for _, r := range rules {
+		var constraint string
+		var err error
+		switch {
+		case r.name == "notNil" && !t.isPointer:
+			err = fmt.Errorf(`rule "notNil": the field is not a pointer`)
+		case t.kind == kindString:
+			constraint, err = g.stringConstraint(s, f, r)
+		case t.kind == kindNumber:
+			constraint, err = numberConstraint(r, t.name)
+		case t.kind == kindBool:
+			constraint, err = boolConstraint(r)
+		default:
+			constraint, err = timeConstraint(r)
+		}
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, constraint+groups(f.rules.groups))
+	}
+	if len(constraints) > 0 {
+		g.imports[itPackage] = true
+	}
+
+	return constraints, nil
+}
+
+//nolint:gocyclo,cyclop
+func (g *generator) stringConstraint(s structType, f field, r rule) (string, error) {
+	switch r.name {
+	case "notBlank", "blank", "notNil", "email", "uuid", "ulid", "hostname", "date", "json":
+		if err := r.check(); err != nil {
+			return "", err
+		}
+		return map[string]string{
+			"notBlank": "it.IsNotBlank()",
+			"blank":    "it.IsBlank()",
+			"notNil":   "it.IsNotNil()",
+			"email":    "it.IsEmail()",
+			"uuid":     "it.IsUUID()",
+			"ulid":     "it.IsULID()",
+			"hostname": "it.IsHostname()",
+			"date":     "it.IsDate()",
+			"json":     "it.IsJSON()",
+		}[r.name], nil
+	case "length":
+		return r.minMax("Length")
+	case "url":
+		if err := r.check(); err != nil {
+			return "", err
+		}
+		return "it.IsURL()", nil
+	case "ip":
+		if err := r.check("version"); err != nil {
+			return "", err
+		}
+		switch r.params["version"] {
+		case "":
+			return "it.IsIP()", nil
+		case "4":
+			return "it.IsIPv4()", nil
+		case "6":
+			return "it.IsIPv6()", nil
+		}
+		return "", fmt.Errorf(`rule "ip": %w "version": must be 4 or 6`, errInvalidParameter)
+	case "dateTime":
+		if err := r.check("layout"); err != nil {
+			return "", err
+		}
+		if layout, exists := r.params["layout"]; exists {
+			return fmt.Sprintf("it.IsDateTime().WithLayout(%s)", strconv.Quote(layout)), nil
+		}
+		return "it.IsDateTime()", nil
+	case "regex":
+		if err := r.check("pattern", "match"); err != nil {
+			return "", err
+		}
+		return g.regexConstraint(s, f, r)
+	case "choice":
+		if err := r.check("values"); err != nil {
+			return "", err
+		}
+		values := r.list("values")
+		if len(values) == 0 {
+			return "", fmt.Errorf(`rule "choice": %w "values": must not be empty`, errInvalidParameter)
+		}
+		for i, value := range values {
+			values[i] = strconv.Quote(value)
+		}
+		return "it.IsOneOf(" + strings.Join(values, ", ") + ")", nil
+	}
+
+	return "", fmt.Errorf(`%w "%s" for string`, errUnknownRule, r.name)
+}
+
+func (g *generator) regexConstraint(s structType, f field, r rule) (string, error) {
+	regex := r.params["pattern"]
+	if regex == "" {
+		return "", fmt.Errorf(`rule "regex": %w "pattern": must not be empty`, errInvalidParameter)
+	}
+	function := "it.Matches"
+	switch r.params["match"] {
+	case "", "true":
+	case "false":
+		function = "it.DoesNotMatch"
+	default:
+		return "", fmt.Errorf(`rule "regex": %w "match": must be true or false`, errInvalidParameter)
+	}
+
+	name := string(unicode.ToLower(rune(s.name[0]))) + s.name[1:] + f.name + "Pattern"
+	for i := 2; g.hasPattern(name); i++ {
+		name = strings.TrimRight(name, "0123456789") + strconv.Itoa(i)
+	}
+	g.patterns = append(g.patterns, pattern{name: name, regex: regex})
+	g.imports["regexp"] = true
+
+	return function + "(" + name + ")", nil
+}
+
+func (g *generator) hasPattern(name string) bool {
+	for _, p := range g.patterns {
+		if p.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func numberConstraint(r rule, typ string) (string, error) {
+	switch r.name {
+	case "notBlank", "blank", "notNil", "positive", "positiveOrZero", "negative", "negativeOrZero":
+		if err := r.check(); err != nil {
+			return "", err
+		}
+		function := map[string]string{
+			"notBlank":       "IsNotBlankNumber",
+			"blank":          "IsBlankNumber",
+			"notNil":         "IsNotNilNumber",
+			"positive":       "IsPositive",
+			"positiveOrZero": "IsPositiveOrZero",
+			"negative":       "IsNegative",
+			"negativeOrZero": "IsNegativeOrZero",
+		}[r.name]
+		return "it." + function + "[" + typ + "]()", nil
+	case "range":
+		if err := r.check("min", "max"); err != nil {
+			return "", err
+		}
+		min, hasMin := r.params["min"]
+		max, hasMax := r.params["max"]
+		if err := checkNumbers(r, min, max); err != nil {
+			return "", err
+		}
+		switch {
+		case hasMin && hasMax:
+			return fmt.Sprintf("it.IsBetween[%s](%s, %s)", typ, min, max), nil
+		case hasMin:
+			return fmt.Sprintf("it.IsGreaterThanOrEqual[%s](%s)", typ, min), nil
+		case hasMax:
+			return fmt.Sprintf("it.IsLessThanOrEqual[%s](%s)", typ, max), nil
+		}
+		return "", fmt.Errorf(`rule "range": %w: min or max must be set`, errInvalidParameter)
+	case "choice":
+		if err := r.check("values"); err != nil {
+			return "", err
+		}
+		values := r.list("values")
+		if len(values) == 0 {
+			return "", fmt.Errorf(`rule "choice": %w "values": must not be empty`, errInvalidParameter)
+		}
+		if err := checkNumbers(r, values...); err != nil {
+			return "", err
+		}
+		return "it.IsOneOf[" + typ + "](" + strings.Join(values, ", ") + ")", nil
+	}
+
+	return "", fmt.Errorf(`%w "%s" for number`, errUnknownRule, r.name)
+}
+
+func boolConstraint(r rule) (string, error) {
+	constraint, exists := map[string]string{
+		"notBlank": "it.IsNotBlank()",
+		"notNil":   "it.IsNotNil()",
+		"true":     "it.IsTrue()",
+		"false":    "it.IsFalse()",
+	}[r.name]
+	if !exists {
+		return "", fmt.Errorf(`%w "%s" for bool`, errUnknownRule, r.name)
+	}
+
+	return constraint, r.check()
+}
+
+func timeConstraint(r rule) (string, error) {
+	constraint, exists := map[string]string{
+		"notBlank": "it.IsNotBlank()",
+		"blank":    "it.IsBlank()",
+		"notNil":   "it.IsNotNil()",
+	}[r.name]
+	if !exists {
+		return "", fmt.Errorf(`%w "%s" for time`, errUnknownRule, r.name)
+	}
+
+	return constraint, r.check()
+}
+
+func (g *generator) countableConstraint(r rule) (string, error) {
+	g.imports[itPackage] = true
+
+	switch r.name {
+	case "notBlank":
+		return "it.IsNotBlank()", r.check()
+	case "blank":
+		return "it.IsBlank()", r.check()
+	case "count":
+		return r.minMax("Count")
+	}
+
+	return "", fmt.Errorf(`%w "%s" for slice or map`, errUnknownRule, r.name)
+}
+
+// minMax returns the length or count constraint by the "min" and "max" parameters.
+func (r rule) minMax(subject string) (string, error) {
+	if err := r.check("min", "max"); err != nil {
+		return "", err
+	}
+	min, hasMin := r.params["min"]
+	max, hasMax := r.params["max"]
+	for _, value := range []string{min, max} {
+		if _, err := strconv.ParseUint(value, 10, 31); value != "" && err != nil {
+			return "", fmt.Errorf(`rule "%s": %w: %s is not a non-negative integer`, r.name, errInvalidParameter, value)
+		}
+	}
+
+	switch {
+	case hasMin && hasMax && min == max:
+		return fmt.Sprintf("it.HasExact%s(%s)", subject, min), nil
+	case hasMin && hasMax:
+		return fmt.Sprintf("it.Has%sBetween(%s, %s)", subject, min, max), nil
+	case hasMin:
+		return fmt.Sprintf("it.HasMin%s(%s)", subject, min), nil
+	case hasMax:
+		return fmt.Sprintf("it.HasMax%s(%s)", subject, max), nil
+	}
+
+	return "", fmt.Errorf(`rule "%s": %w: min or max must be set`, r.name, errInvalidParameter)
+}
+
+// check returns an error if the rule has parameters other than allowed.
+func (r rule) check(allowed ...string) error {
+	for key := range r.params {
+		isAllowed := false
+		for _, a := range allowed {
+			isAllowed = isAllowed || key == a
+		}
+		if !isAllowed {
+			return fmt.Errorf(`rule "%s": %w "%s"`, r.name, errUnknownParameter, key)
+		}
+	}
+
+	return nil
+}
+
+func checkNumbers(r rule, values ...string) error {
+	for _, value := range values {
+		if _, err := strconv.ParseFloat(value, 64); value != "" && err != nil {
+			return fmt.Errorf(`rule "%s": %w: %s is not a number`, r.name, errInvalidParameter, value)
+		}
+	}
+
+	return nil
+}
+
+func groups(groups []string) string {
+	if len(groups) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(groups))
+	for i, group := range groups {
+		quoted[i] = strconv.Quote(group)
+	}
+
+	return ".WhenGroups(" + strings.Join(quoted, ", ") + ")"
+}
@@ -0,0 +1,417 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+const (
+	// annotation marks the struct types for which the Validate methods are generated.
+	annotation = "//validation:generate"
+	// enumAnnotation marks the types with the constant values for which the enum methods are generated.
+	enumAnnotation = "//validation:enum"
+)
+
+type kind int
+
+const (
+	kindString kind = iota
+	kindNumber
+	kindBool
+	kindTime
+	kindStruct
+	kindSlice
+	kindMap
+)
+
+// fieldType is the type of the struct field resolved from the syntax tree.
+type fieldType struct {
+	kind kind
+	// name is the name of the type without the pointer, e.g. "string", "Status" or "time.Time".
+	name      string
+	isPointer bool
+	// isNamed is true for the local types with the basic underlying type (e.g. type Status string),
+	// the values of these types are converted into the basic types.
+	isNamed bool
+	// isValidatable is true for the local structs having the Validate method (written or generated).
+	isValidatable bool
+	// isEnum is true for the named types annotated as enums.
+	isEnum bool
+	elem   *fieldType
+}
+
+type field struct {
+	name     string
+	property string
+	// label is the human-readable label of the property from the "label" tag.
+	label    string
+	typ      fieldType
+	rules    fieldRules
+	position token.Position
+}
+
+type structType struct {
+	name   string
+	fields []field
+}
+
+// enumType is the type with the constant values, e.g. type Status string.
+type enumType struct {
+	name string
+	// values are the names of the constants in order of declaration.
+	values []string
+}
+
+type pkg struct {
+	name    string
+	enums   []enumType
+	structs []structType
+}
+
+// load parses the Go files of the package in the directory (except the test files and the output file)
+// and collects the annotated struct types. The package is returned along with the errors of the invalid
+// fields (these fields are skipped), so the other errors can be found by the generator.
+func load(dir, output string) (*pkg, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == output {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+
+	l := &loader{
+		fset:        fset,
+		types:       map[string]*ast.TypeSpec{},
+		validatable: map[string]bool{},
+		enums:       map[string]bool{},
+		constants:   map[string][]string{},
+	}
+	annotated, enums := l.collect(files)
+	l.collectConstants(files)
+	p := &pkg{name: files[0].Name.Name, structs: make([]structType, 0, len(annotated))}
+	errs := make([]error, 0)
+	for _, spec := range enums {
+		e, err := l.loadEnum(spec)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		p.enums = append(p.enums, e)
+	}
+	for _, spec := range annotated {
+		s, structErrs := l.loadStruct(spec)
+		errs = append(errs, structErrs...)
+		p.structs = append(p.structs, s)
+	}
+	if len(annotated) == 0 && len(enums) == 0 {
+		return nil, fmt.Errorf("no types annotated by %s or %s in %s", annotation, enumAnnotation, dir)
+	}
+
+	return p, errors.Join(errs...)
+}
+
+type loader struct {
+	fset        *token.FileSet
+	types       map[string]*ast.TypeSpec
+	validatable map[string]bool
+	enums       map[string]bool
+	// constants are the names of the typed constants by the names of the types.
+	constants map[string][]string
+}
+
+// collect finds the type declarations, the types having the Validate methods, the constants,
+// the annotated structs and enums. The files are sorted by name, so the annotated types
+// are returned in order of declaration.
+func (l *loader) collect(files []*ast.File) ([]*ast.TypeSpec, []*ast.TypeSpec) {
+	annotated := make([]*ast.TypeSpec, 0)
+	enums := make([]*ast.TypeSpec, 0)
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv != nil && d.Name.Name == "Validate" {
+					l.validatable[receiverName(d.Recv.List[0].Type)] = true
+				}
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					l.types[typeSpec.Name.Name] = typeSpec
+					// the annotation is placed in the doc of the declaration or of the spec in the group
+					isAnnotatedBy := func(annotation string) bool {
+						return isAnnotated(typeSpec.Doc, annotation) || len(d.Specs) == 1 && isAnnotated(d.Doc, annotation)
+					}
+					if _, isStruct := typeSpec.Type.(*ast.StructType); !isStruct {
+						if isAnnotatedBy(enumAnnotation) {
+							enums = append(enums, typeSpec)
+							l.enums[typeSpec.Name.Name] = true
+						}
+						continue
+					}
+					if isAnnotatedBy(annotation) {
+						annotated = append(annotated, typeSpec)
+						l.validatable[typeSpec.Name.Name] = true
+					}
+				}
+			}
+		}
+	}
+
+	return annotated, enums
+}
+
+// collectConstants finds the typed constants of the package. The types of the constants are resolved
+// by the type checker, so the constants declared by the conversions (e.g. const StatusDraft = Status("draft")),
+// by the expressions of the other constants or with iota are found as well. The imported packages are not
+// loaded and the errors of the type checking are ignored: only the local types of the constants are needed.
+func (l *loader) collectConstants(files []*ast.File) {
+	info := &types.Info{Defs: map[*ast.Ident]types.Object{}}
+	config := types.Config{Error: func(error) {}}
+	checked, _ := config.Check(files[0].Name.Name, l.fset, files, info)
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			d, ok := decl.(*ast.GenDecl)
+			if !ok || d.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range d.Specs {
+				for _, name := range spec.(*ast.ValueSpec).Names {
+					constant, ok := info.Defs[name].(*types.Const)
+					if !ok || name.Name == "_" {
+						continue
+					}
+					named, ok := constant.Type().(*types.Named)
+					if !ok || named.Obj().Pkg() != checked {
+						continue
+					}
+					l.constants[named.Obj().Name()] = append(l.constants[named.Obj().Name()], name.Name)
+				}
+			}
+		}
+	}
+}
+
+func (l *loader) loadEnum(spec *ast.TypeSpec) (enumType, error) {
+	name := spec.Name.Name
+	position := l.fset.Position(spec.Pos())
+	t, err := l.resolveIdent(name)
+	if err != nil || t.kind != kindString && t.kind != kindNumber {
+		return enumType{}, fmt.Errorf("%s: %s: enum must be a string or a number type", position, name)
+	}
+	values := l.constants[name]
+	if len(values) == 0 {
+		return enumType{}, fmt.Errorf("%s: %s: enum has no constants", position, name)
+	}
+
+	return enumType{name: name, values: values}, nil
+}
+
+func (l *loader) loadStruct(spec *ast.TypeSpec) (structType, []error) {
+	s := structType{name: spec.Name.Name}
+	errs := make([]error, 0)
+
+	for _, f := range spec.Type.(*ast.StructType).Fields.List {
+		var tag reflect.StructTag
+		if f.Tag != nil {
+			tag = reflect.StructTag(strings.Trim(f.Tag.Value, "`"))
+		}
+		validate, hasValidate := tag.Lookup("validate")
+		each, hasEach := tag.Lookup("validateEach")
+		position := l.fset.Position(f.Pos())
+		// embedded fields are not supported
+		if len(f.Names) == 0 {
+			if hasValidate || hasEach {
+				errs = append(errs, fmt.Errorf("%s: %s: embedded fields cannot be validated", position, s.name))
+			}
+			continue
+		}
+
+		rules, err := parseFieldRules(validate, each)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s.%s: %w", position, s.name, f.Names[0].Name, err))
+			continue
+		}
+		typ, err := l.resolve(f.Type)
+		if err != nil {
+			// fields without tags of the unsupported types are ignored
+			if hasValidate || hasEach {
+				errs = append(errs, fmt.Errorf("%s: %s.%s: %w", position, s.name, f.Names[0].Name, err))
+			}
+			continue
+		}
+		for _, name := range f.Names {
+			s.fields = append(s.fields, field{
+				name:     name.Name,
+				property: propertyName(name.Name, tag),
+				label:    tag.Get("label"),
+				typ:      typ,
+				rules:    rules,
+				position: position,
+			})
+		}
+	}
+
+	return s, errs
+}
+
+var errUnsupportedType = errors.New("unsupported type")
+
+var numberTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true, "byte": true, "rune": true,
+}
+
+func (l *loader) resolve(expr ast.Expr) (fieldType, error) {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		t, err := l.resolve(e.X)
+		if err != nil {
+			return t, err
+		}
+		if t.isPointer || t.kind == kindSlice || t.kind == kindMap {
+			return t, fmt.Errorf("%w: pointer to %s", errUnsupportedType, typeString(e.X))
+		}
+		t.isPointer = true
+		return t, nil
+	case *ast.Ident:
+		return l.resolveIdent(e.Name)
+	case *ast.SelectorExpr:
+		name := typeString(e)
+		if name == "time.Time" {
+			return fieldType{kind: kindTime, name: name}, nil
+		}
+		// structs of the other packages are validated only by the "valid" rule
+		return fieldType{kind: kindStruct, name: name}, nil
+	case *ast.ArrayType:
+		if e.Len != nil {
+			return fieldType{}, fmt.Errorf("%w: array", errUnsupportedType)
+		}
+		elem, err := l.resolve(e.Elt)
+		if err != nil {
+			return fieldType{}, err
+		}
+		return fieldType{kind: kindSlice, name: typeString(e), elem: &elem}, nil
+	case *ast.MapType:
+		if key, ok := e.Key.(*ast.Ident); !ok || key.Name != "string" {
+			return fieldType{}, fmt.Errorf("%w: map with keys of type %s", errUnsupportedType, typeString(e.Key))
+		}
+		elem, err := l.resolve(e.Value)
+		if err != nil {
+			return fieldType{}, err
+		}
+		return fieldType{kind: kindMap, name: typeString(e), elem: &elem}, nil
+	}
+
+	return fieldType{}, fmt.Errorf("%w: %s", errUnsupportedType, typeString(expr))
+}
+
+func (l *loader) resolveIdent(name string) (fieldType, error) {
+	switch {
+	case name == "string":
+		return fieldType{kind: kindString, name: name}, nil
+	case name == "bool":
+		return fieldType{kind: kindBool, name: name}, nil
+	case numberTypes[name]:
+		return fieldType{kind: kindNumber, name: name}, nil
+	}
+
+	spec, exists := l.types[name]
+	if !exists {
+		return fieldType{}, fmt.Errorf("%w: %s", errUnsupportedType, name)
+	}
+	if _, isStruct := spec.Type.(*ast.StructType); isStruct {
+		return fieldType{kind: kindStruct, name: name, isValidatable: l.validatable[name]}, nil
+	}
+	underlying, ok := spec.Type.(*ast.Ident)
+	if !ok || spec.Assign.IsValid() {
+		return fieldType{}, fmt.Errorf("%w: %s", errUnsupportedType, name)
+	}
+	t, err := l.resolveIdent(underlying.Name)
+	if err != nil || t.isNamed || t.kind > kindBool {
+		return fieldType{}, fmt.Errorf("%w: %s", errUnsupportedType, name)
+	}
+	t.name = name
+	t.isNamed = true
+	t.isEnum = l.enums[name]
+
+	return t, nil
+}
+
+func isAnnotated(doc *ast.CommentGroup, annotation string) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.TrimSpace(comment.Text) == annotation {
+			return true
+		}
+	}
+
+	return false
+}
+
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// propertyName returns the name of the property from the "json" tag or the name of the field.
+func propertyName(name string, tag reflect.StructTag) string {
+	jsonName, _, _ := strings.Cut(tag.Get("json"), ",")
+	if jsonName != "" && jsonName != "-" {
+		return jsonName
+	}
+
+	return name
+}
+
+func typeString(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return typeString(e.X) + "." + e.Sel.Name
+	case *ast.StarExpr:
+		return "*" + typeString(e.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(e.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(e.Key) + "]" + typeString(e.Value)
+	}
+
+	return fmt.Sprintf("%T", expr)
+}
@@ -0,0 +1,122 @@
+// Command validationgen generates the Validate methods from the struct tags, so the structs
+// can be validated without reflection. Also, it generates the methods and the choice constraints for the enums.
+//
+// Usage:
+//
+//	//go:generate go run github.com/muonsoft/validation/cmd/validationgen [-o file] [dir]
+//
+// The command reads the Go files of the package in the directory (the current directory by default)
+// and writes the methods into the output file (validation_gen.go by default) for the struct types
+// annotated by the //validation:generate comment. The generated methods implement
+// the [validation.Validatable] interface by using the [validation.StringProperty],
+// [validation.NumberProperty], [validation.ValidProperty], [validation.EachStringProperty] and similar arguments.
+//
+// For the string and number types annotated by the //validation:enum comment the command generates
+// the Values method (the constants of the type in order of declaration, including the constants declared
+// by the conversions like Status("draft")), the IsValid method and
+// the constructor of the [it.ChoiceConstraint] named by the type (e.g. IsStatus). The choices of the constraint
+// (and the {{ choices }} parameter of the message) are the same list, so the new constants are validated
+// after the regeneration.
+//
+//	//validation:enum
+//	type Status string
+//
+//	const (
+//		StatusDraft     Status = "draft"
+//		StatusPublished Status = "published"
+//	)
+//
+// The rules are defined by the "validate" tag of the field and separated by commas. The parameters of the rule
+// are listed in parentheses as key=value pairs, list values are separated by "|" and values containing
+// commas or parentheses can be enclosed in single quotes. The property name is taken from the "json" tag
+// or it is the name of the field. The "label" tag sets the human-readable label of the property
+// (see [validation.ValidatorArgument.WithLabel]).
+//
+//	//validation:generate
+//	type User struct {
+//		Name   string   `json:"name" label:"Name" validate:"notBlank,length(max=100)"`
+//		Role   string   `json:"role" validate:"choice(values=admin|user),groups(admin)"`
+//		Login  string   `json:"login" validate:"regex(pattern='^[a-z]{3,}$')"`
+//		Age    *int     `json:"age" validate:"notNil,range(min=18,max=150)"`
+//		Tags   []string `json:"tags" validate:"count(max=5),unique" validateEach:"notBlank"`
+//		Author *Author  `json:"author" validate:"notNil"`
+//	}
+//
+// Supported rules:
+//
+//   - strings: notBlank, blank, notNil, length(min,max), email, url, uuid, ulid, ip(version), hostname,
+//     dateTime(layout), date, json, regex(pattern,match), choice(values);
+//   - numbers: notBlank, blank, notNil, range(min,max), positive, positiveOrZero, negative, negativeOrZero,
+//     choice(values);
+//   - booleans: notBlank, notNil, true, false;
+//   - time.Time: notBlank, blank, notNil;
+//   - slices and maps: notBlank, blank, notNil, count(min,max) and unique (for slices of strings and numbers);
+//     the elements of the slices of strings and numbers are validated by the rules of the "validateEach" tag;
+//   - structs: notNil.
+//
+// Pointers to strings, numbers, booleans and time.Time are validated by the Nil* arguments
+// (e.g. [validation.NilStringProperty]). The special rules are:
+//
+//   - enum - the value of the enum type (or the elements of the slice in the "validateEach" tag) is validated
+//     by the generated constraint, e.g. IsStatus; as for [it.IsOneOf], blank values are valid;
+//   - groups(create|update) - the constraints of the field are applied only for the validation groups;
+//   - valid - the struct of the other package (or the slice or map of them) is validated by its Validate method;
+//     the local structs having the Validate method (written or generated) are validated without this rule;
+//     the nil elements of the slices and maps of pointers are skipped;
+//   - "-" (the only rule) - the field is not validated.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const defaultOutput = "validation_gen.go"
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+func run(args []string, stderr io.Writer) int {
+	flags := flag.NewFlagSet("validationgen", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	output := flags.String("o", defaultOutput, "name of the output file in the package directory")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	dir := "."
+	if flags.NArg() > 0 {
+		dir = flags.Arg(0)
+	}
+
+	if err := generateFile(dir, *output); err != nil {
+		fmt.Fprintln(stderr, "validationgen:", err)
+		return 1
+	}
+
+	return 0
+}
+
+func generateFile(dir, output string) error {
+	p, err := load(dir, output)
+	if p == nil {
+		return err
+	}
+	source, generateErr := generate(p)
+	if err := errors.Join(err, generateErr); err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, output)
+	// the file is not rewritten when the code is not changed to keep the build cache
+	if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, source) {
+		return nil
+	}
+
+	return os.WriteFile(path, source, 0o644)
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate_Golden(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("testdata", "*"))
+	require.NoError(t, err)
+	for _, dir := range dirs {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			golden := filepath.Join(dir, defaultOutput)
+			p, err := load(dir, defaultOutput)
+			require.NoError(t, err)
+
+			source, err := generate(p)
+
+			require.NoError(t, err)
+			if *update {
+				require.NoError(t, os.WriteFile(golden, source, 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(source))
+		})
+	}
+}
+
+// TestGenerate_GeneratedCodeCompiles runs the tests of the packages in the testdata directory,
+// so the generated code is checked against the current API.
+func TestGenerate_GeneratedCodeCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipped in short mode")
+	}
+	goBinary, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command is not found")
+	}
+
+	// packages in the testdata directory are not matched by the "./..." pattern
+	dirs, err := filepath.Glob(filepath.Join("testdata", "*"))
+	require.NoError(t, err)
+	args := []string{"test"}
+	for _, dir := range dirs {
+		args = append(args, "./"+filepath.ToSlash(dir))
+	}
+
+	output, err := exec.Command(goBinary, args...).CombinedOutput()
+
+	assert.NoError(t, err, string(output))
+}
+
+func TestRun_WhenFileIsGenerated_ExpectItWritten(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "types.go"), "package app\n\n"+
+		"//validation:generate\n"+
+		"type Product struct {\n"+
+		"\tName string `json:\"name\" validate:\"notBlank\"`\n"+
+		"}\n")
+	var stderr bytes.Buffer
+
+	code := run([]string{"-o", "product_validation.go", dir}, &stderr)
+
+	assert.Equal(t, 0, code, stderr.String())
+	source, err := os.ReadFile(filepath.Join(dir, "product_validation.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(source), `validation.StringProperty("name", p.Name, it.IsNotBlank()),`)
+}
+
+func TestRun_WhenTagsAreInvalid_ExpectErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   string
+		expected string
+	}{
+		{
+			name:     "unknown rule",
+			fields:   "Name string `validate:\"notBlank,lenght(max=1)\"`",
+			expected: `types.go:5:2: Product.Name: unknown rule "lenght" for string`,
+		},
+		{
+			name:     "unknown parameter",
+			fields:   "Name string `validate:\"length(maximum=1)\"`",
+			expected: `types.go:5:2: Product.Name: rule "length": unknown parameter "maximum"`,
+		},
+		{
+			name:     "invalid parameter",
+			fields:   "Price int `validate:\"range(min=zero)\"`",
+			expected: `types.go:5:2: Product.Price: rule "range": invalid parameter: zero is not a number`,
+		},
+		{
+			name:     "missing parenthesis",
+			fields:   "Name string `validate:\"length(max=1\"`",
+			expected: `types.go:5:2: Product.Name: tag "validate": rule "length(max=1": missing closing parenthesis`,
+		},
+		{
+			name:     "notNil for value",
+			fields:   "Name string `validate:\"notNil\"`",
+			expected: `types.go:5:2: Product.Name: rule "notNil": the field is not a pointer`,
+		},
+		{
+			name:     "each for string",
+			fields:   "Name string `validateEach:\"notBlank\"`",
+			expected: `types.go:5:2: Product.Name: tag "validateEach": the field is not a slice`,
+		},
+		{
+			name:     "unsupported type",
+			fields:   "Callback func() `validate:\"notNil\"`",
+			expected: `types.go:5:2: Product.Callback: unsupported type: *ast.FuncType`,
+		},
+		{
+			name:     "enum for not enum",
+			fields:   "Name string `validate:\"enum\"`",
+			expected: `types.go:5:2: Product.Name: rule "enum": the type is not annotated by //validation:enum`,
+		},
+		{
+			name: "several errors",
+			fields: "Name string `validate:\"email(strict=true)\"`\n" +
+				"\tTags []string `validate:\"unique(true)\"`",
+			// errors of the tags are found before the errors of the rules
+			expected: `types.go:6:2: Product.Tags: tag "validate": rule "unique": parameter "true" must be defined as key=value` +
+				"\n" + `types.go:5:2: Product.Name: rule "email": unknown parameter "strict"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, filepath.Join(dir, "types.go"), "package app\n\n"+
+				"//validation:generate\n"+
+				"type Product struct {\n"+
+				"\t"+test.fields+"\n"+
+				"}\n")
+			var stderr bytes.Buffer
+
+			code := run([]string{dir}, &stderr)
+
+			assert.Equal(t, 1, code)
+			lines := strings.Split(test.expected, "\n")
+			for i, line := range lines {
+				lines[i] = dir + string(filepath.Separator) + line
+			}
+			assert.Equal(t, "validationgen: "+strings.Join(lines, "\n")+"\n", stderr.String())
+			assert.NoFileExists(t, filepath.Join(dir, defaultOutput))
+		})
+	}
+}
+
+func TestRun_WhenEnumIsInvalid_ExpectErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "types.go"), "package app\n\n"+
+		"//validation:enum\n"+
+		"type Status string\n\n"+
+		"//validation:enum\n"+
+		"type Flag bool\n\n"+
+		"const Enabled Flag = true\n")
+	var stderr bytes.Buffer
+
+	code := run([]string{dir}, &stderr)
+
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "validationgen: "+
+		filepath.Join(dir, "types.go")+":4:6: Status: enum has no constants\n"+
+		filepath.Join(dir, "types.go")+":7:6: Flag: enum must be a string or a number type\n",
+		stderr.String(),
+	)
+}
+
+func TestRun_WhenNoAnnotatedTypes_ExpectError(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "types.go"), "package app\n\ntype Product struct{}\n")
+	var stderr bytes.Buffer
+
+	code := run([]string{dir}, &stderr)
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr.String(), "no types annotated by //validation:generate or //validation:enum")
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+	require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+}
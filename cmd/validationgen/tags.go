@@ -0,0 +1,156 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// rule is a constraint parsed from the struct tag, e.g. length(min=1,max=10).
+type rule struct {
+	name   string
+	params map[string]string
+	// values are the positional arguments, e.g. groups(create|update).
+	values []string
+}
+
+// fieldRules are the rules of the struct field parsed from the "validate" and "validateEach" tags.
+type fieldRules struct {
+	rules  []rule
+	each   []rule
+	groups []string
+	// isValid forces the validation of the nested struct by its Validate method.
+	isValid bool
+	// isSkipped disables the validation of the field (validate:"-").
+	isSkipped bool
+}
+
+var errEmptyRule = errors.New("empty rule")
+
+// parseFieldRules parses the tags of the field. The rules are separated by commas, the parameters
+// of the rule are defined in parentheses as key=value pairs, list values are separated by "|".
+// Values containing commas or parentheses can be quoted by single quotes:
+//
+//	validate:"notBlank,length(max=255),regex(pattern='^[a-z]{1,3}$'),groups(create|update)"
+func parseFieldRules(validate, each string) (fieldRules, error) {
+	if validate == "-" {
+		return fieldRules{isSkipped: true}, nil
+	}
+
+	var fr fieldRules
+	rules, err := parseRules(validate)
+	if err != nil {
+		return fr, fmt.Errorf(`tag "validate": %w`, err)
+	}
+	for _, r := range rules {
+		switch r.name {
+		case "valid":
+			fr.isValid = true
+		case "groups":
+			fr.groups = append(fr.groups, r.values...)
+		default:
+			fr.rules = append(fr.rules, r)
+		}
+	}
+	if fr.each, err = parseRules(each); err != nil {
+		return fr, fmt.Errorf(`tag "validateEach": %w`, err)
+	}
+
+	return fr, nil
+}
+
+func parseRules(tag string) ([]rule, error) {
+	rules := make([]rule, 0)
+	if strings.TrimSpace(tag) == "" {
+		return rules, nil
+	}
+
+	for _, definition := range split(tag, ',') {
+		r, err := parseRule(strings.TrimSpace(definition))
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+
+	return rules, nil
+}
+
+func parseRule(definition string) (rule, error) {
+	if definition == "" {
+		return rule{}, errEmptyRule
+	}
+	open := strings.IndexByte(definition, '(')
+	if open < 0 {
+		return rule{name: definition}, nil
+	}
+	if !strings.HasSuffix(definition, ")") {
+		return rule{}, fmt.Errorf(`rule "%s": missing closing parenthesis`, definition)
+	}
+
+	r := rule{name: definition[:open], params: map[string]string{}}
+	arguments := definition[open+1 : len(definition)-1]
+	// groups are listed without keys: groups(create|update)
+	if r.name == "groups" {
+		for _, group := range split(arguments, '|') {
+			r.values = append(r.values, strings.TrimSpace(group))
+		}
+		return r, nil
+	}
+	for _, argument := range split(arguments, ',') {
+		key, value, found := strings.Cut(argument, "=")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return rule{}, fmt.Errorf(`rule "%s": parameter "%s" must be defined as key=value`, r.name, argument)
+		}
+		r.params[key] = unquote(strings.TrimSpace(value))
+	}
+
+	return r, nil
+}
+
+// split splits the string by the separator outside the parentheses and single quotes.
+func split(s string, separator byte) []string {
+	parts := make([]string, 0)
+	depth := 0
+	isQuoted := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'':
+			isQuoted = !isQuoted
+		case isQuoted:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == separator && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+// list returns the values of the list parameter, e.g. values=draft|published.
+func (r rule) list(key string) []string {
+	value, exists := r.params[key]
+	if !exists {
+		return nil
+	}
+	values := split(value, '|')
+	for i := range values {
+		values[i] = unquote(strings.TrimSpace(values[i]))
+	}
+
+	return values
+}
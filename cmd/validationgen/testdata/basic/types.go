@@ -0,0 +1,68 @@
+package basic
+
+//go:generate go run github.com/muonsoft/validation/cmd/validationgen
+
+import (
+	"context"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+)
+
+type Status string
+
+type Rating int
+
+//validation:generate
+type User struct {
+	Name      string              `json:"name" label:"Full name" validate:"notBlank,length(max=100)"`
+	Email     *string             `json:"email" validate:"notNil,email"`
+	Login     string              `json:"login" validate:"regex(pattern='^[a-z]{3,}$'),regex(pattern='^admin',match=false)"`
+	Role      string              `json:"role" validate:"choice(values=admin|user),groups(admin)"`
+	Status    Status              `json:"status" validate:"notBlank,choice(values=active|blocked)"`
+	Age       *int                `json:"age" validate:"notNil,range(min=18,max=150)"`
+	Rating    Rating              `json:"rating" validate:"range(max=5),choice(values=1|3|5)"`
+	Balance   float64             `json:"balance" validate:"positiveOrZero"`
+	IsActive  bool                `json:"isActive" validate:"true"`
+	Website   string              `json:"website" validate:"url"`
+	BirthDate string              `json:"birthDate" validate:"date"`
+	CreatedAt time.Time           `json:"createdAt" validate:"notBlank"`
+	DeletedAt *time.Time          `json:"deletedAt" validate:"blank"`
+	Tags      []string            `json:"tags" validate:"count(max=3),unique" validateEach:"notBlank,length(min=2)"`
+	Scores    []int               `json:"scores" validateEach:"range(min=0,max=100)"`
+	Address   Address             `json:"address"`
+	Manager   *User               `json:"manager"`
+	Contacts  []Contact           `json:"contacts" validate:"notBlank"`
+	Labels    map[string]string   `json:"labels" validate:"count(max=2)"`
+	Profiles  map[string]*Profile `json:"profiles"`
+	Backups   []*Profile          `json:"backups"`
+	Settings  *Settings           `json:"settings" validate:"notNil"`
+	Note      string              `json:"-" validate:"-"`
+	internal  string
+}
+
+//validation:generate
+type Address struct {
+	City    string `json:"city" validate:"notBlank"`
+	Country string `json:"country" validate:"length(min=2,max=2)"`
+}
+
+//validation:generate
+type Contact struct {
+	Phone string `validate:"regex(pattern='^\\+[0-9]+$')"`
+}
+
+// Profile has the written Validate method, so it is validated without the "valid" rule.
+type Profile struct {
+	Nickname string
+}
+
+func (p Profile) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(ctx, validation.StringProperty("nickname", p.Nickname, it.IsNotBlank()))
+}
+
+// Settings is not validated because it has no Validate method.
+type Settings struct {
+	Theme string
+}
@@ -0,0 +1,152 @@
+package basic_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/cmd/validationgen/testdata/basic"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUser_Validate_WhenValid_ExpectNoViolations(t *testing.T) {
+	email := "user@example.com"
+	age := 30
+
+	err := validator.ValidateIt(context.Background(), basic.User{
+		Name:      "John",
+		Email:     &email,
+		Login:     "john",
+		Status:    "active",
+		Age:       &age,
+		Rating:    5,
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		Address:   basic.Address{City: "Berlin", Country: "DE"},
+		Contacts:  []basic.Contact{{Phone: "+49123"}},
+		Profiles:  map[string]*basic.Profile{"a": nil},
+		Backups:   []*basic.Profile{nil},
+		Settings:  &basic.Settings{},
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestUser_Validate_WhenInvalid_ExpectViolationsAtPaths(t *testing.T) {
+	email := "invalid"
+	age := 10
+	deletedAt := time.Now()
+
+	err := validator.ValidateIt(context.Background(), basic.User{
+		Email:     &email,
+		Login:     "admin",
+		Role:      "guest",
+		Status:    "deleted",
+		Age:       &age,
+		Rating:    4,
+		Balance:   -1,
+		Website:   "example",
+		BirthDate: "01.01.2000",
+		DeletedAt: &deletedAt,
+		Tags:      []string{"go", "go", "", "sql"},
+		Scores:    []int{50, 101},
+		Address:   basic.Address{Country: "DEU"},
+		Manager:   &basic.User{},
+		Contacts:  []basic.Contact{{Phone: "123"}},
+		Labels:    map[string]string{"a": "", "b": "", "c": ""},
+		Profiles:  map[string]*basic.Profile{"main": {}, "empty": nil},
+		Backups:   []*basic.Profile{nil, {}},
+	})
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "name"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidEmail, PropertyPath: "email"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotValid, PropertyPath: "login"},
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "status"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotInRange, PropertyPath: "age"},
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "rating"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotPositiveOrZero, PropertyPath: "balance"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotTrue, PropertyPath: "isActive"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidURL, PropertyPath: "website"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidDate, PropertyPath: "birthDate"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "createdAt"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotBlank, PropertyPath: "deletedAt"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooManyElements, PropertyPath: "tags"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotUnique, PropertyPath: "tags"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "tags[2]"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotInRange, PropertyPath: "scores[1]"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "address.city"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotExactLength, PropertyPath: "address.country"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "manager.name"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsNil, PropertyPath: "manager.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "manager.status"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsNil, PropertyPath: "manager.age"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotTrue, PropertyPath: "manager.isActive"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "manager.createdAt"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "manager.address.city"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "manager.contacts"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsNil, PropertyPath: "manager.settings"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotValid, PropertyPath: "contacts[0].Phone"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooManyElements, PropertyPath: "labels"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "profiles.main.nickname"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "backups[1].nickname"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsNil, PropertyPath: "settings"},
+	)
+}
+
+func TestUser_Validate_WhenGroupIsSet_ExpectGroupConstraintsApplied(t *testing.T) {
+	email := "user@example.com"
+	age := 30
+	user := basic.User{
+		Name:      "John",
+		Email:     &email,
+		Login:     "john",
+		Role:      "guest",
+		Status:    "active",
+		Age:       &age,
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		Address:   basic.Address{City: "Berlin"},
+		Contacts:  []basic.Contact{{Phone: "+49123"}},
+		Settings:  &basic.Settings{},
+	}
+
+	err := validator.WithGroups("admin").ValidateIt(context.Background(), user)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNoSuchChoice).
+		WithPropertyPath("role")
+}
+
+func TestUser_Validate_WhenFieldHasLabel_ExpectLabelInParameters(t *testing.T) {
+	email := "user@example.com"
+	age := 30
+	user := basic.User{
+		Email:     &email,
+		Login:     "john",
+		Status:    "active",
+		Age:       &age,
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		Address:   basic.Address{City: "Berlin"},
+		Contacts:  []basic.Contact{{Phone: "+49123"}},
+		Settings:  &basic.Settings{},
+	}
+
+	err := validator.ValidateIt(context.Background(), user)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrIsBlank).
+		WithPropertyPath("name").
+		Assert(func(tb testing.TB, violation validation.Violation) {
+			tb.Helper()
+			assert.Contains(tb, violation.Parameters(), validation.TemplateParameter{
+				Key:              "{{ label }}",
+				Value:            "Full name",
+				NeedsTranslation: true,
+			})
+		})
+}
@@ -0,0 +1,106 @@
+// Code generated by validationgen. DO NOT EDIT.
+
+package basic
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+)
+
+var (
+	userLoginPattern    = regexp.MustCompile("^[a-z]{3,}$")
+	userLoginPattern2   = regexp.MustCompile("^admin")
+	contactPhonePattern = regexp.MustCompile("^\\+[0-9]+$")
+)
+
+// Validate checks the User by the rules of the struct tags.
+func (u User) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(
+		ctx,
+		validation.StringProperty("name", u.Name, it.IsNotBlank(), it.HasMaxLength(100)).WithLabel("Full name"),
+		validation.NilStringProperty("email", u.Email, it.IsNotNil(), it.IsEmail()),
+		validation.StringProperty("login", u.Login, it.Matches(userLoginPattern), it.DoesNotMatch(userLoginPattern2)),
+		validation.StringProperty("role", u.Role, it.IsOneOf("admin", "user").WhenGroups("admin")),
+		validation.StringProperty("status", string(u.Status), it.IsNotBlank(), it.IsOneOf("active", "blocked")),
+		validation.NilNumberProperty[int]("age", u.Age, it.IsNotNilNumber[int](), it.IsBetween[int](18, 150)),
+		validation.NumberProperty[Rating]("rating", u.Rating, it.IsLessThanOrEqual[Rating](5), it.IsOneOf[Rating](1, 3, 5)),
+		validation.NumberProperty[float64]("balance", u.Balance, it.IsPositiveOrZero[float64]()),
+		validation.BoolProperty("isActive", u.IsActive, it.IsTrue()),
+		validation.StringProperty("website", u.Website, it.IsURL()),
+		validation.StringProperty("birthDate", u.BirthDate, it.IsDate()),
+		validation.TimeProperty("createdAt", u.CreatedAt, it.IsNotBlank()),
+		validation.NilTimeProperty("deletedAt", u.DeletedAt, it.IsBlank()),
+		validation.CountableProperty("tags", len(u.Tags), it.HasMaxCount(3)),
+		validation.ComparablesProperty[string]("tags", u.Tags, it.HasUniqueValues[string]()),
+		validation.EachStringProperty("tags", u.Tags, it.IsNotBlank(), it.HasMinLength(2)),
+		validation.EachNumberProperty[int]("scores", u.Scores, it.IsBetween[int](0, 100)),
+		validation.ValidProperty("address", u.Address),
+		validation.ValidProperty("manager", u.Manager).When(u.Manager != nil),
+		validation.CountableProperty("contacts", len(u.Contacts), it.IsNotBlank()),
+		validation.ValidSliceProperty("contacts", u.Contacts),
+		validation.CountableProperty("labels", len(u.Labels), it.HasMaxCount(2)),
+		validPointerMapProperty("profiles", u.Profiles),
+		validPointerSliceProperty("backups", u.Backups),
+		validation.NilProperty("settings", u.Settings == nil, it.IsNotNil()),
+	)
+}
+
+// Validate checks the Address by the rules of the struct tags.
+func (a Address) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(
+		ctx,
+		validation.StringProperty("city", a.City, it.IsNotBlank()),
+		validation.StringProperty("country", a.Country, it.HasExactLength(2)),
+	)
+}
+
+// Validate checks the Contact by the rules of the struct tags.
+func (c Contact) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(
+		ctx,
+		validation.StringProperty("Phone", c.Phone, it.Matches(contactPhonePattern)),
+	)
+}
+
+// validPointerSliceProperty validates the not nil elements of the slice by their Validate method.
+func validPointerSliceProperty[T any, P interface {
+	*T
+	validation.Validatable
+}](name string, values []P) validation.ValidatorArgument {
+	return validation.NewArgument(func(ctx context.Context, validator *validation.Validator) (*validation.ViolationList, error) {
+		violations := validation.NewViolationList()
+		for i, value := range values {
+			if value == nil {
+				continue
+			}
+			if err := violations.AppendFromError(value.Validate(ctx, validator.AtIndex(i))); err != nil {
+				return nil, err
+			}
+		}
+
+		return violations, nil
+	}).At(validation.PropertyName(name))
+}
+
+// validPointerMapProperty validates the not nil elements of the map by their Validate method.
+func validPointerMapProperty[T any, P interface {
+	*T
+	validation.Validatable
+}](name string, values map[string]P) validation.ValidatorArgument {
+	return validation.NewArgument(func(ctx context.Context, validator *validation.Validator) (*validation.ViolationList, error) {
+		violations := validation.NewViolationList()
+		for key, value := range values {
+			if value == nil {
+				continue
+			}
+			if err := violations.AppendFromError(value.Validate(ctx, validator.AtProperty(key))); err != nil {
+				return nil, err
+			}
+		}
+
+		return violations, nil
+	}).At(validation.PropertyName(name))
+}
@@ -0,0 +1,37 @@
+package enums
+
+//go:generate go run github.com/muonsoft/validation/cmd/validationgen
+
+//validation:enum
+type Status string
+
+const (
+	StatusDraft     Status = "draft"
+	StatusPublished Status = "published"
+	StatusArchived  Status = "archived"
+)
+
+// StatusDeleted is declared by the conversion.
+const StatusDeleted = Status("deleted")
+
+//validation:enum
+type Priority int
+
+const (
+	PriorityLow Priority = iota + 1
+	PriorityMedium
+	_
+	PriorityHigh
+)
+
+// DefaultPriority is not a constant of the Priority type.
+const DefaultPriority = 2
+
+//validation:generate
+type Task struct {
+	Title    string   `json:"title" validate:"notBlank"`
+	Status   Status   `json:"status" validate:"notBlank,enum"`
+	Previous *Status  `json:"previous" validate:"enum,groups(history)"`
+	Priority Priority `json:"priority" validate:"enum"`
+	Statuses []Status `json:"statuses" validate:"count(max=2)" validateEach:"enum"`
+}
@@ -0,0 +1,66 @@
+package enums_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/cmd/validationgen/testdata/enums"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus_Values(t *testing.T) {
+	assert.Equal(t, []enums.Status{"draft", "published", "archived", "deleted"}, enums.Status("").Values())
+	assert.Equal(t, []enums.Priority{1, 2, 4}, enums.Priority(0).Values())
+}
+
+func TestStatus_IsValid(t *testing.T) {
+	assert.True(t, enums.StatusArchived.IsValid())
+	assert.True(t, enums.StatusDeleted.IsValid())
+	assert.False(t, enums.Status("removed").IsValid())
+	assert.True(t, enums.PriorityHigh.IsValid())
+	assert.False(t, enums.Priority(3).IsValid())
+}
+
+func TestIsStatus_WhenValueIsInvalid_ExpectChoicesInMessage(t *testing.T) {
+	err := validator.Validate(context.Background(), validation.Comparable(enums.Status("removed"), enums.IsStatus()))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNoSuchChoice).
+		WithMessage("The value you selected is not a valid choice.")
+}
+
+func TestTask_Validate_WhenInvalid_ExpectViolationsAtPaths(t *testing.T) {
+	previous := enums.Status("removed")
+	task := enums.Task{
+		Title:    "Release",
+		Status:   "unknown",
+		Previous: &previous,
+		Priority: 3,
+		Statuses: []enums.Status{enums.StatusDraft, "unknown"},
+	}
+
+	err := validator.WithGroups(validation.DefaultGroup, "history").ValidateIt(context.Background(), task)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "status"},
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "previous"},
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "priority"},
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "statuses[1]"},
+	)
+}
+
+func TestTask_Validate_WhenValid_ExpectNoViolations(t *testing.T) {
+	task := enums.Task{
+		Title:    "Release",
+		Status:   enums.StatusPublished,
+		Priority: enums.PriorityHigh,
+		Statuses: []enums.Status{enums.StatusDraft, enums.StatusPublished},
+	}
+
+	err := validator.ValidateIt(context.Background(), task)
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,64 @@
+// Code generated by validationgen. DO NOT EDIT.
+
+package enums
+
+import (
+	"context"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+)
+
+// Values returns the values of the Status in order of declaration.
+func (Status) Values() []Status {
+	return []Status{StatusDraft, StatusPublished, StatusArchived, StatusDeleted}
+}
+
+// IsValid checks that the value is one of the Status constants.
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusDraft, StatusPublished, StatusArchived, StatusDeleted:
+		return true
+	}
+
+	return false
+}
+
+// IsStatus creates a constraint to check that the value is one of the Status constants.
+func IsStatus() it.ChoiceConstraint[Status] {
+	return it.IsOneOf(StatusDraft, StatusPublished, StatusArchived, StatusDeleted)
+}
+
+// Values returns the values of the Priority in order of declaration.
+func (Priority) Values() []Priority {
+	return []Priority{PriorityLow, PriorityMedium, PriorityHigh}
+}
+
+// IsValid checks that the value is one of the Priority constants.
+func (p Priority) IsValid() bool {
+	switch p {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		return true
+	}
+
+	return false
+}
+
+// IsPriority creates a constraint to check that the value is one of the Priority constants.
+func IsPriority() it.ChoiceConstraint[Priority] {
+	return it.IsOneOf(PriorityLow, PriorityMedium, PriorityHigh)
+}
+
+// Validate checks the Task by the rules of the struct tags.
+func (t Task) Validate(ctx context.Context, validator *validation.Validator) error {
+	return validator.Validate(
+		ctx,
+		validation.StringProperty("title", t.Title, it.IsNotBlank()),
+		validation.StringProperty("status", string(t.Status), it.IsNotBlank()),
+		validation.ComparableProperty[Status]("status", t.Status, IsStatus()),
+		validation.NilComparableProperty[Status]("previous", t.Previous, IsStatus().WhenGroups("history")),
+		validation.ComparableProperty[Priority]("priority", t.Priority, IsPriority()),
+		validation.CountableProperty("statuses", len(t.Statuses), it.HasMaxCount(2)),
+		validation.EachComparableProperty[Status]("statuses", t.Statuses, IsStatus()),
+	)
+}
@@ -65,7 +65,10 @@ type StringFuncConstraint struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters TemplateParameterList
+	kind              string
+	parameters        map[string]any
 }
 
 // OfStringBy creates a new string constraint from a function with signature func(string) bool.
@@ -89,10 +92,39 @@ func (c StringFuncConstraint) WithError(err error) StringFuncConstraint {
 //	{{ value }} - the current (invalid) value.
 func (c StringFuncConstraint) WithMessage(template string, parameters ...TemplateParameter) StringFuncConstraint {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// WithDefaultMessage sets the default violation message template. Unlike [StringFuncConstraint.WithMessage],
+// the template is not marked as explicit, so it is replaced by the [OverrideMessage] and [MapError] options.
+// It is used by the constraints built on top of [OfStringBy] to set the default message of their error.
+func (c StringFuncConstraint) WithDefaultMessage(template string) StringFuncConstraint {
+	c.messageTemplate = template
+	c.isCustomMessage = false
+	return c
+}
+
+// WithDescription sets the kind and the parameters of the rule checked by the function (see [Describable]).
+func (c StringFuncConstraint) WithDescription(kind string, parameters map[string]any) StringFuncConstraint {
+	c.kind = kind
+	c.parameters = parameters
+	return c
+}
+
+// Describe returns the description of the constraint. The kind and the parameters are set
+// by [StringFuncConstraint.WithDescription], the kind is empty if it is not set.
+func (c StringFuncConstraint) Describe() ConstraintDescription {
+	return ConstraintDescription{
+		Kind:       c.kind,
+		Parameters: c.parameters,
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c StringFuncConstraint) When(condition bool) StringFuncConstraint {
@@ -112,11 +144,13 @@ func (c StringFuncConstraint) ValidateString(ctx context.Context, validator *Val
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
 				TemplateParameter{Key: "{{ value }}", Value: *value},
 			)...,
 		).
 		WithParameter("{{ value }}", *value).
+		WithInvalidValue(*value).
 		Create()
 }
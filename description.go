@@ -0,0 +1,38 @@
+package validation
+
+// ConstraintDescription describes the rule checked by a constraint and its configuration.
+// It can be used to introspect constraints, for example, to generate documentation, to export
+// validation rules into JSON Schema (see [github.com/muonsoft/validation/jsonschema] package)
+// or for debugging purposes.
+type ConstraintDescription struct {
+	// Kind is a stable name of the rule (e.g. "length", "regex" or "email").
+	// Kinds of the built-in constraints are listed in the documentation of their Describe methods.
+	Kind string
+
+	// Parameters are the parameters of the rule (e.g. "min" and "max" for the "length" rule).
+	Parameters map[string]any
+
+	// Groups are the validation groups of the constraint (see [Validator.WithGroups]).
+	Groups []string
+
+	// Ignored is true if the constraint is disabled by the When method.
+	Ignored bool
+
+	// Violations describe the violations that can be produced by the constraint.
+	Violations []ViolationDescription
+}
+
+// ViolationDescription describes the violation that can be produced by a constraint.
+type ViolationDescription struct {
+	// Err is the underlying error of the violation.
+	Err error
+
+	// MessageTemplate is the template for rendering the violation message.
+	MessageTemplate string
+}
+
+// Describable is implemented by the constraints that are able to describe their rules.
+// All the constraints of the [github.com/muonsoft/validation/it] package implement it.
+type Describable interface {
+	Describe() ConstraintDescription
+}
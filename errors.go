@@ -4,83 +4,234 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/muonsoft/validation/message"
 )
 
 var (
-	ErrInvalidDate       = NewError("invalid date", message.InvalidDate)
-	ErrInvalidDateTime   = NewError("invalid datetime", message.InvalidDateTime)
-	ErrInvalidEAN13      = NewError("invalid EAN-13", message.InvalidEAN13)
-	ErrInvalidEAN8       = NewError("invalid EAN-8", message.InvalidEAN8)
-	ErrInvalidEmail      = NewError("invalid email", message.InvalidEmail)
-	ErrInvalidHostname   = NewError("invalid hostname", message.InvalidHostname)
-	ErrInvalidIP         = NewError("invalid IP address", message.InvalidIP)
-	ErrInvalidJSON       = NewError("invalid JSON", message.InvalidJSON)
-	ErrInvalidTime       = NewError("invalid time", message.InvalidTime)
-	ErrInvalidULID       = NewError("invalid ULID", message.InvalidULID)
-	ErrInvalidUPCA       = NewError("invalid UPC-A", message.InvalidUPCA)
-	ErrInvalidUPCE       = NewError("invalid UPC-E", message.InvalidUPCE)
-	ErrInvalidURL        = NewError("invalid URL", message.InvalidURL)
-	ErrInvalidUUID       = NewError("invalid UUID", message.InvalidUUID)
-	ErrIsBlank           = NewError("is blank", message.IsBlank)
-	ErrIsEqual           = NewError("is equal", message.IsEqual)
-	ErrIsNil             = NewError("is nil", message.IsNil)
-	ErrNoSuchChoice      = NewError("no such choice", message.NoSuchChoice)
-	ErrNotBlank          = NewError("is not blank", message.NotBlank)
-	ErrNotDivisible      = NewError("is not divisible", message.NotDivisible)
-	ErrNotDivisibleCount = NewError("not divisible count", message.NotDivisibleCount)
-	ErrNotEqual          = NewError("is not equal", message.NotEqual)
-	ErrNotExactCount     = NewError("not exact count", message.NotExactCount)
-	ErrNotExactLength    = NewError("not exact length", message.NotExactLength)
-	ErrNotFalse          = NewError("is not false", message.NotFalse)
-	ErrNotInRange        = NewError("is not in range", message.NotInRange)
-	ErrNotInteger        = NewError("is not an integer", message.NotInteger)
-	ErrNotNegative       = NewError("is not negative", message.NotNegative)
-	ErrNotNegativeOrZero = NewError("is not negative or zero", message.NotNegativeOrZero)
-	ErrNotNil            = NewError("is not nil", message.NotNil)
-	ErrNotNumeric        = NewError("is not numeric", message.NotNumeric)
-	ErrNotPositive       = NewError("is not positive", message.NotPositive)
-	ErrNotPositiveOrZero = NewError("is not positive or zero", message.NotPositiveOrZero)
-	ErrNotTrue           = NewError("is not true", message.NotTrue)
-	ErrNotUnique         = NewError("is not unique", message.NotUnique)
-	ErrNotValid          = NewError("is not valid", message.NotValid)
-	ErrProhibitedIP      = NewError("is prohibited IP", message.ProhibitedIP)
-	ErrProhibitedURL     = NewError("is prohibited URL", message.ProhibitedURL)
-	ErrTooEarly          = NewError("is too early", message.TooEarly)
-	ErrTooEarlyOrEqual   = NewError("is too early or equal", message.TooEarlyOrEqual)
-	ErrTooFewElements    = NewError("too few elements", message.TooFewElements)
-	ErrTooHigh           = NewError("is too high", message.TooHigh)
-	ErrTooHighOrEqual    = NewError("is too high or equal", message.TooHighOrEqual)
-	ErrTooLate           = NewError("is too late", message.TooLate)
-	ErrTooLateOrEqual    = NewError("is too late or equal", message.TooLateOrEqual)
-	ErrTooLong           = NewError("is too long", message.TooLong)
-	ErrTooLow            = NewError("is too low", message.TooLow)
-	ErrTooLowOrEqual     = NewError("is too low or equal", message.TooLowOrEqual)
-	ErrTooManyElements   = NewError("too many elements", message.TooManyElements)
-	ErrTooShort          = NewError("is too short", message.TooShort)
+	ErrInvalidDate       = NewCodedError("validation.invalidDate", "invalid date", message.InvalidDate)
+	ErrInvalidDateTime   = NewCodedError("validation.invalidDateTime", "invalid datetime", message.InvalidDateTime)
+	ErrInvalidEAN13      = NewCodedError("validation.invalidEAN13", "invalid EAN-13", message.InvalidEAN13)
+	ErrInvalidEAN8       = NewCodedError("validation.invalidEAN8", "invalid EAN-8", message.InvalidEAN8)
+	ErrInvalidEmail      = NewCodedError("validation.invalidEmail", "invalid email", message.InvalidEmail)
+	ErrInvalidHostname   = NewCodedError("validation.invalidHostname", "invalid hostname", message.InvalidHostname)
+	ErrInvalidIP         = NewCodedError("validation.invalidIP", "invalid IP address", message.InvalidIP)
+	ErrInvalidJSON       = NewCodedError("validation.invalidJSON", "invalid JSON", message.InvalidJSON)
+	ErrInvalidTime       = NewCodedError("validation.invalidTime", "invalid time", message.InvalidTime)
+	ErrInvalidType       = NewCodedError("validation.invalidType", "invalid type", message.InvalidType)
+	ErrInvalidULID       = NewCodedError("validation.invalidULID", "invalid ULID", message.InvalidULID)
+	ErrInvalidUPCA       = NewCodedError("validation.invalidUPCA", "invalid UPC-A", message.InvalidUPCA)
+	ErrInvalidUPCE       = NewCodedError("validation.invalidUPCE", "invalid UPC-E", message.InvalidUPCE)
+	ErrInvalidURL        = NewCodedError("validation.invalidURL", "invalid URL", message.InvalidURL)
+	ErrInvalidUUID       = NewCodedError("validation.invalidUUID", "invalid UUID", message.InvalidUUID)
+	ErrIsBlank           = NewCodedError("validation.isBlank", "is blank", message.IsBlank)
+	ErrIsEqual           = NewCodedError("validation.isEqual", "is equal", message.IsEqual)
+	ErrIsNil             = NewCodedError("validation.isNil", "is nil", message.IsNil)
+	ErrMissingProperty   = NewCodedError("validation.missingProperty", "missing property", message.MissingProperty)
+	ErrNoSuchChoice      = NewCodedError("validation.noSuchChoice", "no such choice", message.NoSuchChoice)
+	ErrNotBlank          = NewCodedError("validation.notBlank", "is not blank", message.NotBlank)
+	ErrNotDivisible      = NewCodedError("validation.notDivisible", "is not divisible", message.NotDivisible)
+	ErrNotDivisibleCount = NewCodedError("validation.notDivisibleCount", "not divisible count", message.NotDivisibleCount)
+	ErrNotEqual          = NewCodedError("validation.notEqual", "is not equal", message.NotEqual)
+	ErrNotExactCount     = NewCodedError("validation.notExactCount", "not exact count", message.NotExactCount)
+	ErrNotExactLength    = NewCodedError("validation.notExactLength", "not exact length", message.NotExactLength)
+	ErrNotFalse          = NewCodedError("validation.notFalse", "is not false", message.NotFalse)
+	ErrNotInRange        = NewCodedError("validation.notInRange", "is not in range", message.NotInRange)
+	ErrNotInteger        = NewCodedError("validation.notInteger", "is not an integer", message.NotInteger)
+	ErrNotNegative       = NewCodedError("validation.notNegative", "is not negative", message.NotNegative)
+	ErrNotNegativeOrZero = NewCodedError("validation.notNegativeOrZero", "is not negative or zero", message.NotNegativeOrZero)
+	ErrNotNil            = NewCodedError("validation.notNil", "is not nil", message.NotNil)
+	ErrNotNumeric        = NewCodedError("validation.notNumeric", "is not numeric", message.NotNumeric)
+	ErrNotPositive       = NewCodedError("validation.notPositive", "is not positive", message.NotPositive)
+	ErrNotPositiveOrZero = NewCodedError("validation.notPositiveOrZero", "is not positive or zero", message.NotPositiveOrZero)
+	ErrNotSatisfied      = NewCodedError("validation.notSatisfied", "is not satisfied", message.NotSatisfied)
+	ErrNotTrue           = NewCodedError("validation.notTrue", "is not true", message.NotTrue)
+	ErrNotUnique         = NewCodedError("validation.notUnique", "is not unique", message.NotUnique)
+	ErrNotValid          = NewCodedError("validation.notValid", "is not valid", message.NotValid)
+	ErrProhibitedIP      = NewCodedError("validation.prohibitedIP", "is prohibited IP", message.ProhibitedIP)
+	ErrProhibitedURL     = NewCodedError("validation.prohibitedURL", "is prohibited URL", message.ProhibitedURL)
+	ErrTooEarly          = NewCodedError("validation.tooEarly", "is too early", message.TooEarly)
+	ErrTooEarlyOrEqual   = NewCodedError("validation.tooEarlyOrEqual", "is too early or equal", message.TooEarlyOrEqual)
+	ErrTooFewElements    = NewCodedError("validation.tooFewElements", "too few elements", message.TooFewElements)
+	ErrTooHigh           = NewCodedError("validation.tooHigh", "is too high", message.TooHigh)
+	ErrTooHighOrEqual    = NewCodedError("validation.tooHighOrEqual", "is too high or equal", message.TooHighOrEqual)
+	ErrTooLate           = NewCodedError("validation.tooLate", "is too late", message.TooLate)
+	ErrTooLateOrEqual    = NewCodedError("validation.tooLateOrEqual", "is too late or equal", message.TooLateOrEqual)
+	ErrTooLong           = NewCodedError("validation.tooLong", "is too long", message.TooLong)
+	ErrTooLow            = NewCodedError("validation.tooLow", "is too low", message.TooLow)
+	ErrTooLowOrEqual     = NewCodedError("validation.tooLowOrEqual", "is too low or equal", message.TooLowOrEqual)
+	ErrTooManyElements   = NewCodedError("validation.tooManyElements", "too many elements", message.TooManyElements)
+	ErrTooShort          = NewCodedError("validation.tooShort", "is too short", message.TooShort)
+	ErrUnknownProperty   = NewCodedError("validation.unknownProperty", "unknown property", message.UnknownProperty)
 )
 
 // Error is a base type for static validation error used as an underlying error for [Violation].
 // It can be used to programmatically test for a specific violation.
-// Error code values are protected by backward compatibility rules, message values are not protected.
+// Error code and description values are protected by backward compatibility rules,
+// message values are not protected.
+//
+// The code is a stable machine-readable identifier of the error (e.g. "validation.isBlank").
+// Errors can be registered by the [RegisterErrors] function to be found
+// by the code via [LookupError], e.g. after deserialization of violations.
 type Error struct {
-	code    string
-	message string
+	code        string
+	description string
+	message     string
 }
 
 // NewError creates a static validation error. It should be used to create only package-level errors.
-func NewError(code string, message string) *Error {
-	return &Error{code: code, message: message}
+// The description is also used as the error code. Use [NewCodedError] to set a separate
+// stable code.
+func NewError(description string, message string) *Error {
+	return &Error{code: description, description: description, message: message}
 }
 
-// Error returns error code. This code is protected by backward compatibility rules.
-func (err *Error) Error() string { return err.code }
+// NewCodedError creates a static validation error with the stable machine-readable code.
+// It should be used to create only package-level errors. It is recommended to use namespaced
+// codes (e.g. "app.invalidCoupon") to avoid collisions with the codes of other packages.
+func NewCodedError(code, description, message string) *Error {
+	return &Error{code: code, description: description, message: message}
+}
+
+// Error returns the error description. This description is protected by backward compatibility rules.
+func (err *Error) Error() string { return err.description }
+
+// Code returns the stable machine-readable error code. This code is protected by backward compatibility rules.
+func (err *Error) Code() string { return err.code }
 
 // Message returns message template that will be shown to the end user.
 // Be aware. This message is not protected by backward compatibility rules and may be changed even in patch versions.
 func (err *Error) Message() string { return err.message }
 
+var errorRegistry = newErrorRegistry(
+	ErrInvalidDate,
+	ErrInvalidDateTime,
+	ErrInvalidEAN13,
+	ErrInvalidEAN8,
+	ErrInvalidEmail,
+	ErrInvalidHostname,
+	ErrInvalidIP,
+	ErrInvalidJSON,
+	ErrInvalidTime,
+	ErrInvalidType,
+	ErrInvalidULID,
+	ErrInvalidUPCA,
+	ErrInvalidUPCE,
+	ErrInvalidURL,
+	ErrInvalidUUID,
+	ErrIsBlank,
+	ErrIsEqual,
+	ErrIsNil,
+	ErrMissingProperty,
+	ErrNoSuchChoice,
+	ErrNotBlank,
+	ErrNotDivisible,
+	ErrNotDivisibleCount,
+	ErrNotEqual,
+	ErrNotExactCount,
+	ErrNotExactLength,
+	ErrNotFalse,
+	ErrNotInRange,
+	ErrNotInteger,
+	ErrNotNegative,
+	ErrNotNegativeOrZero,
+	ErrNotNil,
+	ErrNotNumeric,
+	ErrNotPositive,
+	ErrNotPositiveOrZero,
+	ErrNotSatisfied,
+	ErrNotTrue,
+	ErrNotUnique,
+	ErrNotValid,
+	ErrProhibitedIP,
+	ErrProhibitedURL,
+	ErrTooEarly,
+	ErrTooEarlyOrEqual,
+	ErrTooFewElements,
+	ErrTooHigh,
+	ErrTooHighOrEqual,
+	ErrTooLate,
+	ErrTooLateOrEqual,
+	ErrTooLong,
+	ErrTooLow,
+	ErrTooLowOrEqual,
+	ErrTooManyElements,
+	ErrTooShort,
+	ErrUnknownProperty,
+)
+
+// RegisterErrors registers application errors so they can be found by the code
+// via [LookupError]. All built-in errors are registered by default.
+// It returns an error if another error with the same code is already registered,
+// in this case none of the errors is registered.
+// It is safe to register the same error more than once.
+func RegisterErrors(errs ...*Error) error {
+	return errorRegistry.register(errs...)
+}
+
+// LookupError returns a registered error by its code. See [Error.Code] and [RegisterErrors].
+func LookupError(code string) (*Error, bool) {
+	return errorRegistry.lookup(code)
+}
+
+type errorRegistryMap struct {
+	mu     sync.RWMutex
+	errors map[string]*Error
+}
+
+func newErrorRegistry(errs ...*Error) *errorRegistryMap {
+	registry := &errorRegistryMap{errors: make(map[string]*Error, len(errs))}
+	for _, err := range errs {
+		registry.errors[err.code] = err
+	}
+
+	return registry
+}
+
+func (registry *errorRegistryMap) register(errs ...*Error) error {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	for i, err := range errs {
+		if err == nil {
+			return fmt.Errorf("register error at %d: error is nil", i)
+		}
+		if registered, exists := registry.errors[err.code]; exists && registered != err {
+			return fmt.Errorf(`register error at %d: error with code "%s" is already registered`, i, err.code)
+		}
+		for _, previous := range errs[:i] {
+			if previous.code == err.code && previous != err {
+				return fmt.Errorf(`register error at %d: duplicate error code "%s"`, i, err.code)
+			}
+		}
+	}
+	for _, err := range errs {
+		registry.errors[err.code] = err
+	}
+
+	return nil
+}
+
+func (registry *errorRegistryMap) lookup(code string) (*Error, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	err, exists := registry.errors[code]
+
+	return err, exists
+}
+
+// errorCode returns the stable code of the underlying [Error] or an empty string.
+func errorCode(err error) string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.code
+	}
+
+	return ""
+}
+
 // ConstraintError is used to return critical error from constraint that immediately
 // stops the validation process. It is recommended to use [Validator.CreateConstraintError] method
 // to initiate an error from current validation context.
@@ -101,15 +252,23 @@ func (err *ConstraintError) Error() string {
 	return s.String()
 }
 
-// ConstraintNotFoundError is returned when trying to get a constraint
-// from the validator store using a non-existent key.
+// ConstraintNotFoundError is returned when trying to get a constraint by a non-existent key
+// (e.g. from the registry of named constraints of the [github.com/muonsoft/validation/rules] package).
+// The Type is optional, it is the type of the constraint if the key is unique only within the type.
 type ConstraintNotFoundError struct {
 	Key  string
 	Type string
 }
 
 func (err *ConstraintNotFoundError) Error() string {
+	if err.Type == "" {
+		return fmt.Sprintf(`constraint by key "%s" is not found`, err.Key)
+	}
+
 	return fmt.Sprintf(`constraint by key "%s" of type "%s" is not found`, err.Key, err.Type)
 }
 
-var errTranslatorOptionsDenied = errors.New("translation options denied when using custom translator")
+var (
+	errTranslatorOptionsDenied = errors.New("translation options denied when using custom translator")
+	errFactoryOptionsDenied    = errors.New("violation factory options denied when using custom violation factory")
+)
@@ -0,0 +1,96 @@
+package validation_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupError_WhenBuiltinError_ExpectErrorFound(t *testing.T) {
+	tests := []*validation.Error{
+		validation.ErrIsBlank,
+		validation.ErrTooShort,
+		validation.ErrInvalidEmail,
+		validation.ErrNotUnique,
+	}
+	for _, expected := range tests {
+		t.Run(expected.Code(), func(t *testing.T) {
+			err, found := validation.LookupError(expected.Code())
+
+			assert.True(t, found)
+			assert.Same(t, expected, err)
+		})
+	}
+}
+
+func TestError_WhenBuiltinError_ExpectStableCodeAndDescription(t *testing.T) {
+	assert.Equal(t, "validation.isBlank", validation.ErrIsBlank.Code())
+	assert.Equal(t, "is blank", validation.ErrIsBlank.Error())
+}
+
+func TestNewError_ExpectDescriptionUsedAsCode(t *testing.T) {
+	err := validation.NewError("custom error", "message")
+
+	assert.Equal(t, "custom error", err.Code())
+	assert.Equal(t, "custom error", err.Error())
+}
+
+func TestRegisterErrors_WhenNewErrors_ExpectErrorsFound(t *testing.T) {
+	errFirst := validation.NewCodedError("test.registerFirst", "first", "message")
+	errSecond := validation.NewCodedError("test.registerSecond", "second", "message")
+
+	err := validation.RegisterErrors(errFirst, errSecond)
+
+	require.NoError(t, err)
+	found, exists := validation.LookupError("test.registerSecond")
+	assert.True(t, exists)
+	assert.Same(t, errSecond, found)
+	assert.NoError(t, validation.RegisterErrors(errFirst), "registering the same error twice")
+}
+
+func TestRegisterErrors_WhenCodeConflict_ExpectErrorAndNothingRegistered(t *testing.T) {
+	errNew := validation.NewCodedError("test.conflictNew", "new", "message")
+	errConflict := validation.NewCodedError("validation.isBlank", "conflict", "message")
+
+	err := validation.RegisterErrors(errNew, errConflict)
+
+	assert.EqualError(t, err, `register error at 1: error with code "validation.isBlank" is already registered`)
+	_, exists := validation.LookupError("test.conflictNew")
+	assert.False(t, exists)
+	found, _ := validation.LookupError("validation.isBlank")
+	assert.Same(t, validation.ErrIsBlank, found)
+}
+
+func TestRegisterErrors_WhenDuplicateCodesInArguments_ExpectError(t *testing.T) {
+	err := validation.RegisterErrors(
+		validation.NewCodedError("test.duplicate", "first", "message"),
+		validation.NewCodedError("test.duplicate", "second", "message"),
+	)
+
+	assert.EqualError(t, err, `register error at 1: duplicate error code "test.duplicate"`)
+}
+
+func TestLookupError_WhenUnknownCode_ExpectNotFound(t *testing.T) {
+	err, found := validation.LookupError("test.unknown")
+
+	assert.False(t, found)
+	assert.Nil(t, err)
+}
+
+func TestViolation_MarshalJSON_WhenCodedError_ExpectCodeAndError(t *testing.T) {
+	violation := newValidator(t).CreateViolation(
+		context.Background(),
+		validation.ErrIsBlank,
+		"message",
+		validation.PropertyName("name"),
+	)
+
+	data, err := json.Marshal(violation)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"code": "validation.isBlank", "error": "is blank", "message": "message", "propertyPath": "name"}`, string(data))
+}
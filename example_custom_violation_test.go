@@ -31,21 +31,29 @@ var ErrIsEmpty = &DomainError{ID: "IsEmpty", Message: "Value is empty."}
 type DomainViolation struct {
 	id string // id passed from DomainError
 
+	// violation created by the built-in factory is used to render the message in other languages
+	violation validation.Violation
+
 	// required fields for implementing validation.Violation
 	err             error
 	message         string
 	messageTemplate string
 	parameters      []validation.TemplateParameter
+	invalidValue    any
 	propertyPath    *validation.PropertyPath
+	language        language.Tag
 }
 
 func (v *DomainViolation) Unwrap() error                              { return v.err }
 func (v *DomainViolation) Is(target error) bool                       { return errors.Is(v.err, target) }
 func (v *DomainViolation) Error() string                              { return v.err.Error() }
 func (v *DomainViolation) Message() string                            { return v.message }
+func (v *DomainViolation) MessageIn(tag language.Tag) string          { return v.violation.MessageIn(tag) }
 func (v *DomainViolation) MessageTemplate() string                    { return v.messageTemplate }
 func (v *DomainViolation) Parameters() []validation.TemplateParameter { return v.parameters }
+func (v *DomainViolation) InvalidValue() any                          { return v.invalidValue }
 func (v *DomainViolation) PropertyPath() *validation.PropertyPath     { return v.propertyPath }
+func (v *DomainViolation) Language() language.Tag                     { return v.language }
 
 // pathAsJSONPointer formats property path according to a JSON Pointer Syntax https://tools.ietf.org/html/rfc6901
 func (v *DomainViolation) pathAsJSONPointer() string {
@@ -86,7 +94,7 @@ func NewDomainViolationFactory() (*DomainViolationFactory, error) {
 	return &DomainViolationFactory{factory: validation.NewViolationFactory(translator)}, nil
 }
 
-func (factory *DomainViolationFactory) CreateViolation(err error, messageTemplate string, pluralCount int, parameters []validation.TemplateParameter, propertyPath *validation.PropertyPath, lang language.Tag) validation.Violation {
+func (factory *DomainViolationFactory) CreateViolation(err error, messageTemplate string, pluralCount int, parameters []validation.TemplateParameter, invalidValue any, propertyPath *validation.PropertyPath, lang language.Tag) validation.Violation {
 	// extracting error ID from err if it implements DomainError
 	id := ""
 	var domainErr *DomainError
@@ -94,15 +102,18 @@ func (factory *DomainViolationFactory) CreateViolation(err error, messageTemplat
 		id = domainErr.ID
 	}
 
-	violation := factory.factory.CreateViolation(err, messageTemplate, pluralCount, parameters, propertyPath, lang)
+	violation := factory.factory.CreateViolation(err, messageTemplate, pluralCount, parameters, invalidValue, propertyPath, lang)
 
 	return &DomainViolation{
 		id:              id,
+		violation:       violation,
 		err:             err,
 		message:         violation.Message(),
 		messageTemplate: violation.MessageTemplate(),
 		parameters:      violation.Parameters(),
+		invalidValue:    violation.InvalidValue(),
 		propertyPath:    violation.PropertyPath(),
+		language:        violation.Language(),
 	}
 }
 
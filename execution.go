@@ -158,13 +158,7 @@ func validateEachComparable[T comparable](values []T, constraints []ComparableCo
 
 func validateIt(value Validatable) ValidateFunc {
 	return func(ctx context.Context, validator *Validator) (*ViolationList, error) {
-		err := value.Validate(ctx, validator)
-		violations, ok := UnwrapViolationList(err)
-		if ok {
-			return violations, nil
-		}
-
-		return nil, err
+		return unwrapViolationList(value.Validate(ctx, validator))
 	}
 }
 
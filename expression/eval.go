@@ -0,0 +1,423 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/muonsoft/validation/internal/dynamic"
+)
+
+type node interface {
+	eval(variables any) (any, error)
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n *literalNode) eval(any) (any, error) {
+	return n.value, nil
+}
+
+type variableNode struct {
+	name string
+}
+
+func (n *variableNode) eval(variables any) (any, error) {
+	return property(variables, n.name)
+}
+
+type memberNode struct {
+	object   node
+	property node
+}
+
+func (n *memberNode) eval(variables any) (any, error) {
+	object, err := n.object.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+	key, err := n.property.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := key.(type) {
+	case string:
+		return property(object, k)
+	case float64:
+		return element(object, k)
+	}
+
+	return nil, fmt.Errorf("%w: invalid key %s", ErrEvaluation, describe(key))
+}
+
+type listNode struct {
+	elements []node
+}
+
+func (n *listNode) eval(variables any) (any, error) {
+	values := make([]any, len(n.elements))
+	for i, element := range n.elements {
+		value, err := element.eval(variables)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+type lenNode struct {
+	argument node
+}
+
+func (n *lenNode) eval(variables any) (any, error) {
+	value, err := n.argument.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return float64(0), nil
+	}
+	if s, ok := value.(string); ok {
+		return float64(utf8.RuneCountInString(s)), nil
+	}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice || v.Kind() == reflect.Array || v.Kind() == reflect.Map {
+		return float64(v.Len()), nil
+	}
+
+	return nil, fmt.Errorf("%w: len of %s", ErrEvaluation, describe(value))
+}
+
+type unaryNode struct {
+	operator string
+	operand  node
+}
+
+func (n *unaryNode) eval(variables any) (any, error) {
+	value, err := n.operand.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.operator == "!" {
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("%w: operator ! on %s", ErrEvaluation, describe(value))
+		}
+		return !b, nil
+	}
+	number, ok := value.(float64)
+	if !ok {
+		return nil, fmt.Errorf("%w: operator - on %s", ErrEvaluation, describe(value))
+	}
+
+	return -number, nil
+}
+
+type logicalNode struct {
+	isOr  bool
+	left  node
+	right node
+}
+
+func (n *logicalNode) eval(variables any) (any, error) {
+	operator := "&&"
+	if n.isOr {
+		operator = "||"
+	}
+
+	left, err := evalBool(n.left, variables, operator)
+	if err != nil {
+		return nil, err
+	}
+	// short-circuit evaluation
+	if left == n.isOr {
+		return left, nil
+	}
+
+	return evalBool(n.right, variables, operator)
+}
+
+type binaryNode struct {
+	operator string
+	left     node
+	right    node
+}
+
+func (n *binaryNode) eval(variables any) (any, error) {
+	left, err := n.left.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.operator {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "<", "<=", ">", ">=":
+		return compare(n.operator, left, right)
+	}
+
+	return arithmetic(n.operator, left, right)
+}
+
+type inNode struct {
+	left  node
+	right node
+}
+
+func (n *inNode) eval(variables any) (any, error) {
+	left, err := n.left.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if s, ok := right.(string); ok {
+		substring, ok := left.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s in string", ErrEvaluation, describe(left))
+		}
+		return strings.Contains(s, substring), nil
+	}
+	if elements, ok := dynamic.ToArray(right); ok {
+		for _, element := range elements {
+			if equal(left, normalize(element)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	if object, ok := dynamic.ToObject(right); ok {
+		key, ok := left.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s in object", ErrEvaluation, describe(left))
+		}
+		_, exists := object[key]
+		return exists, nil
+	}
+	if right == nil {
+		return false, nil
+	}
+
+	return nil, fmt.Errorf("%w: operator in on %s", ErrEvaluation, describe(right))
+}
+
+type matchesNode struct {
+	left    node
+	pattern node
+	regex   *regexp.Regexp
+}
+
+func (n *matchesNode) eval(variables any) (any, error) {
+	left, err := n.left.eval(variables)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := left.(string)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s matches pattern", ErrEvaluation, describe(left))
+	}
+
+	regex := n.regex
+	if regex == nil {
+		value, err := n.pattern.eval(variables)
+		if err != nil {
+			return nil, err
+		}
+		pattern, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: pattern is %s", ErrEvaluation, describe(value))
+		}
+		if regex, err = regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("%w: invalid pattern: %w", ErrEvaluation, err)
+		}
+	}
+
+	return regex.MatchString(s), nil
+}
+
+func evalBool(n node, variables any, operator string) (bool, error) {
+	value, err := n.eval(variables)
+	if err != nil {
+		return false, err
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: operator %s on %s", ErrEvaluation, operator, describe(value))
+	}
+
+	return b, nil
+}
+
+// property returns the property of the object (a map or a struct). Missing properties
+// of the maps and properties of nil are nil. Missing properties of the structs are errors.
+func property(object any, name string) (any, error) {
+	object = dynamic.Indirect(object)
+	if object == nil {
+		return nil, nil
+	}
+	if m, ok := dynamic.ToObject(object); ok {
+		return normalize(m[name]), nil
+	}
+	if value, ok := dynamic.Field(object, name); ok {
+		return normalize(value), nil
+	}
+
+	return nil, fmt.Errorf(`%w: unknown property "%s" of %s`, ErrEvaluation, name, describe(object))
+}
+
+func element(array any, index float64) (any, error) {
+	array = dynamic.Indirect(array)
+	if array == nil {
+		return nil, nil
+	}
+	elements, ok := dynamic.ToArray(array)
+	if !ok {
+		return nil, fmt.Errorf("%w: index of %s", ErrEvaluation, describe(array))
+	}
+	i := int(index)
+	if float64(i) != index || i < 0 || i >= len(elements) {
+		return nil, nil
+	}
+
+	return normalize(elements[i]), nil
+}
+
+// normalize converts the values of the Go types into the values of the expression types:
+// numbers into float64, named strings and booleans into string and bool.
+func normalize(value any) any {
+	value = dynamic.Indirect(value)
+	if value == nil {
+		return nil
+	}
+	if number, ok := dynamic.ToFloat(value); ok {
+		return number
+	}
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	}
+
+	return value
+}
+
+func equal(left, right any) bool {
+	switch l := left.(type) {
+	case nil:
+		return right == nil
+	case float64, string, bool:
+		return left == right
+	default:
+		if right == nil {
+			return false
+		}
+		return dynamic.Canonical(l) == dynamic.Canonical(right)
+	}
+}
+
+func compare(operator string, left, right any) (bool, error) {
+	var c int
+	switch l := left.(type) {
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			return false, fmt.Errorf("%w: %s %s %s", ErrEvaluation, describe(left), operator, describe(right))
+		}
+		switch {
+		case l < r:
+			c = -1
+		case l > r:
+			c = 1
+		}
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return false, fmt.Errorf("%w: %s %s %s", ErrEvaluation, describe(left), operator, describe(right))
+		}
+		c = strings.Compare(l, r)
+	default:
+		return false, fmt.Errorf("%w: %s %s %s", ErrEvaluation, describe(left), operator, describe(right))
+	}
+
+	switch operator {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	}
+
+	return c >= 0, nil
+}
+
+func arithmetic(operator string, left, right any) (any, error) {
+	if operator == "+" {
+		if l, ok := left.(string); ok {
+			if r, ok := right.(string); ok {
+				return l + r, nil
+			}
+		}
+	}
+	l, isLeftNumber := left.(float64)
+	r, isRightNumber := right.(float64)
+	if !isLeftNumber || !isRightNumber {
+		return nil, fmt.Errorf("%w: %s %s %s", ErrEvaluation, describe(left), operator, describe(right))
+	}
+
+	switch operator {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	}
+	if r == 0 {
+		return nil, fmt.Errorf("%w: division by zero", ErrEvaluation)
+	}
+	if operator == "%" {
+		return math.Mod(l, r), nil
+	}
+
+	return l / r, nil
+}
+
+func describe(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	}
+	if _, ok := dynamic.ToArray(value); ok {
+		return "array"
+	}
+
+	return "object"
+}
@@ -0,0 +1,42 @@
+package expression_test
+
+import (
+	"fmt"
+
+	"github.com/muonsoft/validation/expression"
+)
+
+func ExampleCompile() {
+	e, err := expression.Compile(`len(tags) <= 3 && role in ["admin", "editor"]`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	isValid, err := e.EvaluateBool(map[string]any{
+		"role": "editor",
+		"tags": []string{"go", "news"},
+	})
+	fmt.Println(isValid, err)
+	// Output:
+	// true <nil>
+}
+
+func ExampleCompile_syntaxError() {
+	_, err := expression.Compile(`price > 0 &&`)
+	fmt.Println(err)
+	// Output:
+	// syntax error at position 12: unexpected end of expression
+}
+
+func ExampleExpression_Evaluate() {
+	type Item struct {
+		Price    float64 `json:"price"`
+		Quantity int     `json:"quantity"`
+	}
+
+	total, err := expression.MustCompile(`price * quantity`).Evaluate(Item{Price: 2.5, Quantity: 4})
+	fmt.Println(total, err)
+	// Output:
+	// 10 <nil>
+}
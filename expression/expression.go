@@ -0,0 +1,89 @@
+// Package expression contains a small and safe expression language used to describe rules
+// like `discount <= price * 0.5 || role == "admin"` (see [github.com/muonsoft/validation/it.Satisfies]).
+//
+// The expressions are evaluated against the variables: a map with string keys or a struct
+// (properties are found by the names from the "json" tags or by the names of the fields).
+// The language has no side effects, no loops and no access to the functions of the application,
+// so it is safe to evaluate the expressions from the configuration files.
+//
+// The language supports:
+//
+//   - literals: numbers (1, 0.5, 1e3), strings ("text" or 'text'), true, false, null and arrays ([1, 2]);
+//   - variables and their properties: price, user.role, items[0], labels["env"];
+//   - arithmetic operators: +, -, *, /, % (+ also concatenates strings);
+//   - comparison operators: ==, !=, <, <=, >, >= (numbers and strings are comparable);
+//   - boolean operators: &&, ||, ! (with short-circuit evaluation);
+//   - "in" operator: an element of an array (role in ["admin", "owner"]), a substring
+//     ("@" in email) or a key of an object ("env" in labels);
+//   - "matches" operator: the string matches the regular expression (sku matches "^[A-Z]+$");
+//   - len function: the length of a string (in characters), an array or an object.
+//
+// All numbers are treated as float64. Missing properties of the maps and properties of null are null.
+package expression
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrEvaluation is returned by [Expression.Evaluate] when the expression cannot be evaluated
+// for the given variables (e.g. operands have incompatible types or the struct has no such property).
+var ErrEvaluation = errors.New("evaluation error")
+
+// Expression is a compiled expression. It is safe for concurrent use.
+type Expression struct {
+	source string
+	root   node
+}
+
+// Compile parses the expression. If the expression is invalid, then [SyntaxError] is returned.
+// Patterns of the "matches" operator defined by string literals are compiled as well.
+func Compile(source string) (*Expression, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tokens}
+	root, err := p.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Expression{source: source, root: root}, nil
+}
+
+// MustCompile is like [Compile] but panics if the expression cannot be compiled.
+// It simplifies safe initialization of global variables holding compiled expressions.
+func MustCompile(source string) *Expression {
+	e, err := Compile(source)
+	if err != nil {
+		panic(fmt.Sprintf("compile expression %q: %s", source, err))
+	}
+
+	return e
+}
+
+// String returns the source text used to compile the expression.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Evaluate evaluates the expression against the variables (a map with string keys or a struct).
+// The result is a float64, string, bool, nil, []any or a value of the variables.
+func (e *Expression) Evaluate(variables any) (any, error) {
+	return e.root.eval(variables)
+}
+
+// EvaluateBool evaluates the expression and checks that the result is boolean.
+func (e *Expression) EvaluateBool(variables any) (bool, error) {
+	result, err := e.Evaluate(variables)
+	if err != nil {
+		return false, err
+	}
+	b, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: result is %s, expected boolean", ErrEvaluation, describe(result))
+	}
+
+	return b, nil
+}
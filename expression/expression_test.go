@@ -0,0 +1,183 @@
+package expression_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/muonsoft/validation/expression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Status string
+
+type User struct {
+	Name    string            `json:"name"`
+	Role    string            `json:"role"`
+	Status  Status            `json:"status"`
+	Age     int               `json:"age"`
+	Email   *string           `json:"email"`
+	Tags    []string          `json:"tags"`
+	Labels  map[string]string `json:"labels"`
+	Manager *User             `json:"manager"`
+	Active  bool
+}
+
+func TestExpression_Evaluate(t *testing.T) {
+	email := "user@example.com"
+	user := User{
+		Name:    "Alice",
+		Role:    "admin",
+		Status:  "active",
+		Age:     30,
+		Email:   &email,
+		Tags:    []string{"go", "sql"},
+		Labels:  map[string]string{"env": "prod"},
+		Manager: &User{Name: "Bob"},
+		Active:  true,
+	}
+	document := map[string]any{
+		"price":    100.0,
+		"discount": 20,
+		"items":    []any{map[string]any{"sku": "AB-1"}, map[string]any{"sku": "cd"}},
+	}
+	tests := []struct {
+		expression string
+		variables  any
+		expected   any
+	}{
+		{`1 + 2 * 3`, nil, 7.0},
+		{`(1 + 2) * 3`, nil, 9.0},
+		{`10 % 4 - 1 / 2`, nil, 1.5},
+		{`-age + 1e2`, user, 70.0},
+		{`"a" + 'b'`, nil, "ab"},
+		{`"say \"hi\"\n"`, nil, "say \"hi\"\n"},
+		{`[1, "a", null]`, nil, []any{1.0, "a", nil}},
+		{`age >= 18 && role == "admin"`, user, true},
+		{`age < 18 || !Active`, user, false},
+		{`name < "Bob"`, user, true},
+		{`status == "active"`, user, true},
+		{`status in ["active", "pending"]`, user, true},
+		{`"go" in tags`, user, true},
+		{`"rust" in tags`, user, false},
+		{`"@" in email`, user, true},
+		{`"env" in labels`, user, true},
+		{`"x" in missing`, document, false},
+		{`labels.env == "prod" && labels["env"] == "prod"`, user, true},
+		{`labels.region`, user, nil},
+		{`manager.name`, user, "Bob"},
+		{`manager.manager.name`, user, nil},
+		{`tags[1]`, user, "sql"},
+		{`tags[5]`, user, nil},
+		{`len(name) == 5 && len(tags) == 2 && len(labels) == 1 && len(manager.tags) == 0`, user, true},
+		{`len("привет")`, nil, 6.0},
+		{`name matches "^[A-Z][a-z]+$"`, user, true},
+		{`items[1].sku matches "^[A-Z]+-\\d+$"`, document, false},
+		{`items[0].sku matches pattern`, map[string]any{"items": document["items"], "pattern": "^AB"}, true},
+		{`discount <= price * 0.5 || role == "admin"`, document, true},
+		{`missing == null`, document, true},
+		{`[1, 2] == [1, 2]`, nil, true},
+		{`false && missing.property > 1`, document, false},
+		{`true || 1 / 0`, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			e, err := expression.Compile(test.expression)
+			require.NoError(t, err)
+
+			result, err := e.Evaluate(test.variables)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+			assert.Equal(t, test.expression, e.String())
+		})
+	}
+}
+
+func TestCompile_WhenExpressionIsInvalid_ExpectSyntaxError(t *testing.T) {
+	tests := []struct {
+		expression    string
+		expectedError string
+	}{
+		{``, `syntax error at position 0: unexpected end of expression`},
+		{`1 +`, `syntax error at position 3: unexpected end of expression`},
+		{`(1 + 2`, `syntax error at position 6: expected ")", got end of expression`},
+		{`a b`, `syntax error at position 2: unexpected "b"`},
+		{`a = 1`, `syntax error at position 2: unexpected character '='`},
+		{`"text`, `syntax error at position 0: unterminated string`},
+		{`"\d"`, `syntax error at position 1: unknown escape sequence \d`},
+		{`1.2.3`, `syntax error at position 0: invalid number "1.2.3"`},
+		{`a < b < c`, `syntax error at position 6: comparison operators cannot be chained, use parentheses`},
+		{`exec("rm")`, `syntax error at position 0: unknown function "exec"`},
+		{`len(a, b)`, `syntax error at position 0: function "len" expects 1 argument(s), got 2`},
+		{`a.1`, `syntax error at position 2: expected property name, got "1"`},
+		{`[1, 2`, `syntax error at position 5: expected "," or "]", got end of expression`},
+		{`a matches "["`, `syntax error at position 2: invalid pattern: error parsing regexp: missing closing ]: ` + "`[`"},
+		{`a matches 1`, `syntax error at position 2: pattern of matches must be a string`},
+		{`in`, `syntax error at position 0: unexpected "in"`},
+	}
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			e, err := expression.Compile(test.expression)
+
+			assert.Nil(t, e)
+			var syntaxErr *expression.SyntaxError
+			assert.True(t, errors.As(err, &syntaxErr))
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestExpression_Evaluate_WhenOperandsAreInvalid_ExpectEvaluationError(t *testing.T) {
+	tests := []struct {
+		expression    string
+		expectedError string
+	}{
+		{`name + 1`, `evaluation error: string + number`},
+		{`age > "10"`, `evaluation error: number > string`},
+		{`tags < 1`, `evaluation error: array < number`},
+		{`1 / (age - 30)`, `evaluation error: division by zero`},
+		{`!name`, `evaluation error: operator ! on string`},
+		{`-name`, `evaluation error: operator - on string`},
+		{`age && true`, `evaluation error: operator && on number`},
+		{`unknown > 1`, `evaluation error: unknown property "unknown" of object`},
+		{`age.value`, `evaluation error: unknown property "value" of number`},
+		{`name[0]`, `evaluation error: index of string`},
+		{`tags[true]`, `evaluation error: invalid key boolean`},
+		{`len(age)`, `evaluation error: len of number`},
+		{`1 in name`, `evaluation error: number in string`},
+		{`1 in labels`, `evaluation error: number in object`},
+		{`1 in age`, `evaluation error: operator in on number`},
+		{`age matches "1"`, `evaluation error: number matches pattern`},
+		{`name matches role + "["`, "evaluation error: invalid pattern: error parsing regexp: missing closing ]: `[`"},
+	}
+	user := User{Name: "Alice", Role: "admin", Age: 30, Labels: map[string]string{}}
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			e := expression.MustCompile(test.expression)
+
+			_, err := e.Evaluate(user)
+
+			assert.ErrorIs(t, err, expression.ErrEvaluation)
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestExpression_EvaluateBool(t *testing.T) {
+	e := expression.MustCompile(`age >= 18`)
+
+	isAdult, err := e.EvaluateBool(map[string]any{"age": 21})
+	require.NoError(t, err)
+	assert.True(t, isAdult)
+
+	_, err = expression.MustCompile(`age`).EvaluateBool(map[string]any{"age": 21})
+	assert.ErrorIs(t, err, expression.ErrEvaluation)
+	assert.EqualError(t, err, "evaluation error: result is number, expected boolean")
+}
+
+func TestMustCompile_WhenExpressionIsInvalid_ExpectPanic(t *testing.T) {
+	assert.PanicsWithValue(t, `compile expression "1 +": syntax error at position 3: unexpected end of expression`, func() {
+		expression.MustCompile("1 +")
+	})
+}
@@ -0,0 +1,164 @@
+package expression
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind byte
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdentifier
+	tokenOperator
+)
+
+type token struct {
+	kind     tokenKind
+	text     string
+	number   float64
+	position int
+}
+
+func (t token) is(kind tokenKind, text string) bool {
+	return t.kind == kind && t.text == text
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return strconv.Quote(t.text)
+	}
+
+	return `"` + t.text + `"`
+}
+
+// operators are sorted by length to match the longest operator first.
+var operators = []string{
+	"||", "&&", "==", "!=", "<=", ">=",
+	"<", ">", "!", "+", "-", "*", "/", "%", "(", ")", "[", "]", ",", ".",
+}
+
+func tokenize(source string) ([]token, error) {
+	tokens := make([]token, 0)
+
+	for i := 0; i < len(source); {
+		c, size := utf8.DecodeRuneInString(source[i:])
+		switch {
+		case unicode.IsSpace(c):
+			i += size
+		case c >= '0' && c <= '9':
+			t, err := scanNumber(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+			i += len(t.text)
+		case c == '"' || c == '\'':
+			t, length, err := scanString(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+			i += length
+		case c == '_' || unicode.IsLetter(c):
+			start := i
+			for i < len(source) {
+				c, size := utf8.DecodeRuneInString(source[i:])
+				if c != '_' && !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+					break
+				}
+				i += size
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, text: source[start:i], position: start})
+		default:
+			operator := scanOperator(source[i:])
+			if operator == "" {
+				return nil, newSyntaxError(i, "unexpected character %q", c)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: operator, position: i})
+			i += len(operator)
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, position: len(source)}), nil
+}
+
+func scanNumber(source string, start int) (token, error) {
+	i := start
+	for i < len(source) && (isDigit(source[i]) || source[i] == '.') {
+		i++
+	}
+	// exponent part, e.g. 1e-3
+	if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+		j := i + 1
+		if j < len(source) && (source[j] == '+' || source[j] == '-') {
+			j++
+		}
+		if j < len(source) && isDigit(source[j]) {
+			i = j
+			for i < len(source) && isDigit(source[i]) {
+				i++
+			}
+		}
+	}
+
+	text := source[start:i]
+	number, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return token{}, newSyntaxError(start, "invalid number %q", text)
+	}
+
+	return token{kind: tokenNumber, text: text, number: number, position: start}, nil
+}
+
+func scanString(source string, start int) (token, int, error) {
+	quote := source[start]
+	var s strings.Builder
+
+	for i := start + 1; i < len(source); i++ {
+		switch c := source[i]; c {
+		case quote:
+			return token{kind: tokenString, text: s.String(), position: start}, i - start + 1, nil
+		case '\\':
+			i++
+			if i >= len(source) {
+				break
+			}
+			switch e := source[i]; e {
+			case 'n':
+				s.WriteByte('\n')
+			case 't':
+				s.WriteByte('\t')
+			case '\\', '"', '\'':
+				s.WriteByte(e)
+			default:
+				return token{}, 0, newSyntaxError(i-1, "unknown escape sequence \\%c", e)
+			}
+		default:
+			s.WriteByte(c)
+		}
+	}
+
+	return token{}, 0, newSyntaxError(start, "unterminated string")
+}
+
+func scanOperator(source string) string {
+	for _, operator := range operators {
+		if strings.HasPrefix(source, operator) {
+			return operator
+		}
+	}
+
+	return ""
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,330 @@
+package expression
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// SyntaxError is returned by [Compile] when the expression is invalid.
+type SyntaxError struct {
+	// Position is the byte offset of the invalid token in the expression.
+	Position int
+	Message  string
+}
+
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at position %d: %s", err.Position, err.Message)
+}
+
+func newSyntaxError(position int, format string, args ...any) *SyntaxError {
+	return &SyntaxError{Position: position, Message: fmt.Sprintf(format, args...)}
+}
+
+// functions are the built-in functions with the count of the arguments.
+var functions = map[string]int{
+	"len": 1,
+}
+
+// parser is a recursive descent parser. Operators from the lowest to the highest precedence:
+//
+//	||
+//	&&
+//	== != < <= > >= in matches (not associative)
+//	+ -
+//	* / %
+//	! - (unary)
+//	. [] (member access)
+type parser struct {
+	tokens []token
+	index  int
+}
+
+func (p *parser) Parse() (node, error) {
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, newSyntaxError(t.position, "unexpected %s", t)
+	}
+
+	return n, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is(tokenOperator, "||") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{isOr: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().is(tokenOperator, "&&") {
+		p.next()
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.peek()
+	switch {
+	case t.kind == tokenOperator && isComparisonOperator(t.text):
+		p.next()
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{operator: t.text, left: left, right: right}
+	case t.is(tokenIdentifier, "in"):
+		p.next()
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		left = &inNode{left: left, right: right}
+	case t.is(tokenIdentifier, "matches"):
+		p.next()
+		right, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		m := &matchesNode{left: left, pattern: right}
+		// patterns defined by literals are compiled once with the expression
+		if l, ok := right.(*literalNode); ok {
+			pattern, ok := l.value.(string)
+			if !ok {
+				return nil, newSyntaxError(t.position, "pattern of matches must be a string")
+			}
+			if m.regex, err = regexp.Compile(pattern); err != nil {
+				return nil, newSyntaxError(t.position, "invalid pattern: %s", err)
+			}
+		}
+		left = m
+	}
+
+	if t := p.peek(); (t.kind == tokenOperator && isComparisonOperator(t.text)) ||
+		t.is(tokenIdentifier, "in") || t.is(tokenIdentifier, "matches") {
+		return nil, newSyntaxError(t.position, "comparison operators cannot be chained, use parentheses")
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAdditive() (node, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.is(tokenOperator, "+") || t.is(tokenOperator, "-"); t = p.peek() {
+		p.next()
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{operator: t.text, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseMultiplicative() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.is(tokenOperator, "*") || t.is(tokenOperator, "/") || t.is(tokenOperator, "%"); t = p.peek() {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{operator: t.text, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	t := p.peek()
+	if t.is(tokenOperator, "!") || t.is(tokenOperator, "-") {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{operator: t.text, operand: operand}, nil
+	}
+
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		switch t := p.peek(); {
+		case t.is(tokenOperator, "."):
+			p.next()
+			name := p.next()
+			if name.kind != tokenIdentifier {
+				return nil, newSyntaxError(name.position, "expected property name, got %s", name)
+			}
+			n = &memberNode{object: n, property: &literalNode{value: name.text}}
+		case t.is(tokenOperator, "["):
+			p.next()
+			property, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			n = &memberNode{object: n, property: property}
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenNumber:
+		return &literalNode{value: t.number}, nil
+	case tokenString:
+		return &literalNode{value: t.text}, nil
+	case tokenIdentifier:
+		return p.parseIdentifier(t)
+	case tokenOperator:
+		switch t.text {
+		case "(":
+			n, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return n, p.expect(")")
+		case "[":
+			elements, err := p.parseList("]")
+			if err != nil {
+				return nil, err
+			}
+			return &listNode{elements: elements}, nil
+		}
+	}
+
+	return nil, newSyntaxError(t.position, "unexpected %s", t)
+}
+
+func (p *parser) parseIdentifier(t token) (node, error) {
+	switch t.text {
+	case "true":
+		return &literalNode{value: true}, nil
+	case "false":
+		return &literalNode{value: false}, nil
+	case "null", "nil":
+		return &literalNode{value: nil}, nil
+	case "in", "matches":
+		return nil, newSyntaxError(t.position, "unexpected %s", t)
+	}
+
+	if !p.peek().is(tokenOperator, "(") {
+		return &variableNode{name: t.text}, nil
+	}
+	count, exists := functions[t.text]
+	if !exists {
+		return nil, newSyntaxError(t.position, "unknown function %s", t)
+	}
+	p.next()
+	arguments, err := p.parseList(")")
+	if err != nil {
+		return nil, err
+	}
+	if len(arguments) != count {
+		return nil, newSyntaxError(t.position, "function %s expects %d argument(s), got %d", t, count, len(arguments))
+	}
+
+	return &lenNode{argument: arguments[0]}, nil
+}
+
+func (p *parser) parseList(closing string) ([]node, error) {
+	elements := make([]node, 0)
+	if p.peek().is(tokenOperator, closing) {
+		p.next()
+		return elements, nil
+	}
+
+	for {
+		element, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+
+		t := p.next()
+		if t.is(tokenOperator, closing) {
+			return elements, nil
+		}
+		if !t.is(tokenOperator, ",") {
+			return nil, newSyntaxError(t.position, `expected "," or "%s", got %s`, closing, t)
+		}
+	}
+}
+
+func (p *parser) expect(operator string) error {
+	if t := p.next(); !t.is(tokenOperator, operator) {
+		return newSyntaxError(t.position, `expected "%s", got %s`, operator, t)
+	}
+
+	return nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.index]
+	if t.kind != tokenEOF {
+		p.index++
+	}
+
+	return t
+}
+
+func isComparisonOperator(operator string) bool {
+	switch operator {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return true
+	}
+
+	return false
+}
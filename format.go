@@ -0,0 +1,61 @@
+package validation
+
+import (
+	"time"
+
+	"github.com/muonsoft/validation/message/messageformat"
+	"golang.org/x/text/language"
+)
+
+// DateTimeStyle defines how the violation factory formats date and time values of template parameters
+// (see [TemplateParameter.RawValue]).
+type DateTimeStyle int8
+
+const (
+	// DateTimeStyleLayout keeps date and time values formatted by the layout of the constraint
+	// (usually [time.RFC3339]). It is the default style.
+	DateTimeStyleLayout DateTimeStyle = iota
+
+	// DateTimeStyleShort formats date and time values by the short locale pattern
+	// without seconds (e.g. "1/2/06, 3:04 PM" for English and "02.01.06 15:04" for Russian).
+	DateTimeStyleShort
+
+	// DateTimeStyleMedium formats date and time values by the medium locale pattern
+	// with seconds (e.g. "Jan 2, 2006, 3:04:05 PM" for English and "02.01.2006 15:04:05" for Russian).
+	DateTimeStyleMedium
+)
+
+// parameterFormatter formats typed values of template parameters according to the language.
+// The values are formatted only if the formatting is enabled explicitly (see [FormatParameters]
+// and [FormatDateTime] options), otherwise the values are rendered as is (e.g. "2024" in English).
+type parameterFormatter struct {
+	isEnabled     bool
+	dateTimeStyle DateTimeStyle
+}
+
+// format returns the formatted value and true if the value has a supported type.
+func (f parameterFormatter) format(tag language.Tag, value any) (string, bool) {
+	if !f.isEnabled {
+		return "", false
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		if f.dateTimeStyle == DateTimeStyleLayout {
+			return "", false
+		}
+		return f.formatTime(tag, v), true
+	case time.Duration:
+		return messageformat.FormatDuration(tag, v), true
+	}
+
+	return messageformat.FormatNumber(tag, value)
+}
+
+func (f parameterFormatter) formatTime(tag language.Tag, t time.Time) string {
+	if f.dateTimeStyle == DateTimeStyleShort {
+		return messageformat.FormatDateTime(tag, t, messageformat.StyleShort)
+	}
+
+	return messageformat.FormatDateTime(tag, t, messageformat.StyleMedium)
+}
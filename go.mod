@@ -1,15 +1,15 @@
 module github.com/muonsoft/validation
 
-go 1.18
+go 1.23
 
 require (
 	github.com/muonsoft/language v0.3.1
 	github.com/stretchr/testify v1.8.2
 	golang.org/x/text v0.8.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,196 @@
+// Package dynamic contains helpers to work with dynamic values decoded from JSON
+// (map[string]any, []any, json.Number and scalar values).
+package dynamic
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ToFloat converts a [json.Number] or a value of any Go numeric type into float64.
+func ToFloat(value any) (float64, bool) {
+	if number, ok := value.(json.Number); ok {
+		f, err := number.Float64()
+		return f, err == nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	default:
+		return 0, false
+	}
+}
+
+// ToInt converts a [json.Number] or a value of any Go numeric type without a fractional part into int.
+func ToInt(value any) (int, bool) {
+	if number, ok := value.(json.Number); ok {
+		if i, err := number.Int64(); err == nil {
+			return int(i), i >= math.MinInt && i <= math.MaxInt
+		}
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := v.Int()
+		return int(i), i >= math.MinInt && i <= math.MaxInt
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := v.Uint()
+		return int(u), u <= math.MaxInt
+	}
+
+	f, ok := ToFloat(value)
+	if !ok || f != math.Trunc(f) || f < math.MinInt || f > math.MaxInt {
+		return 0, false
+	}
+
+	return int(f), true
+}
+
+// IsInteger checks that the value is a number without a fractional part.
+func IsInteger(value any) bool {
+	f, ok := ToFloat(value)
+	return ok && f == math.Trunc(f) && !math.IsInf(f, 0)
+}
+
+// ToObject converts a map with string keys into map[string]any.
+func ToObject(value any) (map[string]any, bool) {
+	if object, ok := value.(map[string]any); ok {
+		return object, true
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	object := make(map[string]any, v.Len())
+	iterator := v.MapRange()
+	for iterator.Next() {
+		object[iterator.Key().String()] = iterator.Value().Interface()
+	}
+
+	return object, true
+}
+
+// ToArray converts a slice or an array into []any.
+func ToArray(value any) ([]any, bool) {
+	if elements, ok := value.([]any); ok {
+		return elements, true
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, false
+	}
+	elements := make([]any, v.Len())
+	for i := range elements {
+		elements[i] = v.Index(i).Interface()
+	}
+
+	return elements, true
+}
+
+// Canonical returns the canonical JSON representation of the value: numbers are formatted
+// without insignificant zeros and the properties of objects are sorted. It is used to compare dynamic values.
+func Canonical(value any) string {
+	var s strings.Builder
+	writeCanonical(&s, value)
+
+	return s.String()
+}
+
+func writeCanonical(s *strings.Builder, value any) {
+	if value == nil {
+		s.WriteString("null")
+		return
+	}
+	if f, ok := ToFloat(value); ok {
+		s.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+		return
+	}
+	if object, ok := ToObject(value); ok {
+		names := make([]string, 0, len(object))
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		s.WriteString("{")
+		for i, name := range names {
+			if i > 0 {
+				s.WriteString(",")
+			}
+			s.WriteString(strconv.Quote(name))
+			s.WriteString(":")
+			writeCanonical(s, object[name])
+		}
+		s.WriteString("}")
+		return
+	}
+	if elements, ok := ToArray(value); ok {
+		s.WriteString("[")
+		for i, element := range elements {
+			if i > 0 {
+				s.WriteString(",")
+			}
+			writeCanonical(s, element)
+		}
+		s.WriteString("]")
+		return
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		s.WriteString(strconv.Quote(err.Error()))
+		return
+	}
+	s.Write(data)
+}
+
+// Indirect dereferences pointers and interfaces, it returns nil for nil pointers.
+func Indirect(value any) any {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+
+	return v.Interface()
+}
+
+// Field finds the exported field of the struct by the name from the "json" tag or by the name
+// of the field (case-insensitively, as [json.Unmarshal] does). The value must not be a pointer.
+func Field(value any, name string) (any, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if tag == name || (tag == "" && strings.EqualFold(field.Name, name)) {
+			return v.Field(i).Interface(), true
+		}
+	}
+
+	return nil, false
+}
@@ -11,7 +11,8 @@ import (
 func IsEAN8() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.EAN8).
 		WithError(validation.ErrInvalidEAN8).
-		WithMessage(validation.ErrInvalidEAN8.Message())
+		WithDefaultMessage(validation.ErrInvalidEAN8.Message()).
+		WithDescription("ean8", nil)
 }
 
 // IsEAN13 is used to validate EAN-13 value.
@@ -20,7 +21,8 @@ func IsEAN8() validation.StringFuncConstraint {
 func IsEAN13() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.EAN13).
 		WithError(validation.ErrInvalidEAN13).
-		WithMessage(validation.ErrInvalidEAN13.Message())
+		WithDefaultMessage(validation.ErrInvalidEAN13.Message()).
+		WithDescription("ean13", nil)
 }
 
 // IsUPCA is used to validate UPC-A value.
@@ -29,7 +31,8 @@ func IsEAN13() validation.StringFuncConstraint {
 func IsUPCA() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.UPCA).
 		WithError(validation.ErrInvalidUPCA).
-		WithMessage(validation.ErrInvalidUPCA.Message())
+		WithDefaultMessage(validation.ErrInvalidUPCA.Message()).
+		WithDescription("upcA", nil)
 }
 
 // IsUPCE is used to validate UPC-E value.
@@ -38,5 +41,6 @@ func IsUPCA() validation.StringFuncConstraint {
 func IsUPCE() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.UPCE).
 		WithError(validation.ErrInvalidUPCE).
-		WithMessage(validation.ErrInvalidUPCE.Message())
+		WithDefaultMessage(validation.ErrInvalidUPCE.Message()).
+		WithDescription("upcE", nil)
 }
@@ -17,6 +17,7 @@ type NotBlankConstraint[T comparable] struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 }
 
@@ -44,6 +45,17 @@ func (c NotBlankConstraint[T]) WithAllowedNil() NotBlankConstraint[T] {
 	return c
 }
 
+// Describe returns the description of the constraint: the "notBlank" rule with the "allowNil" parameter.
+func (c NotBlankConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "notBlank",
+		Parameters: map[string]any{"allowNil": c.allowNil},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c NotBlankConstraint[T]) When(condition bool) NotBlankConstraint[T] {
@@ -67,6 +79,7 @@ func (c NotBlankConstraint[T]) WithError(err error) NotBlankConstraint[T] {
 // for injecting its values into the final message.
 func (c NotBlankConstraint[T]) WithMessage(template string, parameters ...validation.TemplateParameter) NotBlankConstraint[T] {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
@@ -82,7 +95,7 @@ func (c NotBlankConstraint[T]) ValidateBool(ctx context.Context, validator *vali
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, valueOf(value))
 }
 
 func (c NotBlankConstraint[T]) ValidateNumber(ctx context.Context, validator *validation.Validator, value *T) error {
@@ -100,7 +113,7 @@ func (c NotBlankConstraint[T]) ValidateString(ctx context.Context, validator *va
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, valueOf(value))
 }
 
 func (c NotBlankConstraint[T]) ValidateComparable(ctx context.Context, validator *validation.Validator, value *T) error {
@@ -114,7 +127,7 @@ func (c NotBlankConstraint[T]) ValidateComparable(ctx context.Context, validator
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, valueOf(value))
 }
 
 func (c NotBlankConstraint[T]) ValidateCountable(ctx context.Context, validator *validation.Validator, count int) error {
@@ -122,7 +135,7 @@ func (c NotBlankConstraint[T]) ValidateCountable(ctx context.Context, validator
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, count)
 }
 
 func (c NotBlankConstraint[T]) ValidateTime(ctx context.Context, validator *validation.Validator, value *time.Time) error {
@@ -137,12 +150,14 @@ func (c NotBlankConstraint[T]) ValidateTime(ctx context.Context, validator *vali
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, valueOf(value))
 }
 
-func (c NotBlankConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator) validation.Violation {
+func (c NotBlankConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator, value any) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(c.messageParameters...).
+		WithInvalidValue(value).
 		Create()
 }
 
@@ -154,6 +169,7 @@ type BlankConstraint[T comparable] struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 }
 
@@ -175,6 +191,16 @@ func IsBlankComparable[T comparable]() BlankConstraint[T] {
 	}
 }
 
+// Describe returns the description of the constraint: the "blank" rule.
+func (c BlankConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "blank",
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c BlankConstraint[T]) When(condition bool) BlankConstraint[T] {
@@ -198,6 +224,7 @@ func (c BlankConstraint[T]) WithError(err error) BlankConstraint[T] {
 // for injecting its values into the final message.
 func (c BlankConstraint[T]) WithMessage(template string, parameters ...validation.TemplateParameter) BlankConstraint[T] {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
@@ -207,7 +234,7 @@ func (c BlankConstraint[T]) ValidateBool(ctx context.Context, validator *validat
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, *value)
 }
 
 func (c BlankConstraint[T]) ValidateNumber(ctx context.Context, validator *validation.Validator, value *T) error {
@@ -219,7 +246,7 @@ func (c BlankConstraint[T]) ValidateString(ctx context.Context, validator *valid
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, *value)
 }
 
 func (c BlankConstraint[T]) ValidateComparable(ctx context.Context, validator *validation.Validator, value *T) error {
@@ -227,7 +254,7 @@ func (c BlankConstraint[T]) ValidateComparable(ctx context.Context, validator *v
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, *value)
 }
 
 func (c BlankConstraint[T]) ValidateCountable(ctx context.Context, validator *validation.Validator, count int) error {
@@ -235,7 +262,7 @@ func (c BlankConstraint[T]) ValidateCountable(ctx context.Context, validator *va
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, count)
 }
 
 func (c BlankConstraint[T]) ValidateTime(ctx context.Context, validator *validation.Validator, value *time.Time) error {
@@ -243,12 +270,14 @@ func (c BlankConstraint[T]) ValidateTime(ctx context.Context, validator *validat
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, *value)
 }
 
-func (c BlankConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator) validation.Violation {
+func (c BlankConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator, value any) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(c.messageParameters...).
+		WithInvalidValue(value).
 		Create()
 }
 
@@ -259,6 +288,7 @@ type NotNilConstraint[T comparable] struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 }
 
@@ -280,6 +310,16 @@ func IsNotNilComparable[T comparable]() NotNilConstraint[T] {
 	}
 }
 
+// Describe returns the description of the constraint: the "notNil" rule.
+func (c NotNilConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "notNil",
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c NotNilConstraint[T]) When(condition bool) NotNilConstraint[T] {
@@ -303,6 +343,7 @@ func (c NotNilConstraint[T]) WithError(err error) NotNilConstraint[T] {
 // for injecting its values into the final message.
 func (c NotNilConstraint[T]) WithMessage(template string, parameters ...validation.TemplateParameter) NotNilConstraint[T] {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
@@ -312,7 +353,7 @@ func (c NotNilConstraint[T]) ValidateNil(ctx context.Context, validator *validat
 		return nil
 	}
 
-	return c.newViolation(ctx, validator)
+	return c.newViolation(ctx, validator, nil)
 }
 
 func (c NotNilConstraint[T]) ValidateBool(ctx context.Context, validator *validation.Validator, value *bool) error {
@@ -335,9 +376,11 @@ func (c NotNilConstraint[T]) ValidateTime(ctx context.Context, validator *valida
 	return c.ValidateNil(ctx, validator, value == nil)
 }
 
-func (c NotNilConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator) validation.Violation {
+func (c NotNilConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator, value any) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(c.messageParameters...).
+		WithInvalidValue(value).
 		Create()
 }
 
@@ -348,6 +391,7 @@ type NilConstraint[T comparable] struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 }
 
@@ -369,6 +413,16 @@ func IsNilComparable[T comparable]() NilConstraint[T] {
 	}
 }
 
+// Describe returns the description of the constraint: the "nil" rule.
+func (c NilConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "nil",
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c NilConstraint[T]) When(condition bool) NilConstraint[T] {
@@ -392,41 +446,48 @@ func (c NilConstraint[T]) WithError(err error) NilConstraint[T] {
 // for injecting its values into the final message.
 func (c NilConstraint[T]) WithMessage(template string, parameters ...validation.TemplateParameter) NilConstraint[T] {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
 func (c NilConstraint[T]) ValidateNil(ctx context.Context, validator *validation.Validator, isNil bool) error {
-	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || isNil {
-		return nil
-	}
-
-	return c.newViolation(ctx, validator)
+	return c.validateNil(ctx, validator, isNil, nil)
 }
 
 func (c NilConstraint[T]) ValidateBool(ctx context.Context, validator *validation.Validator, value *bool) error {
-	return c.ValidateNil(ctx, validator, value == nil)
+	return c.validateNil(ctx, validator, value == nil, valueOf(value))
 }
 
 func (c NilConstraint[T]) ValidateNumber(ctx context.Context, validator *validation.Validator, value *T) error {
-	return c.ValidateNil(ctx, validator, value == nil)
+	return c.validateNil(ctx, validator, value == nil, valueOf(value))
 }
 
 func (c NilConstraint[T]) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
-	return c.ValidateNil(ctx, validator, value == nil)
+	return c.validateNil(ctx, validator, value == nil, valueOf(value))
 }
 
 func (c NilConstraint[T]) ValidateComparable(ctx context.Context, validator *validation.Validator, value *T) error {
-	return c.ValidateNil(ctx, validator, value == nil)
+	return c.validateNil(ctx, validator, value == nil, valueOf(value))
 }
 
 func (c NilConstraint[T]) ValidateTime(ctx context.Context, validator *validation.Validator, value *time.Time) error {
-	return c.ValidateNil(ctx, validator, value == nil)
+	return c.validateNil(ctx, validator, value == nil, valueOf(value))
+}
+
+func (c NilConstraint[T]) validateNil(ctx context.Context, validator *validation.Validator, isNil bool, value any) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || isNil {
+		return nil
+	}
+
+	return c.newViolation(ctx, validator, value)
 }
 
-func (c NilConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator) validation.Violation {
+func (c NilConstraint[T]) newViolation(ctx context.Context, validator *validation.Validator, value any) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(c.messageParameters...).
+		WithInvalidValue(value).
 		Create()
 }
 
@@ -437,6 +498,7 @@ type BoolConstraint struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 }
 
@@ -458,6 +520,17 @@ func IsFalse() BoolConstraint {
 	}
 }
 
+// Describe returns the description of the constraint: the "bool" rule with the "expected" parameter.
+func (c BoolConstraint) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "bool",
+		Parameters: map[string]any{"expected": c.expected},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c BoolConstraint) When(condition bool) BoolConstraint {
@@ -481,6 +554,7 @@ func (c BoolConstraint) WithError(err error) BoolConstraint {
 // for injecting its values into the final message.
 func (c BoolConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) BoolConstraint {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
@@ -491,6 +565,16 @@ func (c BoolConstraint) ValidateBool(ctx context.Context, validator *validation.
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(c.messageParameters...).
+		WithInvalidValue(*value).
 		Create()
 }
+
+func valueOf[T any](value *T) any {
+	if value == nil {
+		return nil
+	}
+
+	return *value
+}
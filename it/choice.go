@@ -15,10 +15,12 @@ import (
 type ChoiceConstraint[T comparable] struct {
 	blank             T
 	choices           map[T]bool
+	values            []T
 	choicesValue      string
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 	disallowBlank     bool
 	isIgnored         bool
@@ -44,6 +46,7 @@ func IsOneOf[T comparable](values ...T) ChoiceConstraint[T] {
 
 	return ChoiceConstraint[T]{
 		choices:         choices,
+		values:          values,
 		choicesValue:    s.String(),
 		err:             validation.ErrNoSuchChoice,
 		messageTemplate: validation.ErrNoSuchChoice.Message(),
@@ -69,10 +72,28 @@ func (c ChoiceConstraint[T]) WithError(err error) ChoiceConstraint[T] {
 //	{{ value }} - the current (invalid) value.
 func (c ChoiceConstraint[T]) WithMessage(template string, parameters ...validation.TemplateParameter) ChoiceConstraint[T] {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "choice" rule with the "choices" parameter
+// (slice of expected values) and the "allowBlank" parameter.
+func (c ChoiceConstraint[T]) Describe() validation.ConstraintDescription {
+	choices := make([]any, len(c.values))
+	for i, value := range c.values {
+		choices[i] = value
+	}
+
+	return validation.ConstraintDescription{
+		Kind:       "choice",
+		Parameters: map[string]any{"choices": choices, "allowBlank": !c.disallowBlank},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c ChoiceConstraint[T]) When(condition bool) ChoiceConstraint[T] {
@@ -107,11 +128,13 @@ func (c ChoiceConstraint[T]) ValidateComparable(ctx context.Context, validator *
 
 	return validator.
 		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: fmt.Sprint(*value)},
 				validation.TemplateParameter{Key: "{{ choices }}", Value: c.choicesValue},
 			)...,
 		).
+		WithInvalidValue(*value).
 		Create()
 }
@@ -13,10 +13,12 @@ import (
 // ComparisonConstraint is used for comparisons between comparable generic types.
 type ComparisonConstraint[T comparable] struct {
 	isIgnored         bool
+	rule              string
 	value             T
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 	comparedValue     string
 	isValid           func(value T) bool
@@ -25,6 +27,7 @@ type ComparisonConstraint[T comparable] struct {
 // IsEqualTo checks that the value is equal to the specified value.
 func IsEqualTo[T comparable](value T) ComparisonConstraint[T] {
 	return ComparisonConstraint[T]{
+		rule:            "equalTo",
 		err:             validation.ErrNotEqual,
 		value:           value,
 		messageTemplate: validation.ErrNotEqual.Message(),
@@ -36,6 +39,7 @@ func IsEqualTo[T comparable](value T) ComparisonConstraint[T] {
 // IsNotEqualTo checks that the value is not equal to the specified value.
 func IsNotEqualTo[T comparable](value T) ComparisonConstraint[T] {
 	return ComparisonConstraint[T]{
+		rule:            "notEqualTo",
 		err:             validation.ErrIsEqual,
 		value:           value,
 		messageTemplate: validation.ErrIsEqual.Message(),
@@ -60,10 +64,22 @@ func (c ComparisonConstraint[T]) WithMessage(
 	parameters ...validation.TemplateParameter,
 ) ComparisonConstraint[T] {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "equalTo" or "notEqualTo" rule with the "value" parameter.
+func (c ComparisonConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       c.rule,
+		Parameters: map[string]any{"value": c.value},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c ComparisonConstraint[T]) When(condition bool) ComparisonConstraint[T] {
@@ -91,22 +107,26 @@ func (c ComparisonConstraint[T]) ValidateComparable(ctx context.Context, validat
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
-				validation.TemplateParameter{Key: "{{ comparedValue }}", Value: c.comparedValue},
-				validation.TemplateParameter{Key: "{{ value }}", Value: formatComparable(*value)},
+				validation.TemplateParameter{Key: "{{ comparedValue }}", Value: c.comparedValue, RawValue: c.value},
+				validation.TemplateParameter{Key: "{{ value }}", Value: formatComparable(*value), RawValue: *value},
 			)...,
 		).
+		WithInvalidValue(*value).
 		Create()
 }
 
 // NumberComparisonConstraint is used for various numeric comparisons between integer and float values.
 type NumberComparisonConstraint[T validation.Numeric] struct {
 	isIgnored         bool
+	rule              string
 	value             T
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 	comparedValue     string
 	isValid           func(value T) bool
@@ -115,6 +135,7 @@ type NumberComparisonConstraint[T validation.Numeric] struct {
 // IsLessThan checks that the number is less than the specified value.
 func IsLessThan[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "lessThan",
 		err:             validation.ErrTooHigh,
 		value:           value,
 		messageTemplate: validation.ErrTooHigh.Message(),
@@ -126,6 +147,7 @@ func IsLessThan[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 // IsLessThanOrEqual checks that the number is less than or equal to the specified value.
 func IsLessThanOrEqual[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "lessThanOrEqual",
 		err:             validation.ErrTooHighOrEqual,
 		value:           value,
 		messageTemplate: validation.ErrTooHighOrEqual.Message(),
@@ -137,6 +159,7 @@ func IsLessThanOrEqual[T validation.Numeric](value T) NumberComparisonConstraint
 // IsGreaterThan checks that the number is greater than the specified value.
 func IsGreaterThan[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "greaterThan",
 		err:             validation.ErrTooLow,
 		value:           value,
 		messageTemplate: validation.ErrTooLow.Message(),
@@ -148,6 +171,7 @@ func IsGreaterThan[T validation.Numeric](value T) NumberComparisonConstraint[T]
 // IsGreaterThanOrEqual checks that the number is greater than or equal to the specified value.
 func IsGreaterThanOrEqual[T validation.Numeric](value T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "greaterThanOrEqual",
 		err:             validation.ErrTooLowOrEqual,
 		value:           value,
 		messageTemplate: validation.ErrTooLowOrEqual.Message(),
@@ -160,6 +184,7 @@ func IsGreaterThanOrEqual[T validation.Numeric](value T) NumberComparisonConstra
 // If you want to allow zero use [IsPositiveOrZero] comparison.
 func IsPositive[T validation.Numeric]() NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "greaterThan",
 		err:             validation.ErrNotPositive,
 		value:           0,
 		messageTemplate: validation.ErrNotPositive.Message(),
@@ -172,6 +197,7 @@ func IsPositive[T validation.Numeric]() NumberComparisonConstraint[T] {
 // If you don't want to allow zero as a valid value, use [IsPositive] comparison.
 func IsPositiveOrZero[T validation.Numeric]() NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "greaterThanOrEqual",
 		err:             validation.ErrNotPositiveOrZero,
 		value:           0,
 		messageTemplate: validation.ErrNotPositiveOrZero.Message(),
@@ -184,6 +210,7 @@ func IsPositiveOrZero[T validation.Numeric]() NumberComparisonConstraint[T] {
 // If you want to allow zero use [IsNegativeOrZero] comparison.
 func IsNegative[T validation.Numeric]() NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "lessThan",
 		err:             validation.ErrNotNegative,
 		value:           0,
 		messageTemplate: validation.ErrNotNegative.Message(),
@@ -196,6 +223,7 @@ func IsNegative[T validation.Numeric]() NumberComparisonConstraint[T] {
 // If you don't want to allow zero as a valid value, use [IsNegative] comparison.
 func IsNegativeOrZero[T validation.Numeric]() NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "lessThanOrEqual",
 		err:             validation.ErrNotNegativeOrZero,
 		value:           0,
 		messageTemplate: validation.ErrNotNegativeOrZero.Message(),
@@ -212,7 +240,9 @@ func IsDivisibleBy[
 	divisor T,
 ) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "divisibleBy",
 		err:             validation.ErrNotDivisible,
+		value:           divisor,
 		messageTemplate: validation.ErrNotDivisible.Message(),
 		comparedValue:   fmt.Sprint(divisor),
 		isValid:         func(n T) bool { return n%divisor == 0 },
@@ -223,7 +253,9 @@ func IsDivisibleBy[
 // It checks that the remainder is zero or almost zero with an error of 1e-12.
 func IsDivisibleByFloat[T ~float32 | ~float64](divisor T) NumberComparisonConstraint[T] {
 	return NumberComparisonConstraint[T]{
+		rule:            "divisibleBy",
 		err:             validation.ErrNotDivisible,
+		value:           divisor,
 		messageTemplate: validation.ErrNotDivisible.Message(),
 		comparedValue:   fmt.Sprint(divisor),
 		isValid:         func(n T) bool { return is.DivisibleBy(float64(n), float64(divisor)) },
@@ -246,10 +278,23 @@ func (c NumberComparisonConstraint[T]) WithMessage(
 	parameters ...validation.TemplateParameter,
 ) NumberComparisonConstraint[T] {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: one of the "lessThan", "lessThanOrEqual", "greaterThan", "greaterThanOrEqual"
+// or "divisibleBy" rules with the "value" parameter.
+func (c NumberComparisonConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       c.rule,
+		Parameters: map[string]any{"value": c.value},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c NumberComparisonConstraint[T]) When(condition bool) NumberComparisonConstraint[T] {
@@ -269,12 +314,14 @@ func (c NumberComparisonConstraint[T]) ValidateNumber(ctx context.Context, valid
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
-				validation.TemplateParameter{Key: "{{ comparedValue }}", Value: c.comparedValue},
-				validation.TemplateParameter{Key: "{{ value }}", Value: fmt.Sprint(*value)},
+				validation.TemplateParameter{Key: "{{ comparedValue }}", Value: c.comparedValue, RawValue: c.value},
+				validation.TemplateParameter{Key: "{{ value }}", Value: fmt.Sprint(*value), RawValue: *value},
 			)...,
 		).
+		WithInvalidValue(*value).
 		Create()
 }
 
@@ -284,6 +331,7 @@ type RangeConstraint[T validation.Numeric] struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 	min               T
 	max               T
@@ -318,10 +366,22 @@ func (c RangeConstraint[T]) WithError(err error) RangeConstraint[T] {
 //	{{ value }} - the current (invalid) value.
 func (c RangeConstraint[T]) WithMessage(template string, parameters ...validation.TemplateParameter) RangeConstraint[T] {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "range" rule with the "min" and "max" parameters.
+func (c RangeConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "range",
+		Parameters: map[string]any{"min": c.min, "max": c.max},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c RangeConstraint[T]) When(condition bool) RangeConstraint[T] {
@@ -347,22 +407,26 @@ func (c RangeConstraint[T]) ValidateNumber(ctx context.Context, validator *valid
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
-				validation.TemplateParameter{Key: "{{ min }}", Value: fmt.Sprint(c.min)},
-				validation.TemplateParameter{Key: "{{ max }}", Value: fmt.Sprint(c.max)},
-				validation.TemplateParameter{Key: "{{ value }}", Value: fmt.Sprint(*value)},
+				validation.TemplateParameter{Key: "{{ min }}", Value: fmt.Sprint(c.min), RawValue: c.min},
+				validation.TemplateParameter{Key: "{{ max }}", Value: fmt.Sprint(c.max), RawValue: c.max},
+				validation.TemplateParameter{Key: "{{ value }}", Value: fmt.Sprint(*value), RawValue: *value},
 			)...,
 		).
+		WithInvalidValue(*value).
 		Create()
 }
 
 // TimeComparisonConstraint is used to compare time values.
 type TimeComparisonConstraint struct {
 	isIgnored         bool
+	rule              string
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 	comparedValue     time.Time
 	layout            string
@@ -372,6 +436,7 @@ type TimeComparisonConstraint struct {
 // IsEarlierThan checks that the given time is earlier than the specified value.
 func IsEarlierThan(value time.Time) TimeComparisonConstraint {
 	return TimeComparisonConstraint{
+		rule:            "earlierThan",
 		err:             validation.ErrTooLate,
 		messageTemplate: validation.ErrTooLate.Message(),
 		comparedValue:   value,
@@ -385,6 +450,7 @@ func IsEarlierThan(value time.Time) TimeComparisonConstraint {
 // IsEarlierThanOrEqual checks that the given time is earlier or equal to the specified value.
 func IsEarlierThanOrEqual(value time.Time) TimeComparisonConstraint {
 	return TimeComparisonConstraint{
+		rule:            "earlierThanOrEqual",
 		err:             validation.ErrTooLateOrEqual,
 		messageTemplate: validation.ErrTooLateOrEqual.Message(),
 		comparedValue:   value,
@@ -398,6 +464,7 @@ func IsEarlierThanOrEqual(value time.Time) TimeComparisonConstraint {
 // IsLaterThan checks that the given time is later than the specified value.
 func IsLaterThan(value time.Time) TimeComparisonConstraint {
 	return TimeComparisonConstraint{
+		rule:            "laterThan",
 		err:             validation.ErrTooEarly,
 		messageTemplate: validation.ErrTooEarly.Message(),
 		comparedValue:   value,
@@ -411,6 +478,7 @@ func IsLaterThan(value time.Time) TimeComparisonConstraint {
 // IsLaterThanOrEqual checks that the given time is later or equal to the specified value.
 func IsLaterThanOrEqual(value time.Time) TimeComparisonConstraint {
 	return TimeComparisonConstraint{
+		rule:            "laterThanOrEqual",
 		err:             validation.ErrTooEarlyOrEqual,
 		messageTemplate: validation.ErrTooEarlyOrEqual.Message(),
 		comparedValue:   value,
@@ -435,11 +503,13 @@ func (c TimeComparisonConstraint) WithError(err error) TimeComparisonConstraint
 //
 // All values are formatted by the layout that can be defined by the [TimeComparisonConstraint.WithLayout] method.
 // Default layout is [time.RFC3339].
+// The layout can be replaced by the locale pattern using the [validation.SetDateTimeStyle] option.
 func (c TimeComparisonConstraint) WithMessage(
 	template string,
 	parameters ...validation.TemplateParameter,
 ) TimeComparisonConstraint {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
@@ -450,6 +520,18 @@ func (c TimeComparisonConstraint) WithLayout(layout string) TimeComparisonConstr
 	return c
 }
 
+// Describe returns the description of the constraint: one of the "earlierThan", "earlierThanOrEqual", "laterThan" or "laterThanOrEqual"
+// rules with the "value" parameter.
+func (c TimeComparisonConstraint) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       c.rule,
+		Parameters: map[string]any{"value": c.comparedValue},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c TimeComparisonConstraint) When(condition bool) TimeComparisonConstraint {
@@ -469,12 +551,18 @@ func (c TimeComparisonConstraint) ValidateTime(ctx context.Context, validator *v
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
-				validation.TemplateParameter{Key: "{{ comparedValue }}", Value: c.comparedValue.Format(c.layout)},
-				validation.TemplateParameter{Key: "{{ value }}", Value: value.Format(c.layout)},
+				validation.TemplateParameter{
+					Key:      "{{ comparedValue }}",
+					Value:    c.comparedValue.Format(c.layout),
+					RawValue: c.comparedValue,
+				},
+				validation.TemplateParameter{Key: "{{ value }}", Value: value.Format(c.layout), RawValue: *value},
 			)...,
 		).
+		WithInvalidValue(*value).
 		Create()
 }
 
@@ -484,6 +572,7 @@ type TimeRangeConstraint struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 	layout            string
 	min               time.Time
@@ -516,12 +605,25 @@ func (c TimeRangeConstraint) WithError(err error) TimeRangeConstraint {
 //
 // All values are formatted by the layout that can be defined by the [TimeRangeConstraint.WithLayout] method.
 // Default layout is time.RFC3339.
+// The layout can be replaced by the locale pattern using the [validation.SetDateTimeStyle] option.
 func (c TimeRangeConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) TimeRangeConstraint {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "timeRange" rule with the "min" and "max" parameters.
+func (c TimeRangeConstraint) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "timeRange",
+		Parameters: map[string]any{"min": c.min, "max": c.max},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c TimeRangeConstraint) When(condition bool) TimeRangeConstraint {
@@ -557,13 +659,15 @@ func (c TimeRangeConstraint) ValidateTime(ctx context.Context, validator *valida
 
 func (c TimeRangeConstraint) newViolation(ctx context.Context, validator *validation.Validator, value *time.Time) validation.Violation {
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
-				validation.TemplateParameter{Key: "{{ min }}", Value: c.min.Format(c.layout)},
-				validation.TemplateParameter{Key: "{{ max }}", Value: c.max.Format(c.layout)},
-				validation.TemplateParameter{Key: "{{ value }}", Value: value.Format(c.layout)},
+				validation.TemplateParameter{Key: "{{ min }}", Value: c.min.Format(c.layout), RawValue: c.min},
+				validation.TemplateParameter{Key: "{{ max }}", Value: c.max.Format(c.layout), RawValue: c.max},
+				validation.TemplateParameter{Key: "{{ value }}", Value: value.Format(c.layout), RawValue: *value},
 			)...,
 		).
+		WithInvalidValue(*value).
 		Create()
 }
 
@@ -573,6 +677,7 @@ type UniqueConstraint[T comparable] struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 }
 
@@ -595,10 +700,21 @@ func (c UniqueConstraint[T]) WithError(err error) UniqueConstraint[T] {
 // for injecting its values into the final message.
 func (c UniqueConstraint[T]) WithMessage(template string, parameters ...validation.TemplateParameter) UniqueConstraint[T] {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "unique" rule.
+func (c UniqueConstraint[T]) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "unique",
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c UniqueConstraint[T]) When(condition bool) UniqueConstraint[T] {
@@ -618,7 +734,9 @@ func (c UniqueConstraint[T]) ValidateComparables(ctx context.Context, validator
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(c.messageParameters...).
+		WithInvalidValue(values).
 		Create()
 }
 
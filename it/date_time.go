@@ -15,6 +15,7 @@ type DateTimeConstraint struct {
 	err               error
 	layout            string
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 }
 
@@ -67,10 +68,22 @@ func (c DateTimeConstraint) WithError(err error) DateTimeConstraint {
 //	{{ value }} - the current (invalid) value.
 func (c DateTimeConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) DateTimeConstraint {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "dateTime" rule with the "layout" parameter.
+func (c DateTimeConstraint) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "dateTime",
+		Parameters: map[string]any{"layout": c.layout},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c DateTimeConstraint) When(condition bool) DateTimeConstraint {
@@ -93,11 +106,14 @@ func (c DateTimeConstraint) ValidateString(ctx context.Context, validator *valid
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ layout }}", Value: c.layout},
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
 			)...,
 		).
-		WithParameter("{{ value }}", *value).Create()
+		WithParameter("{{ value }}", *value).
+		WithInvalidValue(*value).
+		Create()
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/expression"
 	"github.com/muonsoft/validation/it"
 	"github.com/muonsoft/validation/validator"
 )
@@ -887,3 +888,21 @@ func ExampleIPConstraint_DenyIP() {
 	// Output:
 	// violation: "This IP address is prohibited to use."
 }
+
+func ExampleSatisfies() {
+	type Order struct {
+		Price    float64 `json:"price"`
+		Discount float64 `json:"discount"`
+		Role     string  `json:"role"`
+	}
+	rule := expression.MustCompile(`discount <= price * 0.5 || role == "admin"`)
+	order := Order{Price: 100, Discount: 60, Role: "manager"}
+
+	err := validator.Validate(
+		context.Background(),
+		validation.This[any](order, it.Satisfies(rule)).At(validation.PropertyName("discount")),
+	)
+	fmt.Println(err)
+	// Output:
+	// violation at "discount": "This value should satisfy the expression discount <= price * 0.5 || role == "admin"."
+}
@@ -0,0 +1,115 @@
+package it
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/expression"
+)
+
+// ExpressionConstraint is used to ensure that the value satisfies the expression
+// (see [github.com/muonsoft/validation/expression] for the syntax). The value is used as the variables
+// of the expression, so it should be a struct or a map with string keys. It is designed for
+// the cross-field rules like `discount <= price * 0.5 || role == "admin"`, that can be defined
+// in the configuration files. Use it with the [validation.This] argument.
+//
+// If the expression cannot be evaluated for the value (e.g. the struct has no such property
+// or the result is not boolean), then the [validation.ConstraintError] is returned.
+type ExpressionConstraint struct {
+	isIgnored         bool
+	groups            []string
+	expression        *expression.Expression
+	err               error
+	messageTemplate   string
+	isCustomMessage   bool
+	messageParameters validation.TemplateParameterList
+}
+
+// Satisfies creates an [ExpressionConstraint] to check that the value satisfies the compiled expression.
+// Use [expression.Compile] to get the errors of the invalid expressions before the validation
+// or [expression.MustCompile] to initialize the global variables.
+func Satisfies(e *expression.Expression) ExpressionConstraint {
+	return ExpressionConstraint{
+		expression:      e,
+		err:             validation.ErrNotSatisfied,
+		messageTemplate: validation.ErrNotSatisfied.Message(),
+	}
+}
+
+// WithError overrides default error for produced violation.
+func (c ExpressionConstraint) WithError(err error) ExpressionConstraint {
+	c.err = err
+	return c
+}
+
+// WithMessage sets the violation message template. You can set custom template parameters
+// for injecting its values into the final message. Also, you can use default parameters:
+//
+//	{{ expression }} - the source text of the expression.
+func (c ExpressionConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) ExpressionConstraint {
+	c.messageTemplate = template
+	c.isCustomMessage = true
+	c.messageParameters = parameters
+	return c
+}
+
+// When enables conditional validation of this constraint. If the expression evaluates to false,
+// then the constraint will be ignored.
+func (c ExpressionConstraint) When(condition bool) ExpressionConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+// WhenGroups enables conditional validation of the constraint by using the validation groups.
+func (c ExpressionConstraint) WhenGroups(groups ...string) ExpressionConstraint {
+	c.groups = groups
+	return c
+}
+
+// Describe returns the description of the constraint: the "expression" rule with the "expression" parameter.
+func (c ExpressionConstraint) Describe() validation.ConstraintDescription {
+	source := ""
+	if c.expression != nil {
+		source = c.expression.String()
+	}
+
+	return validation.ConstraintDescription{
+		Kind:       "expression",
+		Parameters: map[string]any{"expression": source},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
+func (c ExpressionConstraint) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if c.expression == nil {
+		return validator.CreateConstraintError("ExpressionConstraint", "nil expression")
+	}
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
+		return nil
+	}
+
+	isSatisfied, err := c.expression.EvaluateBool(value)
+	if err != nil {
+		return validator.CreateConstraintError(
+			"ExpressionConstraint",
+			fmt.Sprintf("expression %q: %s", c.expression.String(), err),
+		)
+	}
+	if isSatisfied {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.TemplateParameter{Key: "{{ expression }}", Value: c.expression.String()},
+			)...,
+		).
+		WithInvalidValue(value).
+		Create()
+}
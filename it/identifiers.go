@@ -13,7 +13,8 @@ import (
 func IsULID() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.ULID).
 		WithError(validation.ErrInvalidULID).
-		WithMessage(validation.ErrInvalidULID.Message())
+		WithDefaultMessage(validation.ErrInvalidULID.Message()).
+		WithDescription("ulid", nil)
 }
 
 // UUIDConstraint validates whether a string value is a valid UUID (also known as GUID).
@@ -35,6 +36,7 @@ type UUIDConstraint struct {
 	options           []func(o *validate.UUIDOptions)
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 }
 
@@ -87,10 +89,21 @@ func (c UUIDConstraint) WithError(err error) UUIDConstraint {
 //	{{ value }} - the current (invalid) value.
 func (c UUIDConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) UUIDConstraint {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "uuid" rule.
+func (c UUIDConstraint) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "uuid",
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c UUIDConstraint) When(condition bool) UUIDConstraint {
@@ -113,10 +126,12 @@ func (c UUIDConstraint) ValidateString(ctx context.Context, validator *validatio
 	}
 
 	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
 			)...,
 		).
+		WithInvalidValue(*value).
 		Create()
 }
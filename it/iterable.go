@@ -23,12 +23,16 @@ type CountConstraint struct {
 	exactErr                     error
 	divisibleErr                 error
 	minMessageTemplate           string
+	isCustomMinMessage           bool
 	minMessageParameters         validation.TemplateParameterList
 	maxMessageTemplate           string
+	isCustomMaxMessage           bool
 	maxMessageParameters         validation.TemplateParameterList
 	exactMessageTemplate         string
+	isCustomExactMessage         bool
 	exactMessageParameters       validation.TemplateParameterList
 	divisibleByMessageTemplate   string
+	isCustomDivisibleMessage     bool
 	divisibleByMessageParameters validation.TemplateParameterList
 }
 
@@ -89,6 +93,51 @@ func HasCountDivisibleBy(divisor int) CountConstraint {
 	return c
 }
 
+// Describe returns the description of the constraint: the "count" rule with the "min", "max" and "divisibleBy" parameters.
+// Only the checked parameters and the violations of the checked limits are set.
+func (c CountConstraint) Describe() validation.ConstraintDescription {
+	parameters := map[string]any{}
+	var violations []validation.ViolationDescription
+	if c.checkMin && c.checkMax && c.min == c.max {
+		parameters["min"] = c.min
+		parameters["max"] = c.max
+		violations = append(violations, validation.ViolationDescription{
+			Err:             c.exactErr,
+			MessageTemplate: c.exactMessageTemplate,
+		})
+	} else {
+		if c.checkMin {
+			parameters["min"] = c.min
+			violations = append(violations, validation.ViolationDescription{
+				Err:             c.minErr,
+				MessageTemplate: c.minMessageTemplate,
+			})
+		}
+		if c.checkMax {
+			parameters["max"] = c.max
+			violations = append(violations, validation.ViolationDescription{
+				Err:             c.maxErr,
+				MessageTemplate: c.maxMessageTemplate,
+			})
+		}
+	}
+	if c.checkDivisible {
+		parameters["divisibleBy"] = c.divisibleBy
+		violations = append(violations, validation.ViolationDescription{
+			Err:             c.divisibleErr,
+			MessageTemplate: c.divisibleByMessageTemplate,
+		})
+	}
+
+	return validation.ConstraintDescription{
+		Kind:       "count",
+		Parameters: parameters,
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: violations,
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c CountConstraint) When(condition bool) CountConstraint {
@@ -138,6 +187,7 @@ func (c CountConstraint) WithDivisibleError(err error) CountConstraint {
 //	{{ limit }} - the lower limit.
 func (c CountConstraint) WithMinMessage(template string, parameters ...validation.TemplateParameter) CountConstraint {
 	c.minMessageTemplate = template
+	c.isCustomMinMessage = true
 	c.minMessageParameters = parameters
 	return c
 }
@@ -150,6 +200,7 @@ func (c CountConstraint) WithMinMessage(template string, parameters ...validatio
 //	{{ limit }} - the upper limit.
 func (c CountConstraint) WithMaxMessage(template string, parameters ...validation.TemplateParameter) CountConstraint {
 	c.maxMessageTemplate = template
+	c.isCustomMaxMessage = true
 	c.maxMessageParameters = parameters
 	return c
 }
@@ -162,6 +213,7 @@ func (c CountConstraint) WithMaxMessage(template string, parameters ...validatio
 //	{{ limit }} - the exact expected collection size.
 func (c CountConstraint) WithExactMessage(template string, parameters ...validation.TemplateParameter) CountConstraint {
 	c.exactMessageTemplate = template
+	c.isCustomExactMessage = true
 	c.exactMessageParameters = parameters
 	return c
 }
@@ -174,6 +226,7 @@ func (c CountConstraint) WithExactMessage(template string, parameters ...validat
 //	{{ divisibleBy }} - the divisor for the collection size.
 func (c CountConstraint) WithDivisibleMessage(template string, parameters ...validation.TemplateParameter) CountConstraint {
 	c.divisibleByMessageTemplate = template
+	c.isCustomDivisibleMessage = true
 	c.divisibleByMessageParameters = parameters
 	return c
 }
@@ -186,10 +239,10 @@ func (c CountConstraint) ValidateCountable(ctx context.Context, validator *valid
 		return c.newNotDivisibleViolation(ctx, validator, count)
 	}
 	if c.checkMax && count > c.max {
-		return c.newViolation(ctx, validator, count, c.max, c.maxErr, c.maxMessageTemplate, c.maxMessageParameters)
+		return c.newViolation(ctx, validator, count, c.max, c.maxErr, c.maxMessageTemplate, c.isCustomMaxMessage, c.maxMessageParameters)
 	}
 	if c.checkMin && count < c.min {
-		return c.newViolation(ctx, validator, count, c.min, c.minErr, c.minMessageTemplate, c.minMessageParameters)
+		return c.newViolation(ctx, validator, count, c.min, c.minErr, c.minMessageTemplate, c.isCustomMinMessage, c.minMessageParameters)
 	}
 
 	return nil
@@ -201,22 +254,26 @@ func (c CountConstraint) newViolation(
 	count, limit int,
 	err error,
 	template string,
+	isCustomMessage bool,
 	parameters validation.TemplateParameterList,
 ) validation.Violation {
 	if c.checkMin && c.checkMax && c.min == c.max {
 		template = c.exactMessageTemplate
+		isCustomMessage = c.isCustomExactMessage
 		parameters = c.exactMessageParameters
 		err = c.exactErr
 	}
 
 	return validator.BuildViolation(ctx, err, template).
+		WithExplicitMessage(isCustomMessage).
 		WithPluralCount(limit).
 		WithParameters(
 			parameters.Prepend(
-				validation.TemplateParameter{Key: "{{ count }}", Value: strconv.Itoa(count)},
-				validation.TemplateParameter{Key: "{{ limit }}", Value: strconv.Itoa(limit)},
+				validation.TemplateParameter{Key: "{{ count }}", Value: strconv.Itoa(count), RawValue: count},
+				validation.TemplateParameter{Key: "{{ limit }}", Value: strconv.Itoa(limit), RawValue: limit},
 			)...,
 		).
+		WithInvalidValue(count).
 		Create()
 }
 
@@ -226,12 +283,14 @@ func (c CountConstraint) newNotDivisibleViolation(
 	count int,
 ) validation.Violation {
 	return validator.BuildViolation(ctx, c.divisibleErr, c.divisibleByMessageTemplate).
+		WithExplicitMessage(c.isCustomDivisibleMessage).
 		WithPluralCount(c.divisibleBy).
 		WithParameters(
 			c.divisibleByMessageParameters.Prepend(
-				validation.TemplateParameter{Key: "{{ count }}", Value: strconv.Itoa(count)},
-				validation.TemplateParameter{Key: "{{ divisibleBy }}", Value: strconv.Itoa(c.divisibleBy)},
+				validation.TemplateParameter{Key: "{{ count }}", Value: strconv.Itoa(count), RawValue: count},
+				validation.TemplateParameter{Key: "{{ divisibleBy }}", Value: strconv.Itoa(c.divisibleBy), RawValue: c.divisibleBy},
 			)...,
 		).
+		WithInvalidValue(count).
 		Create()
 }
@@ -23,10 +23,13 @@ type LengthConstraint struct {
 	maxErr                 error
 	exactErr               error
 	minMessageTemplate     string
+	isCustomMinMessage     bool
 	minMessageParameters   validation.TemplateParameterList
 	maxMessageTemplate     string
+	isCustomMaxMessage     bool
 	maxMessageParameters   validation.TemplateParameterList
 	exactMessageTemplate   string
+	isCustomExactMessage   bool
 	exactMessageParameters validation.TemplateParameterList
 }
 
@@ -69,6 +72,44 @@ func HasExactLength(count int) LengthConstraint {
 	return newLengthConstraint(count, count, true, true)
 }
 
+// Describe returns the description of the constraint: the "length" rule with the "min" and "max" parameters.
+// Only the checked parameters and the violations of the checked limits are set.
+func (c LengthConstraint) Describe() validation.ConstraintDescription {
+	parameters := map[string]any{}
+	var violations []validation.ViolationDescription
+	if c.checkMin && c.checkMax && c.min == c.max {
+		parameters["min"] = c.min
+		parameters["max"] = c.max
+		violations = append(violations, validation.ViolationDescription{
+			Err:             c.exactErr,
+			MessageTemplate: c.exactMessageTemplate,
+		})
+	} else {
+		if c.checkMin {
+			parameters["min"] = c.min
+			violations = append(violations, validation.ViolationDescription{
+				Err:             c.minErr,
+				MessageTemplate: c.minMessageTemplate,
+			})
+		}
+		if c.checkMax {
+			parameters["max"] = c.max
+			violations = append(violations, validation.ViolationDescription{
+				Err:             c.maxErr,
+				MessageTemplate: c.maxMessageTemplate,
+			})
+		}
+	}
+
+	return validation.ConstraintDescription{
+		Kind:       "length",
+		Parameters: parameters,
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: violations,
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c LengthConstraint) When(condition bool) LengthConstraint {
@@ -112,6 +153,7 @@ func (c LengthConstraint) WithExactError(err error) LengthConstraint {
 //	{{ value }} - the current (invalid) value.
 func (c LengthConstraint) WithMinMessage(template string, parameters ...validation.TemplateParameter) LengthConstraint {
 	c.minMessageTemplate = template
+	c.isCustomMinMessage = true
 	c.minMessageParameters = parameters
 	return c
 }
@@ -125,6 +167,7 @@ func (c LengthConstraint) WithMinMessage(template string, parameters ...validati
 //	{{ value }} - the current (invalid) value.
 func (c LengthConstraint) WithMaxMessage(template string, parameters ...validation.TemplateParameter) LengthConstraint {
 	c.maxMessageTemplate = template
+	c.isCustomMaxMessage = true
 	c.maxMessageParameters = parameters
 	return c
 }
@@ -138,6 +181,7 @@ func (c LengthConstraint) WithMaxMessage(template string, parameters ...validati
 //	{{ value }} - the current (invalid) value.
 func (c LengthConstraint) WithExactMessage(template string, parameters ...validation.TemplateParameter) LengthConstraint {
 	c.exactMessageTemplate = template
+	c.isCustomExactMessage = true
 	c.exactMessageParameters = parameters
 	return c
 }
@@ -150,10 +194,10 @@ func (c LengthConstraint) ValidateString(ctx context.Context, validator *validat
 	count := utf8.RuneCountInString(*value)
 
 	if c.checkMax && count > c.max {
-		return c.newViolation(ctx, validator, count, c.max, *value, c.maxErr, c.maxMessageTemplate, c.maxMessageParameters)
+		return c.newViolation(ctx, validator, count, c.max, *value, c.maxErr, c.maxMessageTemplate, c.isCustomMaxMessage, c.maxMessageParameters)
 	}
 	if c.checkMin && count < c.min {
-		return c.newViolation(ctx, validator, count, c.min, *value, c.minErr, c.minMessageTemplate, c.minMessageParameters)
+		return c.newViolation(ctx, validator, count, c.min, *value, c.minErr, c.minMessageTemplate, c.isCustomMinMessage, c.minMessageParameters)
 	}
 
 	return nil
@@ -166,23 +210,27 @@ func (c LengthConstraint) newViolation(
 	value string,
 	err error,
 	template string,
+	isCustomMessage bool,
 	parameters validation.TemplateParameterList,
 ) validation.Violation {
 	if c.checkMin && c.checkMax && c.min == c.max {
 		template = c.exactMessageTemplate
+		isCustomMessage = c.isCustomExactMessage
 		parameters = c.exactMessageParameters
 		err = c.exactErr
 	}
 
 	return validator.BuildViolation(ctx, err, template).
+		WithExplicitMessage(isCustomMessage).
 		WithPluralCount(limit).
 		WithParameters(
 			parameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: strconv.Quote(value)},
-				validation.TemplateParameter{Key: "{{ length }}", Value: strconv.Itoa(count)},
-				validation.TemplateParameter{Key: "{{ limit }}", Value: strconv.Itoa(limit)},
+				validation.TemplateParameter{Key: "{{ length }}", Value: strconv.Itoa(count), RawValue: count},
+				validation.TemplateParameter{Key: "{{ limit }}", Value: strconv.Itoa(limit), RawValue: limit},
 			)...,
 		).
+		WithInvalidValue(value).
 		Create()
 }
 
@@ -193,6 +241,7 @@ type RegexpConstraint struct {
 	groups            []string
 	err               error
 	messageTemplate   string
+	isCustomMessage   bool
 	messageParameters validation.TemplateParameterList
 	regex             *regexp.Regexp
 }
@@ -229,10 +278,28 @@ func (c RegexpConstraint) WithError(err error) RegexpConstraint {
 //	{{ value }} - the current (invalid) value.
 func (c RegexpConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) RegexpConstraint {
 	c.messageTemplate = template
+	c.isCustomMessage = true
 	c.messageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "regex" rule with the "pattern" parameter and the "match" parameter
+// that is false for the [DoesNotMatch] constraint.
+func (c RegexpConstraint) Describe() validation.ConstraintDescription {
+	pattern := ""
+	if c.regex != nil {
+		pattern = c.regex.String()
+	}
+
+	return validation.ConstraintDescription{
+		Kind:       "regex",
+		Parameters: map[string]any{"pattern": pattern, "match": c.match},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{{Err: c.err, MessageTemplate: c.messageTemplate}},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c RegexpConstraint) When(condition bool) RegexpConstraint {
@@ -259,11 +326,13 @@ func (c RegexpConstraint) ValidateString(ctx context.Context, validator *validat
 
 	return validator.
 		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithExplicitMessage(c.isCustomMessage).
 		WithParameters(
 			c.messageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: *value},
 			)...,
 		).
+		WithInvalidValue(*value).
 		Create()
 }
 
@@ -271,19 +340,22 @@ func (c RegexpConstraint) ValidateString(ctx context.Context, validator *validat
 func IsJSON() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.JSON).
 		WithError(validation.ErrInvalidJSON).
-		WithMessage(validation.ErrInvalidJSON.Message())
+		WithDefaultMessage(validation.ErrInvalidJSON.Message()).
+		WithDescription("json", nil)
 }
 
 // IsInteger checks that string value is an integer.
 func IsInteger() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.Integer).
 		WithError(validation.ErrNotInteger).
-		WithMessage(validation.ErrNotInteger.Message())
+		WithDefaultMessage(validation.ErrNotInteger.Message()).
+		WithDescription("integer", nil)
 }
 
 // IsNumeric checks that string value is a valid numeric (integer or float).
 func IsNumeric() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.Number).
 		WithError(validation.ErrNotNumeric).
-		WithMessage(validation.ErrNotNumeric.Message())
+		WithDefaultMessage(validation.ErrNotNumeric.Message()).
+		WithDescription("numeric", nil)
 }
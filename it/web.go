@@ -17,7 +17,8 @@ import (
 func IsEmail() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.Email).
 		WithError(validation.ErrInvalidEmail).
-		WithMessage(validation.ErrInvalidEmail.Message())
+		WithDefaultMessage(validation.ErrInvalidEmail.Message()).
+		WithDescription("email", nil)
 }
 
 // IsHTML5Email is used for validation of an email address based on pattern for HTML5
@@ -25,7 +26,8 @@ func IsEmail() validation.StringFuncConstraint {
 func IsHTML5Email() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.HTML5Email).
 		WithError(validation.ErrInvalidEmail).
-		WithMessage(validation.ErrInvalidEmail.Message())
+		WithDefaultMessage(validation.ErrInvalidEmail.Message()).
+		WithDescription("email", map[string]any{"html5": true})
 }
 
 // IsHostname validates that a value is a valid hostname. It checks that:
@@ -41,7 +43,8 @@ func IsHTML5Email() validation.StringFuncConstraint {
 func IsHostname() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.StrictHostname).
 		WithError(validation.ErrInvalidHostname).
-		WithMessage(validation.ErrInvalidHostname.Message())
+		WithDefaultMessage(validation.ErrInvalidHostname.Message()).
+		WithDescription("hostname", nil)
 }
 
 // IsLooseHostname validates that a value is a valid hostname. It checks that:
@@ -50,7 +53,8 @@ func IsHostname() validation.StringFuncConstraint {
 func IsLooseHostname() validation.StringFuncConstraint {
 	return validation.OfStringBy(is.Hostname).
 		WithError(validation.ErrInvalidHostname).
-		WithMessage(validation.ErrInvalidHostname.Message())
+		WithDefaultMessage(validation.ErrInvalidHostname.Message()).
+		WithDescription("hostname", map[string]any{"loose": true})
 }
 
 // URLConstraint is used to validate URL string. This constraint doesn’t check that the host of the
@@ -68,8 +72,10 @@ type URLConstraint struct {
 	invalidErr                  error
 	prohibitedErr               error
 	invalidMessageTemplate      string
+	isCustomInvalidMessage      bool
 	invalidMessageParameters    validation.TemplateParameterList
 	prohibitedMessageTemplate   string
+	isCustomProhibitedMessage   bool
 	prohibitedMessageParameters validation.TemplateParameterList
 }
 
@@ -140,6 +146,7 @@ func (c URLConstraint) WithError(err error) URLConstraint {
 //	{{ value }} - the current (invalid) value.
 func (c URLConstraint) WithMessage(template string, parameters ...validation.TemplateParameter) URLConstraint {
 	c.invalidMessageTemplate = template
+	c.isCustomInvalidMessage = true
 	c.invalidMessageParameters = parameters
 	return c
 }
@@ -157,10 +164,38 @@ func (c URLConstraint) WithProhibitedError(err error) URLConstraint {
 //	{{ value }} - the current (invalid) value.
 func (c URLConstraint) WithProhibitedMessage(template string, parameters ...validation.TemplateParameter) URLConstraint {
 	c.prohibitedMessageTemplate = template
+	c.isCustomProhibitedMessage = true
 	c.prohibitedMessageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "url" rule with the "schemas", "relativeSchema",
+// "hosts" and "hostPattern" parameters. The "restricted" parameter is true if the constraint has
+// custom restrictions.
+func (c URLConstraint) Describe() validation.ConstraintDescription {
+	hostPattern := ""
+	if c.hostPattern != nil {
+		hostPattern = c.hostPattern.String()
+	}
+
+	return validation.ConstraintDescription{
+		Kind: "url",
+		Parameters: map[string]any{
+			"schemas":        c.schemas,
+			"relativeSchema": c.supportsRelativeSchema,
+			"hosts":          c.hosts,
+			"hostPattern":    hostPattern,
+			"restricted":     len(c.restrictions) > 0,
+		},
+		Groups:  c.groups,
+		Ignored: c.isIgnored,
+		Violations: []validation.ViolationDescription{
+			{Err: c.invalidErr, MessageTemplate: c.invalidMessageTemplate},
+			{Err: c.prohibitedErr, MessageTemplate: c.prohibitedMessageTemplate},
+		},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c URLConstraint) When(condition bool) URLConstraint {
@@ -213,21 +248,25 @@ func (c URLConstraint) getRestrictions() []func(u *url.URL) error {
 
 func (c URLConstraint) newInvalidViolation(ctx context.Context, validator *validation.Validator, value string) error {
 	return validator.BuildViolation(ctx, c.invalidErr, c.invalidMessageTemplate).
+		WithExplicitMessage(c.isCustomInvalidMessage).
 		WithParameters(
 			c.invalidMessageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: value},
 			)...,
 		).
+		WithInvalidValue(value).
 		Create()
 }
 
 func (c URLConstraint) newProhibitedViolation(ctx context.Context, validator *validation.Validator, value string) error {
 	return validator.BuildViolation(ctx, c.prohibitedErr, c.prohibitedMessageTemplate).
+		WithExplicitMessage(c.isCustomProhibitedMessage).
 		WithParameters(
 			c.prohibitedMessageParameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: value},
 			)...,
 		).
+		WithInvalidValue(value).
 		Create()
 }
 
@@ -235,6 +274,7 @@ func (c URLConstraint) newProhibitedViolation(ctx context.Context, validator *va
 // and restrict some ranges by additional options.
 type IPConstraint struct {
 	isIgnored    bool
+	version      int
 	validate     func(value string, restrictions ...func(ip net.IP) error) error
 	restrictions []func(ip net.IP) error
 
@@ -244,8 +284,10 @@ type IPConstraint struct {
 	prohibitedErr error
 
 	invalidMessageTemplate      string
+	isCustomInvalidMessage      bool
 	invalidMessageParameters    validation.TemplateParameterList
 	prohibitedMessageTemplate   string
+	isCustomProhibitedMessage   bool
 	prohibitedMessageParameters validation.TemplateParameterList
 }
 
@@ -256,12 +298,16 @@ func IsIP() IPConstraint {
 
 // IsIPv4 creates an IPConstraint to validate an IPv4 address.
 func IsIPv4() IPConstraint {
-	return newIPConstraint(validate.IPv4)
+	c := newIPConstraint(validate.IPv4)
+	c.version = 4
+	return c
 }
 
 // IsIPv6 creates an IPConstraint to validate an IPv4 address.
 func IsIPv6() IPConstraint {
-	return newIPConstraint(validate.IPv6)
+	c := newIPConstraint(validate.IPv6)
+	c.version = 6
+	return c
 }
 
 func newIPConstraint(validate func(value string, restrictions ...func(ip net.IP) error) error) IPConstraint {
@@ -312,6 +358,7 @@ func (c IPConstraint) WithProhibitedError(err error) IPConstraint {
 //	{{ value }} - the current (invalid) value.
 func (c IPConstraint) WithInvalidMessage(template string, parameters ...validation.TemplateParameter) IPConstraint {
 	c.invalidMessageTemplate = template
+	c.isCustomInvalidMessage = true
 	c.invalidMessageParameters = parameters
 	return c
 }
@@ -323,10 +370,26 @@ func (c IPConstraint) WithInvalidMessage(template string, parameters ...validati
 //	{{ value }} - the current (invalid) value.
 func (c IPConstraint) WithProhibitedMessage(template string, parameters ...validation.TemplateParameter) IPConstraint {
 	c.prohibitedMessageTemplate = template
+	c.isCustomProhibitedMessage = true
 	c.prohibitedMessageParameters = parameters
 	return c
 }
 
+// Describe returns the description of the constraint: the "ip" rule with the "version" parameter
+// (4, 6 or 0 for any version). The "restricted" parameter is true if some ranges of IP addresses are denied.
+func (c IPConstraint) Describe() validation.ConstraintDescription {
+	return validation.ConstraintDescription{
+		Kind:       "ip",
+		Parameters: map[string]any{"version": c.version, "restricted": len(c.restrictions) > 0},
+		Groups:     c.groups,
+		Ignored:    c.isIgnored,
+		Violations: []validation.ViolationDescription{
+			{Err: c.invalidErr, MessageTemplate: c.invalidMessageTemplate},
+			{Err: c.prohibitedErr, MessageTemplate: c.prohibitedMessageTemplate},
+		},
+	}
+}
+
 // When enables conditional validation of this constraint. If the expression evaluates to false,
 // then the constraint will be ignored.
 func (c IPConstraint) When(condition bool) IPConstraint {
@@ -358,10 +421,12 @@ func (c IPConstraint) validateIP(ctx context.Context, validator *validation.Vali
 	var parameters validation.TemplateParameterList
 
 	if errors.Is(err, validate.ErrProhibited) {
-		builder = validator.BuildViolation(ctx, c.prohibitedErr, c.prohibitedMessageTemplate)
+		builder = validator.BuildViolation(ctx, c.prohibitedErr, c.prohibitedMessageTemplate).
+			WithExplicitMessage(c.isCustomProhibitedMessage)
 		parameters = c.prohibitedMessageParameters
 	} else {
-		builder = validator.BuildViolation(ctx, c.invalidErr, c.invalidMessageTemplate)
+		builder = validator.BuildViolation(ctx, c.invalidErr, c.invalidMessageTemplate).
+			WithExplicitMessage(c.isCustomInvalidMessage)
 		parameters = c.invalidMessageParameters
 	}
 
@@ -371,5 +436,6 @@ func (c IPConstraint) validateIP(ctx context.Context, validator *validation.Vali
 				validation.TemplateParameter{Key: "{{ value }}", Value: value},
 			)...,
 		).
+		WithInvalidValue(value).
 		Create()
 }
@@ -0,0 +1,661 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/internal/dynamic"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/schema"
+)
+
+var (
+	// ErrInvalidSchema is returned by [Compile] when the schema is malformed
+	// (e.g. a keyword has a value of the wrong type or the pattern is not a valid regular expression).
+	ErrInvalidSchema = errors.New("invalid schema")
+
+	// ErrUnsupportedKeyword is returned by [Compile] when the schema contains a keyword
+	// that is not supported by the compiler. Unknown keywords are not ignored to not accept
+	// the values that must be rejected by the schema.
+	ErrUnsupportedKeyword = errors.New("unsupported keyword")
+
+	// ErrUnsupportedReference is returned by [Compile] when the schema contains a reference
+	// to another document or to an anchor. Only local JSON pointers (e.g. "#/$defs/Tag") are supported.
+	ErrUnsupportedReference = errors.New("unsupported reference")
+)
+
+// Compile compiles the JSON Schema into the validation logic. Only a subset of draft 2020-12 is supported:
+//
+//   - "type" (a single type or a list of types);
+//   - "properties", "required" and "additionalProperties" for objects;
+//   - "items", "minItems", "maxItems" and "uniqueItems" for arrays;
+//   - "minLength", "maxLength", "pattern" and "format" for strings;
+//   - "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum" and "multipleOf" for numbers;
+//   - "enum" and "const";
+//   - "allOf", "anyOf", "oneOf" and "not";
+//   - "$ref" to the local JSON pointers (e.g. "#/$defs/Tag"), recursive references are allowed.
+//
+// Formats "email", "uri", "uuid", "date-time", "date", "hostname", "ipv4" and "ipv6" are checked,
+// other formats are treated as annotations. Annotations ("title", "description", "default", "examples", etc.),
+// "$defs" and the keywords with "x-" prefix are ignored. Any other keyword causes [ErrUnsupportedKeyword] error.
+func Compile(data []byte) (*CompiledSchema, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var root any
+	if err := decoder.Decode(&root); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+
+	c := &compiler{
+		root:       root,
+		references: make(map[string]*compiledRef),
+		inPlace:    make(map[string][]string),
+	}
+	n, err := c.compile(root, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkCycles(); err != nil {
+		return nil, err
+	}
+
+	return &CompiledSchema{root: n}, nil
+}
+
+// CompileSchema compiles the schema created by [Export] into the validation logic. See [Compile] for details.
+func CompileSchema(schema *Schema) (*CompiledSchema, error) {
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("marshal schema: %w", err)
+	}
+
+	return Compile(data)
+}
+
+// MustCompile is like [Compile] but panics if the schema cannot be compiled.
+func MustCompile(data []byte) *CompiledSchema {
+	schema, err := Compile(data)
+	if err != nil {
+		panic(err)
+	}
+
+	return schema
+}
+
+type compiler struct {
+	root any
+	// references are the compiled schemas by the JSON pointers, so the recursive
+	// references are resolved into the same node.
+	references map[string]*compiledRef
+	// inPlace are the pointers of the subschemas validating the same value as the schema
+	// ("$ref", "allOf", "anyOf", "oneOf" and "not"), they are used to find the endless cycles.
+	inPlace map[string][]string
+}
+
+// definition collects the keywords of the schema before building the node.
+type definition struct {
+	pointer string
+
+	types       []string
+	hasTypeKeys bool
+	strings     []validation.StringConstraint
+	numbers     []validation.NumberConstraint[float64]
+	counts      []validation.CountableConstraint
+	uniqueItems bool
+	items       schema.Node
+
+	properties     []property
+	required       []string
+	additional     schema.Node
+	denyAdditional bool
+
+	enum     []string
+	constant *string
+	ref      schema.Node
+	allOf    []schema.Node
+	anyOf    []schema.Node
+	oneOf    []schema.Node
+	not      schema.Node
+
+	// The string constraints of the "it" package skip empty strings, so the "minLength",
+	// "pattern" and "format" keywords are checked for the empty string separately.
+	minLength       int
+	emptyStringErrs []*validation.Error
+}
+
+// compile returns the node of the schema located by the JSON pointer. Nodes are cached
+// by the pointers, so the recursive references are resolved into the same node.
+func (c *compiler) compile(value any, pointer string) (*compiledRef, error) {
+	if ref, exists := c.references[pointer]; exists {
+		return ref, nil
+	}
+	ref := &compiledRef{}
+	c.references[pointer] = ref
+
+	if b, ok := value.(bool); ok {
+		ref.node = schema.Any()
+		if !b {
+			ref.node = schema.Not(schema.Any())
+		}
+		return ref, nil
+	}
+	keywords, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s: schema must be an object or a boolean", ErrInvalidSchema, location(pointer))
+	}
+
+	def := &definition{pointer: pointer}
+	for _, name := range sortedKeys(keywords) {
+		if err := c.compileKeyword(def, name, keywords[name]); err != nil {
+			return nil, err
+		}
+	}
+	ref.node = def.build()
+
+	return ref, nil
+}
+
+//nolint:gocyclo,cyclop,funlen
+func (c *compiler) compileKeyword(def *definition, name string, value any) error {
+	pointer := def.pointer
+	invalid := func(reason string) error {
+		return fmt.Errorf(`%w: %s: "%s" %s`, ErrInvalidSchema, location(pointer), name, reason)
+	}
+
+	switch name {
+	case "type":
+		types, ok := toStrings(value)
+		if !ok || len(types) == 0 {
+			return invalid("must be a string or an array of strings")
+		}
+		for _, t := range types {
+			if !isJSONType(t) {
+				return invalid(fmt.Sprintf(`has unknown type "%s"`, t))
+			}
+		}
+		def.types = types
+
+	case "properties":
+		properties, ok := value.(map[string]any)
+		if !ok {
+			return invalid("must be an object")
+		}
+		for _, name := range sortedKeys(properties) {
+			child, err := c.compile(properties[name], pointer+"/properties/"+escape(name))
+			if err != nil {
+				return err
+			}
+			def.properties = append(def.properties, property{name: name, node: child})
+		}
+		def.hasTypeKeys = true
+
+	case "required":
+		_, isArray := value.([]any)
+		required, ok := toStrings(value)
+		if !isArray || !ok {
+			return invalid("must be an array of strings")
+		}
+		def.required = required
+		def.hasTypeKeys = true
+
+	case "additionalProperties":
+		child, err := c.compile(value, pointer+"/additionalProperties")
+		if err != nil {
+			return err
+		}
+		if allowed, ok := value.(bool); ok {
+			def.denyAdditional = !allowed
+		} else {
+			def.additional = child
+		}
+		def.hasTypeKeys = true
+
+	case "items":
+		if _, isArray := value.([]any); isArray {
+			return fmt.Errorf(`%w: %s: "items" with an array of schemas (use "prefixItems" of draft 2020-12)`,
+				ErrUnsupportedKeyword, location(pointer))
+		}
+		child, err := c.compile(value, pointer+"/items")
+		if err != nil {
+			return err
+		}
+		def.items = child
+		def.hasTypeKeys = true
+
+	case "minItems", "maxItems", "minLength", "maxLength":
+		limit, ok := dynamic.ToInt(value)
+		if !ok || limit < 0 {
+			return invalid("must be a non-negative integer")
+		}
+		switch name {
+		case "minItems":
+			def.counts = append(def.counts, it.HasMinCount(limit))
+		case "maxItems":
+			def.counts = append(def.counts, it.HasMaxCount(limit))
+		case "minLength":
+			def.minLength = limit
+			def.strings = append(def.strings, it.HasMinLength(limit))
+		default:
+			def.strings = append(def.strings, it.HasMaxLength(limit))
+		}
+		def.hasTypeKeys = true
+
+	case "uniqueItems":
+		unique, ok := value.(bool)
+		if !ok {
+			return invalid("must be a boolean")
+		}
+		def.uniqueItems = unique
+		def.hasTypeKeys = true
+
+	case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf":
+		limit, ok := dynamic.ToFloat(value)
+		if !ok {
+			return invalid("must be a number")
+		}
+		switch name {
+		case "minimum":
+			def.numbers = append(def.numbers, it.IsGreaterThanOrEqual(limit))
+		case "maximum":
+			def.numbers = append(def.numbers, it.IsLessThanOrEqual(limit))
+		case "exclusiveMinimum":
+			def.numbers = append(def.numbers, it.IsGreaterThan(limit))
+		case "exclusiveMaximum":
+			def.numbers = append(def.numbers, it.IsLessThan(limit))
+		default:
+			if limit <= 0 {
+				return invalid("must be greater than 0")
+			}
+			def.numbers = append(def.numbers, it.IsDivisibleByFloat(limit))
+		}
+		def.hasTypeKeys = true
+
+	case "pattern":
+		pattern, ok := value.(string)
+		if !ok {
+			return invalid("must be a string")
+		}
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return invalid(fmt.Sprintf("is not a valid regular expression: %s", err))
+		}
+		def.strings = append(def.strings, it.Matches(regex))
+		if !regex.MatchString("") {
+			def.emptyStringErrs = append(def.emptyStringErrs, validation.ErrNotValid)
+		}
+		def.hasTypeKeys = true
+
+	case "format":
+		format, ok := value.(string)
+		if !ok {
+			return invalid("must be a string")
+		}
+		if constraint, err := formatConstraint(format); constraint != nil {
+			def.strings = append(def.strings, constraint)
+			def.emptyStringErrs = append(def.emptyStringErrs, err)
+			def.hasTypeKeys = true
+		}
+
+	case "enum":
+		values, ok := value.([]any)
+		if !ok {
+			return invalid("must be an array")
+		}
+		def.enum = make([]string, len(values))
+		for i, v := range values {
+			def.enum[i] = dynamic.Canonical(v)
+		}
+
+	case "const":
+		constant := dynamic.Canonical(value)
+		def.constant = &constant
+
+	case "allOf", "anyOf", "oneOf":
+		schemas, ok := value.([]any)
+		if !ok || len(schemas) == 0 {
+			return invalid("must be a non-empty array")
+		}
+		nodes := make([]schema.Node, len(schemas))
+		for i, subschema := range schemas {
+			subpointer := pointer + "/" + name + "/" + strconv.Itoa(i)
+			child, err := c.compile(subschema, subpointer)
+			if err != nil {
+				return err
+			}
+			nodes[i] = child
+			c.inPlace[pointer] = append(c.inPlace[pointer], subpointer)
+		}
+		switch name {
+		case "allOf":
+			def.allOf = nodes
+		case "anyOf":
+			def.anyOf = nodes
+		default:
+			def.oneOf = nodes
+		}
+
+	case "not":
+		child, err := c.compile(value, pointer+"/not")
+		if err != nil {
+			return err
+		}
+		def.not = child
+		c.inPlace[pointer] = append(c.inPlace[pointer], pointer+"/not")
+
+	case "$ref":
+		ref, ok := value.(string)
+		if !ok {
+			return invalid("must be a string")
+		}
+		target, targetPointer, err := c.resolve(ref)
+		if err != nil {
+			return err
+		}
+		def.ref = target
+		c.inPlace[pointer] = append(c.inPlace[pointer], targetPointer)
+
+	case "$schema", "$id", "$comment", "$defs", "definitions", "title", "description",
+		"default", "examples", "deprecated", "readOnly", "writeOnly":
+		// annotations and definitions are not validated, definitions are compiled by the references
+
+	default:
+		if !strings.HasPrefix(name, "x-") {
+			return fmt.Errorf(`%w: %s: "%s"`, ErrUnsupportedKeyword, location(pointer), name)
+		}
+	}
+
+	return nil
+}
+
+// build creates the node of the schema. The keywords depending on the type of the value are
+// applied by the [schema.Union] of the typed nodes, so they are skipped for the values of other types.
+func (def *definition) build() schema.Node {
+	nodes := make([]schema.Node, 0, 1)
+	if len(def.types) > 0 || def.hasTypeKeys {
+		nodes = append(nodes, def.buildUnion())
+	}
+	if len(def.enum) > 0 {
+		nodes = append(nodes, enumNode{values: def.enum})
+	}
+	if def.constant != nil {
+		nodes = append(nodes, constNode{value: *def.constant})
+	}
+	if def.ref != nil {
+		nodes = append(nodes, def.ref)
+	}
+	nodes = append(nodes, def.allOf...)
+	if len(def.anyOf) > 0 {
+		nodes = append(nodes, schema.AnyOf(def.anyOf...))
+	}
+	if len(def.oneOf) > 0 {
+		nodes = append(nodes, schema.OneOf(def.oneOf...))
+	}
+	if def.not != nil {
+		nodes = append(nodes, schema.Not(def.not))
+	}
+
+	switch len(nodes) {
+	case 0:
+		return schema.Any()
+	case 1:
+		return nodes[0]
+	}
+
+	return schema.All(nodes...)
+}
+
+func (def *definition) buildUnion() schema.UnionNode {
+	types := def.types
+	if len(types) == 0 {
+		types = []string{"null", "boolean", "string", "number", "object", "array"}
+	}
+
+	nodes := make([]schema.TypedNode, len(types))
+	for i, t := range types {
+		nodes[i] = def.buildTyped(t)
+	}
+
+	return schema.Union(nodes...)
+}
+
+func (def *definition) buildTyped(t string) schema.TypedNode {
+	switch t {
+	case "boolean":
+		return schema.Bool()
+	case "string":
+		return stringNode{
+			StringNode:      schema.String(def.strings...),
+			minLength:       def.minLength,
+			emptyStringErrs: def.emptyStringErrs,
+		}
+	case "number":
+		return schema.Number(def.numbers...)
+	case "integer":
+		return schema.Number(def.numbers...).OnlyIntegers()
+	case "object":
+		return def.buildObject()
+	case "array":
+		array := schema.Array(def.items, def.counts...)
+		if def.uniqueItems {
+			array = array.UniqueItems()
+		}
+		return array
+	}
+
+	return schema.Null()
+}
+
+func (def *definition) buildObject() schema.ObjectNode {
+	required := make(map[string]bool, len(def.required))
+	for _, name := range def.required {
+		required[name] = true
+	}
+
+	properties := make([]schema.Property, 0, len(def.properties)+len(def.required))
+	defined := make(map[string]bool, len(def.properties))
+	for _, p := range def.properties {
+		defined[p.name] = true
+		property := schema.Prop(p.name, p.node)
+		if required[p.name] {
+			property = property.Required()
+		}
+		properties = append(properties, property)
+	}
+	for _, name := range def.required {
+		if !defined[name] {
+			defined[name] = true
+			properties = append(properties, schema.Prop(name, nil).Required())
+		}
+	}
+
+	object := schema.Object(properties...)
+	if def.denyAdditional {
+		return object.DenyAdditionalProperties()
+	}
+	if def.additional != nil {
+		return object.WithAdditionalProperties(def.additional)
+	}
+
+	return object
+}
+
+// checkCycles returns an error if the schema references itself by "$ref", "allOf", "anyOf", "oneOf"
+// or "not" without going into "properties", "items" or "additionalProperties" (e.g. {"$ref": "#"}).
+// The validation of such schema never ends, because the same value is validated by the same node again.
+func (c *compiler) checkCycles() error {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	states := make(map[string]int, len(c.references))
+
+	var visit func(pointer string) (string, bool)
+	visit = func(pointer string) (string, bool) {
+		switch states[pointer] {
+		case visiting:
+			return pointer, true
+		case visited:
+			return "", false
+		}
+		states[pointer] = visiting
+		for _, child := range c.inPlace[pointer] {
+			if cycle, found := visit(child); found {
+				return cycle, true
+			}
+		}
+		states[pointer] = visited
+		return "", false
+	}
+
+	for _, pointer := range sortedKeys(c.references) {
+		if cycle, found := visit(pointer); found {
+			return fmt.Errorf(
+				"%w: %s: schema references itself without going into properties or items",
+				ErrInvalidSchema, location(cycle),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (c *compiler) resolve(ref string) (*compiledRef, string, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, "", fmt.Errorf(`%w: "%s"`, ErrUnsupportedReference, ref)
+	}
+	pointer, err := url.PathUnescape(ref[1:])
+	if err != nil {
+		return nil, "", fmt.Errorf(`%w: reference "%s": %w`, ErrInvalidSchema, ref, err)
+	}
+	if pointer != "" && !strings.HasPrefix(pointer, "/") {
+		return nil, "", fmt.Errorf(`%w: "%s"`, ErrUnsupportedReference, ref)
+	}
+	if n, exists := c.references[pointer]; exists {
+		return n, pointer, nil
+	}
+
+	value, found := lookup(c.root, pointer)
+	if !found {
+		return nil, "", fmt.Errorf(`%w: reference "%s" cannot be resolved`, ErrInvalidSchema, ref)
+	}
+	n, err := c.compile(value, pointer)
+
+	return n, pointer, err
+}
+
+// formatConstraint returns the constraint checking the format and the error of the violation
+// for the empty string (all the supported formats do not accept empty strings).
+func formatConstraint(format string) (validation.StringConstraint, *validation.Error) {
+	switch format {
+	case "email":
+		return it.IsEmail(), validation.ErrInvalidEmail
+	case "uri":
+		return validation.OfStringBy(isAbsoluteURI).
+			WithError(validation.ErrInvalidURL).
+			WithDefaultMessage(validation.ErrInvalidURL.Message()), validation.ErrInvalidURL
+	case "uuid":
+		return it.IsUUID(), validation.ErrInvalidUUID
+	case "date-time":
+		return it.IsDateTime(), validation.ErrInvalidDateTime
+	case "date":
+		return it.IsDate(), validation.ErrInvalidDate
+	case "hostname":
+		return it.IsHostname(), validation.ErrInvalidHostname
+	case "ipv4":
+		return it.IsIPv4(), validation.ErrInvalidIP
+	case "ipv6":
+		return it.IsIPv6(), validation.ErrInvalidIP
+	}
+
+	return nil, nil
+}
+
+func isAbsoluteURI(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && u.IsAbs()
+}
+
+// lookup finds the value in the document by the JSON pointer (RFC 6901).
+func lookup(document any, pointer string) (any, bool) {
+	if pointer == "" {
+		return document, true
+	}
+
+	current := document
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch v := current.(type) {
+		case map[string]any:
+			next, exists := v[token]
+			if !exists {
+				return nil, false
+			}
+			current = next
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			current = v[i]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func escape(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+func location(pointer string) string {
+	return "#" + pointer
+}
+
+func toStrings(value any) ([]string, bool) {
+	if s, ok := value.(string); ok {
+		return []string{s}, true
+	}
+	values, ok := value.([]any)
+	if !ok {
+		return nil, false
+	}
+	strs := make([]string, len(values))
+	for i, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return nil, false
+		}
+		strs[i] = s
+	}
+
+	return strs, true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func isJSONType(t string) bool {
+	switch t {
+	case "null", "boolean", "object", "array", "number", "integer", "string":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,452 @@
+package jsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/jsonschema"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/muonsoft/validation/schema"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+const orderSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "Order",
+	"type": "object",
+	"properties": {
+		"id": {"type": "string", "format": "uuid"},
+		"email": {"type": "string", "format": "email"},
+		"status": {"enum": ["new", "paid"]},
+		"total": {"type": "number", "minimum": 0, "multipleOf": 0.01},
+		"items": {"type": "array", "items": {"$ref": "#/$defs/Item"}, "minItems": 1},
+		"createdAt": {"type": "string", "format": "date-time"}
+	},
+	"required": ["id", "status", "items"],
+	"additionalProperties": false,
+	"$defs": {
+		"Item": {
+			"type": "object",
+			"properties": {
+				"sku": {"type": "string", "pattern": "^[A-Z]{3}-\\d+$"},
+				"quantity": {"type": "integer", "minimum": 1, "maximum": 100}
+			},
+			"required": ["sku"]
+		}
+	}
+}`
+
+func TestCompile_WhenDocumentIsValid_ExpectNoViolations(t *testing.T) {
+	compiled, err := jsonschema.Compile([]byte(orderSchema))
+	require.NoError(t, err)
+	document := decode(t, `{
+		"id": "83eab6fd-230b-44fe-b52f-463387bd8788",
+		"email": "user@example.com",
+		"status": "paid",
+		"total": 10.5,
+		"items": [{"sku": "ABC-1", "quantity": 2}],
+		"createdAt": "2024-01-02T15:04:05Z"
+	}`)
+
+	err = validator.Validate(context.Background(), jsonschema.Value(document, compiled))
+
+	assert.NoError(t, err)
+}
+
+func TestCompile_WhenDocumentIsInvalid_ExpectViolationsWithPropertyPaths(t *testing.T) {
+	compiled, err := jsonschema.Compile([]byte(orderSchema))
+	require.NoError(t, err)
+	document := decode(t, `{
+		"email": "invalid",
+		"status": "archived",
+		"total": -1,
+		"items": [{"sku": "abc", "quantity": 1.5}, {"quantity": 0}],
+		"createdAt": "yesterday",
+		"comment": "unexpected"
+	}`)
+
+	err = validator.Validate(context.Background(), jsonschema.Value(document, compiled))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidDateTime, PropertyPath: "createdAt"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidEmail, PropertyPath: "email"},
+		validationtest.ViolationAttributes{Error: validation.ErrMissingProperty, PropertyPath: "id"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidType, PropertyPath: "items[0].quantity"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotValid, PropertyPath: "items[0].sku"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLowOrEqual, PropertyPath: "items[1].quantity"},
+		validationtest.ViolationAttributes{Error: validation.ErrMissingProperty, PropertyPath: "items[1].sku"},
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "status"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooLowOrEqual, PropertyPath: "total"},
+		validationtest.ViolationAttributes{Error: validation.ErrUnknownProperty, PropertyPath: "comment"},
+	)
+}
+
+func TestCompile_WhenTranslationsAreLoaded_ExpectTranslatedMessages(t *testing.T) {
+	v, err := validation.NewValidator(
+		validation.Translations(russian.Messages),
+		validation.DefaultLanguage(language.Russian),
+	)
+	require.NoError(t, err)
+	compiled := jsonschema.MustCompile([]byte(`{
+		"type": "object",
+		"properties": {"age": {"type": "integer"}},
+		"required": ["name"]
+	}`))
+
+	err = v.Validate(context.Background(), jsonschema.Value(decode(t, `{"age": "ten"}`), compiled))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			PropertyPath: "age",
+			Message:      "Значение должно быть типа integer.",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrMissingProperty,
+			PropertyPath: "name",
+			Message:      "Это поле отсутствует.",
+		},
+	)
+}
+
+func TestCompile_WhenFormatMessageOverridden_ExpectOverriddenMessage(t *testing.T) {
+	v, err := validation.NewValidator(validation.OverrideMessage(validation.ErrInvalidURL, "Overridden."))
+	require.NoError(t, err)
+	compiled := jsonschema.MustCompile([]byte(`{"format": "uri"}`))
+
+	err = v.Validate(context.Background(), jsonschema.Value(decode(t, `"/relative"`), compiled))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrInvalidURL).
+		WithMessage("Overridden.")
+}
+
+func TestCompile_WhenRecursiveReference_ExpectNestedValuesValidated(t *testing.T) {
+	compiled := jsonschema.MustCompile([]byte(`{
+		"$defs": {
+			"Category": {
+				"type": "object",
+				"properties": {
+					"title": {"type": "string", "minLength": 1},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/Category"}}
+				}
+			}
+		},
+		"$ref": "#/$defs/Category"
+	}`))
+	document := decode(t, `{"title": "root", "children": [{"title": "a", "children": [{"title": ""}]}]}`)
+
+	err := validator.Validate(context.Background(), jsonschema.Value(document, compiled))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrTooShort).
+		WithPropertyPath("children[0].children[0].title")
+}
+
+func TestCompile_Keywords(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		valid   []string
+		invalid map[string]error
+	}{
+		{
+			name:    "type list",
+			schema:  `{"type": ["string", "null"]}`,
+			valid:   []string{`"a"`, `null`},
+			invalid: map[string]error{`1`: validation.ErrInvalidType},
+		},
+		{
+			name:    "integer",
+			schema:  `{"type": "integer"}`,
+			valid:   []string{`1`, `1.0`, `-5`},
+			invalid: map[string]error{`1.5`: validation.ErrInvalidType, `"1"`: validation.ErrInvalidType},
+		},
+		{
+			name:    "keywords of other types are ignored",
+			schema:  `{"minLength": 2, "minimum": 5, "minItems": 1, "required": ["a"]}`,
+			valid:   []string{`10`, `"ab"`, `[1]`, `{"a": 1}`, `true`, `null`},
+			invalid: map[string]error{`"a"`: validation.ErrTooShort, `1`: validation.ErrTooLowOrEqual},
+		},
+		{
+			name:    "max length counts characters",
+			schema:  `{"maxLength": 2}`,
+			valid:   []string{`"äö"`},
+			invalid: map[string]error{`"abc"`: validation.ErrTooLong},
+		},
+		{
+			name:    "exclusive limits",
+			schema:  `{"exclusiveMinimum": 0, "exclusiveMaximum": 10}`,
+			valid:   []string{`5`},
+			invalid: map[string]error{`0`: validation.ErrTooLow, `10`: validation.ErrTooHigh},
+		},
+		{
+			name:    "const",
+			schema:  `{"const": {"a": [1, "b"]}}`,
+			valid:   []string{`{"a": [1.0, "b"]}`},
+			invalid: map[string]error{`{"a": [1]}`: validation.ErrNotEqual},
+		},
+		{
+			name:    "enum of mixed values",
+			schema:  `{"enum": [1, "one", null]}`,
+			valid:   []string{`1`, `"one"`, `null`},
+			invalid: map[string]error{`"1"`: validation.ErrNoSuchChoice},
+		},
+		{
+			name:   "unique items",
+			schema: `{"uniqueItems": true, "maxItems": 3}`,
+			valid:  []string{`[1, "1", {"a": 1}]`},
+			invalid: map[string]error{
+				`[{"a": 1}, {"a": 1.0}]`: validation.ErrNotUnique,
+				`[1, 2, 3, 4]`:           validation.ErrTooManyElements,
+			},
+		},
+		{
+			name:    "additional properties schema",
+			schema:  `{"properties": {"a": true}, "additionalProperties": {"type": "integer"}}`,
+			valid:   []string{`{"a": "x", "b": 1}`},
+			invalid: map[string]error{`{"b": "x"}`: validation.ErrInvalidType},
+		},
+		{
+			name:    "uri",
+			schema:  `{"format": "uri"}`,
+			valid:   []string{`"urn:isbn:0451450523"`, `"https://example.com/path"`},
+			invalid: map[string]error{`"/relative"`: validation.ErrInvalidURL},
+		},
+		{
+			name:    "ip",
+			schema:  `{"anyOf": [{"format": "ipv4"}, {"format": "ipv6"}]}`,
+			valid:   []string{`"127.0.0.1"`, `"::1"`},
+			invalid: map[string]error{`"localhost"`: validation.ErrNotValid},
+		},
+		{
+			name:   "empty string",
+			schema: `{"properties": {"a": {"minLength": 1}, "b": {"pattern": "^x"}, "c": {"format": "email"}}}`,
+			valid:  []string{`{"b": "x", "c": "user@example.com"}`},
+			invalid: map[string]error{
+				`{"a": ""}`: validation.ErrTooShort,
+				`{"b": ""}`: validation.ErrNotValid,
+				`{"c": ""}`: validation.ErrInvalidEmail,
+			},
+		},
+		{
+			name:    "empty string matching pattern",
+			schema:  `{"pattern": "^x*$"}`,
+			valid:   []string{`""`},
+			invalid: map[string]error{`"y"`: validation.ErrNotValid},
+		},
+		{
+			name:    "unknown format is annotation",
+			schema:  `{"format": "phone"}`,
+			valid:   []string{`"anything"`},
+			invalid: map[string]error{},
+		},
+		{
+			name:    "all of",
+			schema:  `{"allOf": [{"minLength": 2}, {"pattern": "^a"}]}`,
+			valid:   []string{`"ab"`},
+			invalid: map[string]error{`"b"`: validation.ErrTooShort},
+		},
+		{
+			name:    "one of",
+			schema:  `{"oneOf": [{"type": "integer"}, {"minimum": 2}]}`,
+			valid:   []string{`1`, `2.5`},
+			invalid: map[string]error{`3`: validation.ErrNotValid, `1.5`: validation.ErrNotValid},
+		},
+		{
+			name:    "not",
+			schema:  `{"not": {"type": "string"}}`,
+			valid:   []string{`1`},
+			invalid: map[string]error{`"a"`: validation.ErrNotValid},
+		},
+		{
+			name:    "false schema",
+			schema:  `{"properties": {"a": false}}`,
+			valid:   []string{`{"b": 1}`},
+			invalid: map[string]error{`{"a": 1}`: validation.ErrNotValid},
+		},
+		{
+			name:    "escaped reference",
+			schema:  `{"$defs": {"a/b~c d": {"type": "string"}}, "properties": {"p": {"$ref": "#/$defs/a~1b~0c%20d"}}}`,
+			valid:   []string{`{"p": "x"}`},
+			invalid: map[string]error{`{"p": 1}`: validation.ErrInvalidType},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			compiled, err := jsonschema.Compile([]byte(test.schema))
+			require.NoError(t, err)
+
+			for _, value := range test.valid {
+				err := validator.Validate(context.Background(), jsonschema.Value(decode(t, value), compiled))
+				assert.NoError(t, err, value)
+			}
+			for value, expected := range test.invalid {
+				err := validator.Validate(context.Background(), jsonschema.Value(decode(t, value), compiled))
+				assert.ErrorIs(t, err, expected, value)
+			}
+		})
+	}
+}
+
+func TestCompile_WhenSchemaIsNotSupported_ExpectError(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		err    error
+		text   string
+	}{
+		{
+			name:   "malformed json",
+			schema: `{`,
+			err:    jsonschema.ErrInvalidSchema,
+		},
+		{
+			name:   "invalid keyword value",
+			schema: `{"properties": {"a": {"minLength": "1"}}}`,
+			err:    jsonschema.ErrInvalidSchema,
+			text:   `invalid schema: #/properties/a: "minLength" must be a non-negative integer`,
+		},
+		{
+			name:   "invalid pattern",
+			schema: `{"pattern": "(?<=a)b"}`,
+			err:    jsonschema.ErrInvalidSchema,
+		},
+		{
+			name:   "unknown type",
+			schema: `{"type": "date"}`,
+			err:    jsonschema.ErrInvalidSchema,
+		},
+		{
+			name:   "unresolved reference",
+			schema: `{"$ref": "#/$defs/Missing"}`,
+			err:    jsonschema.ErrInvalidSchema,
+		},
+		{
+			name:   "reference to itself",
+			schema: `{"$ref": "#"}`,
+			err:    jsonschema.ErrInvalidSchema,
+			text:   `invalid schema: #: schema references itself without going into properties or items`,
+		},
+		{
+			name:   "reference cycle in definitions",
+			schema: `{"$defs": {"A": {"$ref": "#/$defs/A"}}, "$ref": "#/$defs/A"}`,
+			err:    jsonschema.ErrInvalidSchema,
+			text:   `invalid schema: #/$defs/A: schema references itself without going into properties or items`,
+		},
+		{
+			name:   "reference cycle through combinators",
+			schema: `{"$defs": {"A": {"anyOf": [{"not": {"$ref": "#/$defs/B"}}]}, "B": {"allOf": [{"$ref": "#/$defs/A"}]}}, "oneOf": [{"$ref": "#/$defs/A"}]}`,
+			err:    jsonschema.ErrInvalidSchema,
+		},
+		{
+			name:   "unsupported keyword",
+			schema: `{"items": {"patternProperties": {}}}`,
+			err:    jsonschema.ErrUnsupportedKeyword,
+			text:   `unsupported keyword: #/items: "patternProperties"`,
+		},
+		{
+			name:   "remote reference",
+			schema: `{"$ref": "https://example.com/schema.json"}`,
+			err:    jsonschema.ErrUnsupportedReference,
+		},
+		{
+			name:   "anchor reference",
+			schema: `{"$ref": "#item"}`,
+			err:    jsonschema.ErrUnsupportedReference,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := jsonschema.Compile([]byte(test.schema))
+
+			assert.ErrorIs(t, err, test.err)
+			if test.text != "" {
+				assert.EqualError(t, err, test.text)
+			}
+		})
+	}
+}
+
+func TestCompileSchema_WhenExportedSchema_ExpectSameRules(t *testing.T) {
+	product := jsonschema.Define("Product",
+		jsonschema.StringProperty("name", it.IsNotBlank(), it.HasMaxLength(10)),
+		jsonschema.StringProperty("sku", it.Matches(regexp.MustCompile(`^\d+$`))),
+		jsonschema.NumberProperty[int]("quantity", it.IsBetween(1, 5)),
+	)
+	exported, err := jsonschema.Export(product)
+	require.NoError(t, err)
+
+	compiled, err := jsonschema.CompileSchema(exported)
+
+	require.NoError(t, err)
+	err = validator.Validate(
+		context.Background(),
+		jsonschema.Value(decode(t, `{"name": "", "sku": "x", "quantity": 6}`), compiled),
+	)
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort, PropertyPath: "name"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooHighOrEqual, PropertyPath: "quantity"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotValid, PropertyPath: "sku"},
+	)
+}
+
+func TestCompiledSchema_WhenUsedAsSchemaNode_ExpectNestedValidation(t *testing.T) {
+	address := jsonschema.MustCompile([]byte(`{"type": "object", "required": ["city"]}`))
+	node := schema.Object(schema.Prop("address", address).Required())
+
+	err := validator.Validate(context.Background(), schema.Value(decode(t, `{"address": {}}`), node))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrMissingProperty).
+		WithPropertyPath("address.city")
+}
+
+func TestCompiledSchema_WhenComparedToSchemaNode_ExpectSameViolations(t *testing.T) {
+	compiled := jsonschema.MustCompile([]byte(`{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "maxLength": 3},
+			"tags": {"type": "array", "items": {"type": "integer"}, "uniqueItems": true}
+		},
+		"required": ["id", "name"],
+		"additionalProperties": false
+	}`))
+	node := schema.Object(
+		schema.Prop("name", schema.String(it.HasMaxLength(3))).Required(),
+		schema.Prop("tags", schema.Array(schema.Number().OnlyIntegers()).UniqueItems()),
+		schema.Prop("id", nil).Required(),
+	).DenyAdditionalProperties()
+	document := decode(t, `{"name": "long", "tags": [1, 1, "a"], "extra": null}`)
+
+	compiledErr := validator.Validate(context.Background(), jsonschema.Value(document, compiled))
+	nodeErr := validator.Validate(context.Background(), schema.Value(document, node))
+
+	validationtest.Assert(t, compiledErr).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrTooLong, PropertyPath: "name"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotUnique, PropertyPath: "tags"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidType, PropertyPath: "tags[2]"},
+		validationtest.ViolationAttributes{Error: validation.ErrMissingProperty, PropertyPath: "id"},
+		validationtest.ViolationAttributes{Error: validation.ErrUnknownProperty, PropertyPath: "extra"},
+	)
+	assert.Equal(t, nodeErr, compiledErr)
+}
+
+func decode(t *testing.T, data string) any {
+	t.Helper()
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	var document any
+	require.NoError(t, decoder.Decode(&document))
+
+	return document
+}
@@ -0,0 +1,182 @@
+package jsonschema
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/muonsoft/validation"
+)
+
+// Definition describes the validation rules of the object. It is exported into the "$defs"
+// section of the schema under its name, so the names of the definitions must be unique.
+type Definition struct {
+	name       string
+	properties []Property
+}
+
+// Define creates a [Definition] of the object with the given properties. The properties with the same name
+// are merged. Use [Definition.Add] to describe recursive structures.
+func Define(name string, properties ...Property) *Definition {
+	return &Definition{name: name, properties: properties}
+}
+
+// Name returns the name of the definition.
+func (d *Definition) Name() string {
+	return d.name
+}
+
+// Add appends the properties to the definition. It can be used to add a property that refers
+// to the definition itself (e.g. a category with subcategories).
+func (d *Definition) Add(properties ...Property) *Definition {
+	d.properties = append(d.properties, properties...)
+	return d
+}
+
+// Property describes the validation rules of the property of the object.
+// Use the functions like [StringProperty] or [ValidProperty] to create it.
+type Property struct {
+	name string
+	// newSchema creates the base schema of the property.
+	newSchema func() *Schema
+	// each is true if the constraints are applied to the items of the array.
+	each        bool
+	constraints []any
+	reference   *Definition
+}
+
+// StringProperty describes the string property with the constraints.
+func StringProperty(name string, constraints ...validation.StringConstraint) Property {
+	return newProperty(name, typeSchema("string"), false, constraints)
+}
+
+// NumberProperty describes the numeric property with the constraints.
+// The type of the property is "integer" for integer types and "number" for floats.
+func NumberProperty[T validation.Numeric](name string, constraints ...validation.NumberConstraint[T]) Property {
+	return newProperty(name, typeSchema(typeOf[T]()), false, constraints)
+}
+
+// BoolProperty describes the boolean property with the constraints.
+func BoolProperty(name string, constraints ...validation.BoolConstraint) Property {
+	return newProperty(name, typeSchema("boolean"), false, constraints)
+}
+
+// TimeProperty describes the date/time property with the constraints.
+// The property is described as a string in the "date-time" format.
+func TimeProperty(name string, constraints ...validation.TimeConstraint) Property {
+	return newProperty(name, func() *Schema { return &Schema{Type: "string", Format: "date-time"} }, false, constraints)
+}
+
+// CountableProperty describes the array property with the constraints of the count of its elements.
+func CountableProperty(name string, constraints ...validation.CountableConstraint) Property {
+	return newProperty(name, typeSchema("array"), false, constraints)
+}
+
+// ComparableProperty describes the property of a comparable type with the constraints.
+// The type of the property is defined by the underlying type of T.
+func ComparableProperty[T comparable](name string, constraints ...validation.ComparableConstraint[T]) Property {
+	return newProperty(name, typeSchema(typeOf[T]()), false, constraints)
+}
+
+// ComparablesProperty describes the array property of comparable values with the constraints
+// (e.g. [github.com/muonsoft/validation/it.HasUniqueValues]).
+func ComparablesProperty[T comparable](name string, constraints ...validation.ComparablesConstraint[T]) Property {
+	return newProperty(name, arraySchema(typeOf[T]()), false, constraints)
+}
+
+// EachStringProperty describes the array of strings with the constraints applied to each element.
+func EachStringProperty(name string, constraints ...validation.StringConstraint) Property {
+	return newProperty(name, arraySchema("string"), true, constraints)
+}
+
+// EachNumberProperty describes the array of numbers with the constraints applied to each element.
+func EachNumberProperty[T validation.Numeric](name string, constraints ...validation.NumberConstraint[T]) Property {
+	return newProperty(name, arraySchema(typeOf[T]()), true, constraints)
+}
+
+// EachComparableProperty describes the array of comparable values with the constraints applied to each element.
+func EachComparableProperty[T comparable](name string, constraints ...validation.ComparableConstraint[T]) Property {
+	return newProperty(name, arraySchema(typeOf[T]()), true, constraints)
+}
+
+// ValidProperty describes the property of the object defined by the definition.
+// It is exported as a reference to the definition.
+func ValidProperty(name string, definition *Definition) Property {
+	return Property{
+		name:      name,
+		newSchema: func() *Schema { return &Schema{Ref: reference(definition)} },
+		reference: definition,
+	}
+}
+
+// ValidSliceProperty describes the array of objects defined by the definition.
+func ValidSliceProperty(name string, definition *Definition) Property {
+	return Property{
+		name: name,
+		newSchema: func() *Schema {
+			return &Schema{Type: "array", Items: &Schema{Ref: reference(definition)}}
+		},
+		reference: definition,
+	}
+}
+
+// ValidMapProperty describes the map (object with arbitrary keys) of objects defined by the definition.
+func ValidMapProperty(name string, definition *Definition) Property {
+	return Property{
+		name: name,
+		newSchema: func() *Schema {
+			return &Schema{Type: "object", AdditionalProperties: &Schema{Ref: reference(definition)}}
+		},
+		reference: definition,
+	}
+}
+
+func newProperty[C any](name string, newSchema func() *Schema, each bool, constraints []C) Property {
+	property := Property{
+		name:        name,
+		newSchema:   newSchema,
+		each:        each,
+		constraints: make([]any, len(constraints)),
+	}
+	for i, constraint := range constraints {
+		property.constraints[i] = constraint
+	}
+
+	return property
+}
+
+func typeSchema(schemaType string) func() *Schema {
+	return func() *Schema {
+		return &Schema{Type: schemaType}
+	}
+}
+
+func arraySchema(itemsType string) func() *Schema {
+	return func() *Schema {
+		return &Schema{Type: "array", Items: &Schema{Type: itemsType}}
+	}
+}
+
+func reference(definition *Definition) string {
+	return "#/$defs/" + definition.name
+}
+
+func typeOf[T any]() string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t == reflect.TypeOf(time.Time{}) {
+		return "string"
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return ""
+	}
+}
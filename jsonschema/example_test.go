@@ -0,0 +1,95 @@
+package jsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/jsonschema"
+	"github.com/muonsoft/validation/validator"
+)
+
+func ExampleExport() {
+	product := jsonschema.Define("Product",
+		jsonschema.StringProperty("name", it.IsNotBlank(), it.HasMaxLength(100)),
+		jsonschema.NumberProperty[int]("quantity", it.IsBetween(1, 10)),
+	)
+
+	schema, err := jsonschema.Export(product)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(schema)
+	// Output:
+	// {
+	//   "$schema": "https://json-schema.org/draft/2020-12/schema",
+	//   "$ref": "#/$defs/Product",
+	//   "$defs": {
+	//     "Product": {
+	//       "type": "object",
+	//       "properties": {
+	//         "name": {
+	//           "type": "string",
+	//           "minLength": 1,
+	//           "maxLength": 100
+	//         },
+	//         "quantity": {
+	//           "type": "integer",
+	//           "minimum": 1,
+	//           "maximum": 10
+	//         }
+	//       },
+	//       "required": [
+	//         "name"
+	//       ]
+	//     }
+	//   }
+	// }
+}
+
+func ExampleExport_unsupportedConstraints() {
+	user := jsonschema.Define("User",
+		jsonschema.StringProperty("id", it.IsNotBlank(), it.IsULID()),
+	)
+
+	_, err := jsonschema.Export(user)
+	fmt.Println(err)
+	// Output:
+	// unsupported constraints: User.id (ulid)
+}
+
+func ExampleCompile() {
+	compiled, err := jsonschema.Compile([]byte(`{
+		"type": "object",
+		"properties": {
+			"email": {"type": "string", "format": "email"},
+			"tags": {"type": "array", "items": {"type": "string", "minLength": 2}}
+		},
+		"required": ["id", "email"]
+	}`))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	var document any
+	_ = json.Unmarshal([]byte(`{"email": "user", "tags": ["go", "a"]}`), &document)
+
+	err = validator.Validate(context.Background(), jsonschema.Value(document, compiled))
+
+	if violations, ok := validation.UnwrapViolationList(err); ok {
+		for violation := range violations.Values() {
+			fmt.Println(violation)
+		}
+	}
+	// Output:
+	// violation at "email": "This value is not a valid email address."
+	// violation at "tags[1]": "This value is too short. It should have 2 characters or more."
+	// violation at "id": "This field is missing."
+}
@@ -0,0 +1,365 @@
+package jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/muonsoft/validation"
+)
+
+// ErrDuplicateDefinition is returned by [Export] when different definitions have the same name.
+var ErrDuplicateDefinition = errors.New("duplicate definition")
+
+// UnsupportedConstraint is a constraint that cannot be expressed in JSON Schema.
+type UnsupportedConstraint struct {
+	// Path is the path to the property in form of "Definition.property". The "[]" suffix
+	// is added for constraints applied to the elements of an array.
+	Path string
+
+	// Name is the kind of the rule (see [validation.ConstraintDescription]) or the Go type
+	// of the constraint if it is not able to describe itself.
+	Name string
+}
+
+// UnsupportedConstraintsError is returned by [Export] when some constraints cannot be expressed in JSON Schema.
+type UnsupportedConstraintsError struct {
+	Constraints []UnsupportedConstraint
+}
+
+func (err *UnsupportedConstraintsError) Error() string {
+	var s strings.Builder
+	s.WriteString("unsupported constraints: ")
+	for i, constraint := range err.Constraints {
+		if i > 0 {
+			s.WriteString(", ")
+		}
+		s.WriteString(constraint.Path)
+		s.WriteString(" (")
+		s.WriteString(constraint.Name)
+		s.WriteString(")")
+	}
+
+	return s.String()
+}
+
+// Export exports the definition and all the definitions referenced by it into JSON Schema (draft 2020-12).
+// The root schema refers to the definition, and the definitions are placed into the "$defs" section.
+//
+// The constraints that are not able to describe themselves (see [validation.Describable])
+// or whose rules cannot be expressed in JSON Schema (e.g. [github.com/muonsoft/validation/it.IsULID])
+// are never dropped silently: the schema without them is returned along with the [UnsupportedConstraintsError]
+// listing them, so the caller decides whether the schema is acceptable.
+//
+// The constraints disabled by the When method are skipped.
+//
+// Be aware that most of the constraints skip blank values, while JSON Schema keywords are applied
+// to any present value. Use [github.com/muonsoft/validation/it.IsNotBlank] to make the property required.
+func Export(definition *Definition) (*Schema, error) {
+	e := &exporter{definitions: make(map[string]*Definition)}
+	root := &Schema{
+		Schema: Draft,
+		Ref:    reference(definition),
+		Defs:   make(map[string]*Schema),
+	}
+
+	queue := []*Definition{definition}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if exported, exists := e.definitions[current.name]; exists {
+			if exported != current {
+				return nil, fmt.Errorf(`%w: "%s"`, ErrDuplicateDefinition, current.name)
+			}
+			continue
+		}
+
+		e.definitions[current.name] = current
+		root.Defs[current.name] = e.exportDefinition(current)
+		for _, property := range current.properties {
+			if property.reference != nil {
+				queue = append(queue, property.reference)
+			}
+		}
+	}
+
+	if len(e.unsupported) > 0 {
+		return root, &UnsupportedConstraintsError{Constraints: e.unsupported}
+	}
+
+	return root, nil
+}
+
+type exporter struct {
+	definitions map[string]*Definition
+	unsupported []UnsupportedConstraint
+}
+
+func (e *exporter) exportDefinition(definition *Definition) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema, len(definition.properties))}
+	required := make(map[string]bool)
+
+	for _, property := range definition.properties {
+		propertySchema, exists := schema.Properties[property.name]
+		if exists {
+			merge(propertySchema, property.newSchema())
+		} else {
+			propertySchema = property.newSchema()
+			schema.Properties[property.name] = propertySchema
+		}
+
+		target := propertySchema
+		path := definition.name + "." + property.name
+		if property.each {
+			if propertySchema.Items == nil {
+				propertySchema.Items = &Schema{}
+			}
+			target = propertySchema.Items
+			path += "[]"
+		}
+
+		for _, constraint := range property.constraints {
+			isRequired := e.applyConstraint(target, path, constraint)
+			if isRequired && !property.each && !required[property.name] {
+				required[property.name] = true
+				schema.Required = append(schema.Required, property.name)
+			}
+		}
+	}
+
+	return schema
+}
+
+func (e *exporter) applyConstraint(schema *Schema, path string, constraint any) bool {
+	describable, ok := constraint.(validation.Describable)
+	if !ok {
+		e.unsupported = append(e.unsupported, UnsupportedConstraint{Path: path, Name: fmt.Sprintf("%T", constraint)})
+		return false
+	}
+	description := describable.Describe()
+	if description.Ignored {
+		return false
+	}
+	if description.Kind == "" {
+		e.unsupported = append(e.unsupported, UnsupportedConstraint{Path: path, Name: fmt.Sprintf("%T", constraint)})
+		return false
+	}
+
+	fragment, isRequired, ok := describe(schema.Type, description)
+	if !ok {
+		e.unsupported = append(e.unsupported, UnsupportedConstraint{Path: path, Name: description.Kind})
+		return false
+	}
+	merge(schema, fragment)
+
+	return isRequired
+}
+
+// describe converts the description of the constraint into the schema keywords.
+// It returns true as the second value if the constraint makes the property required.
+func describe(schemaType string, description validation.ConstraintDescription) (*Schema, bool, bool) {
+	parameters := description.Parameters
+
+	switch description.Kind {
+	case "notBlank":
+		allowNil, _ := parameters["allowNil"].(bool)
+		return notBlankSchema(schemaType), !allowNil, true
+	case "notNil":
+		return &Schema{}, true, true
+	case "blank":
+		schema := blankSchema(schemaType)
+		return schema, false, schema != nil
+	case "bool":
+		return &Schema{Const: parameters["expected"]}, false, true
+	case "choice":
+		choices, _ := parameters["choices"].([]any)
+		return &Schema{Enum: choices}, false, true
+	case "equalTo":
+		return &Schema{Const: parameters["value"]}, false, true
+	case "notEqualTo":
+		return &Schema{Not: &Schema{Const: parameters["value"]}}, false, true
+	case "lessThan":
+		return &Schema{ExclusiveMaximum: parameters["value"]}, false, true
+	case "lessThanOrEqual":
+		return &Schema{Maximum: parameters["value"]}, false, true
+	case "greaterThan":
+		return &Schema{ExclusiveMinimum: parameters["value"]}, false, true
+	case "greaterThanOrEqual":
+		return &Schema{Minimum: parameters["value"]}, false, true
+	case "divisibleBy":
+		return &Schema{MultipleOf: parameters["value"]}, false, true
+	case "range":
+		return &Schema{Minimum: parameters["min"], Maximum: parameters["max"]}, false, true
+	case "length":
+		return &Schema{MinLength: intParameter(parameters, "min"), MaxLength: intParameter(parameters, "max")}, false, true
+	case "count":
+		if _, exists := parameters["divisibleBy"]; exists {
+			return nil, false, false
+		}
+		return &Schema{MinItems: intParameter(parameters, "min"), MaxItems: intParameter(parameters, "max")}, false, true
+	case "unique":
+		return &Schema{UniqueItems: true}, false, true
+	case "regex":
+		pattern, _ := parameters["pattern"].(string)
+		if match, _ := parameters["match"].(bool); !match {
+			return &Schema{Not: &Schema{Pattern: pattern}}, false, true
+		}
+		return &Schema{Pattern: pattern}, false, true
+	case "dateTime":
+		schema := dateTimeSchema(parameters["layout"])
+		return schema, false, schema != nil
+	case "email":
+		return &Schema{Format: "email"}, false, true
+	case "hostname":
+		return &Schema{Format: "hostname"}, false, true
+	case "url":
+		schema := urlSchema(parameters)
+		return schema, false, schema != nil
+	case "uuid":
+		return &Schema{Format: "uuid"}, false, true
+	case "ip":
+		if restricted, _ := parameters["restricted"].(bool); restricted {
+			return nil, false, false
+		}
+		switch parameters["version"] {
+		case 4:
+			return &Schema{Format: "ipv4"}, false, true
+		case 6:
+			return &Schema{Format: "ipv6"}, false, true
+		}
+		return &Schema{AnyOf: []*Schema{{Format: "ipv4"}, {Format: "ipv6"}}}, false, true
+	}
+
+	return nil, false, false
+}
+
+func notBlankSchema(schemaType string) *Schema {
+	switch schemaType {
+	case "string":
+		return &Schema{MinLength: intPointer(1)}
+	case "array":
+		return &Schema{MinItems: intPointer(1)}
+	case "integer", "number":
+		return &Schema{Not: &Schema{Const: 0}}
+	case "boolean":
+		return &Schema{Const: true}
+	}
+
+	return &Schema{}
+}
+
+func blankSchema(schemaType string) *Schema {
+	switch schemaType {
+	case "string":
+		return &Schema{MaxLength: intPointer(0)}
+	case "array":
+		return &Schema{MaxItems: intPointer(0)}
+	case "integer", "number":
+		return &Schema{Const: 0}
+	case "boolean":
+		return &Schema{Const: false}
+	}
+
+	return nil
+}
+
+func dateTimeSchema(layout any) *Schema {
+	switch layout {
+	case time.RFC3339:
+		return &Schema{Format: "date-time"}
+	case "2006-01-02":
+		return &Schema{Format: "date"}
+	case "15:04:05":
+		// the "time" format requires the time zone, so the pattern is used instead
+		return &Schema{Pattern: `^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`}
+	}
+
+	return nil
+}
+
+func urlSchema(parameters map[string]any) *Schema {
+	hosts, _ := parameters["hosts"].([]string)
+	hostPattern, _ := parameters["hostPattern"].(string)
+	restricted, _ := parameters["restricted"].(bool)
+	if len(hosts) > 0 || hostPattern != "" || restricted {
+		return nil
+	}
+
+	schemas, _ := parameters["schemas"].([]string)
+	quoted := make([]string, len(schemas))
+	for i, schema := range schemas {
+		quoted[i] = regexp.QuoteMeta(schema)
+	}
+	if relative, _ := parameters["relativeSchema"].(bool); relative {
+		return &Schema{
+			Format:  "uri-reference",
+			Pattern: "^(?:(?:" + strings.Join(quoted, "|") + "):)?//",
+		}
+	}
+
+	return &Schema{Format: "uri", Pattern: "^(?:" + strings.Join(quoted, "|") + "):"}
+}
+
+func intParameter(parameters map[string]any, name string) *int {
+	value, ok := parameters[name].(int)
+	if !ok {
+		return nil
+	}
+
+	return intPointer(value)
+}
+
+func intPointer(value int) *int {
+	return &value
+}
+
+// merge copies the keywords of the fragment into the schema. The stricter one of the length and count limits
+// is kept (e.g. "minLength" of 3 instead of 1). If the schema already has a different value of some other keyword,
+// then the fragment is added into "allOf" to keep both rules.
+func merge(schema, fragment *Schema) {
+	fragment = mergeLimits(schema, fragment)
+	target := reflect.ValueOf(schema).Elem()
+	source := reflect.ValueOf(fragment).Elem()
+
+	for i := 0; i < source.NumField(); i++ {
+		if !source.Field(i).IsZero() && !target.Field(i).IsZero() &&
+			!reflect.DeepEqual(source.Field(i).Interface(), target.Field(i).Interface()) {
+			schema.AllOf = append(schema.AllOf, fragment)
+			return
+		}
+	}
+	for i := 0; i < source.NumField(); i++ {
+		if !source.Field(i).IsZero() {
+			target.Field(i).Set(source.Field(i))
+		}
+	}
+}
+
+// mergeLimits sets the stricter limits into the schema and returns the fragment without them.
+func mergeLimits(schema, fragment *Schema) *Schema {
+	rest := *fragment
+	schema.MinLength, rest.MinLength = stricterLimit(schema.MinLength, fragment.MinLength, true)
+	schema.MaxLength, rest.MaxLength = stricterLimit(schema.MaxLength, fragment.MaxLength, false)
+	schema.MinItems, rest.MinItems = stricterLimit(schema.MinItems, fragment.MinItems, true)
+	schema.MaxItems, rest.MaxItems = stricterLimit(schema.MaxItems, fragment.MaxItems, false)
+
+	return &rest
+}
+
+func stricterLimit(current, limit *int, isLowerLimit bool) (*int, *int) {
+	if limit == nil {
+		return current, nil
+	}
+	if current == nil {
+		return limit, nil
+	}
+
+	if isLowerLimit {
+		return intPointer(max(*current, *limit)), nil
+	}
+
+	return intPointer(min(*current, *limit)), nil
+}
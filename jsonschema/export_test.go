@@ -0,0 +1,270 @@
+package jsonschema_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport_WhenDefinitionWithNestedDefinitions_ExpectJSONSchema(t *testing.T) {
+	tag := jsonschema.Define("Tag",
+		jsonschema.StringProperty("name", it.IsNotBlank(), it.HasMaxLength(20)),
+	)
+	product := jsonschema.Define("Product",
+		jsonschema.StringProperty("name", it.IsNotBlank(), it.HasLengthBetween(3, 100)),
+		jsonschema.StringProperty("sku", it.Matches(regexp.MustCompile(`^[A-Z]{3}-\d+$`))),
+		jsonschema.StringProperty("status", it.IsOneOf("draft", "published")),
+		jsonschema.NumberProperty[int]("quantity", it.IsBetween(1, 100)),
+		jsonschema.NumberProperty[float64]("price", it.IsPositive[float64](), it.IsDivisibleByFloat(0.01)),
+		jsonschema.StringProperty("email", it.IsEmail()),
+		jsonschema.StringProperty("website", it.IsURL()),
+		jsonschema.StringProperty("ip", it.IsIPv4()),
+		jsonschema.TimeProperty("createdAt"),
+		jsonschema.EachStringProperty("keywords", it.IsNotBlank()),
+		jsonschema.CountableProperty("keywords", it.HasMaxCount(5)),
+		jsonschema.ValidSliceProperty("tags", tag),
+		jsonschema.ValidProperty("mainTag", tag),
+	)
+
+	schema, err := jsonschema.Export(product)
+
+	require.NoError(t, err)
+	data, err := json.MarshalIndent(schema, "", "  ")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$ref": "#/$defs/Product",
+		"$defs": {
+			"Product": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "minLength": 3, "maxLength": 100},
+					"sku": {"type": "string", "pattern": "^[A-Z]{3}-\\d+$"},
+					"status": {"type": "string", "enum": ["draft", "published"]},
+					"quantity": {"type": "integer", "minimum": 1, "maximum": 100},
+					"price": {"type": "number", "exclusiveMinimum": 0, "multipleOf": 0.01},
+					"email": {"type": "string", "format": "email"},
+					"website": {"type": "string", "format": "uri", "pattern": "^(?:http|https):"},
+					"ip": {"type": "string", "format": "ipv4"},
+					"createdAt": {"type": "string", "format": "date-time"},
+					"keywords": {"type": "array", "items": {"type": "string", "minLength": 1}, "maxItems": 5},
+					"tags": {"type": "array", "items": {"$ref": "#/$defs/Tag"}},
+					"mainTag": {"$ref": "#/$defs/Tag"}
+				},
+				"required": ["name"]
+			},
+			"Tag": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "minLength": 1, "maxLength": 20}
+				},
+				"required": ["name"]
+			}
+		}
+	}`, string(data))
+}
+
+func TestExport_WhenRecursiveDefinition_ExpectReferenceToItself(t *testing.T) {
+	category := jsonschema.Define("Category", jsonschema.StringProperty("title", it.IsNotBlank()))
+	category.Add(jsonschema.ValidMapProperty("children", category))
+
+	schema, err := jsonschema.Export(category)
+
+	require.NoError(t, err)
+	require.Len(t, schema.Defs, 1)
+	assert.Equal(t, "#/$defs/Category", schema.Defs["Category"].Properties["children"].AdditionalProperties.Ref)
+}
+
+func TestExport_WhenUnsupportedConstraints_ExpectErrorWithSchema(t *testing.T) {
+	custom := validation.OfStringBy(func(s string) bool { return s != "" })
+	user := jsonschema.Define("User",
+		jsonschema.StringProperty("id", it.IsULID()),
+		jsonschema.StringProperty("name", custom, it.HasMaxLength(50)),
+		jsonschema.TimeProperty("birthday", it.IsEarlierThan(time.Now())),
+		jsonschema.StringProperty("ip", it.IsIP().DenyPrivateIP()),
+		jsonschema.EachStringProperty("phones", validation.OfStringBy(isPhone).WithDescription("phone", nil)),
+	)
+
+	schema, err := jsonschema.Export(user)
+
+	var unsupported *jsonschema.UnsupportedConstraintsError
+	require.True(t, errors.As(err, &unsupported))
+	assert.Equal(t, []jsonschema.UnsupportedConstraint{
+		{Path: "User.id", Name: "ulid"},
+		{Path: "User.name", Name: "validation.StringFuncConstraint"},
+		{Path: "User.birthday", Name: "earlierThan"},
+		{Path: "User.ip", Name: "ip"},
+		{Path: "User.phones[]", Name: "phone"},
+	}, unsupported.Constraints)
+	assert.Equal(t,
+		"unsupported constraints: User.id (ulid), User.name (validation.StringFuncConstraint), "+
+			"User.birthday (earlierThan), User.ip (ip), User.phones[] (phone)",
+		err.Error(),
+	)
+	require.NotNil(t, schema)
+	assert.Equal(t, 50, *schema.Defs["User"].Properties["name"].MaxLength)
+}
+
+func TestExport_WhenConstraintIsNotDescribable_ExpectGoTypeInError(t *testing.T) {
+	user := jsonschema.Define("User", jsonschema.StringProperty("name", notDescribableConstraint{}))
+
+	_, err := jsonschema.Export(user)
+
+	var unsupported *jsonschema.UnsupportedConstraintsError
+	require.True(t, errors.As(err, &unsupported))
+	assert.Equal(t, []jsonschema.UnsupportedConstraint{
+		{Path: "User.name", Name: "jsonschema_test.notDescribableConstraint"},
+	}, unsupported.Constraints)
+}
+
+func TestExport_WhenDefinitionsWithSameName_ExpectError(t *testing.T) {
+	root := jsonschema.Define("Root",
+		jsonschema.ValidProperty("first", jsonschema.Define("Item")),
+		jsonschema.ValidProperty("second", jsonschema.Define("Item")),
+	)
+
+	_, err := jsonschema.Export(root)
+
+	assert.ErrorIs(t, err, jsonschema.ErrDuplicateDefinition)
+}
+
+func TestExport_Constraints(t *testing.T) {
+	tests := []struct {
+		name     string
+		property jsonschema.Property
+		want     string
+	}{
+		{
+			name:     "not blank with allowed nil",
+			property: jsonschema.StringProperty("p", it.IsNotBlank().WithAllowedNil()),
+			want:     `{"type": "string", "minLength": 1}`,
+		},
+		{
+			name:     "not blank number",
+			property: jsonschema.NumberProperty[int]("p", it.IsNotBlankNumber[int]()),
+			want:     `{"type": "integer", "not": {"const": 0}}`,
+		},
+		{
+			name:     "blank",
+			property: jsonschema.StringProperty("p", it.IsBlank()),
+			want:     `{"type": "string", "maxLength": 0}`,
+		},
+		{
+			name:     "true",
+			property: jsonschema.BoolProperty("p", it.IsTrue()),
+			want:     `{"type": "boolean", "const": true}`,
+		},
+		{
+			name:     "equal to",
+			property: jsonschema.ComparableProperty[string]("p", it.IsEqualTo("foo")),
+			want:     `{"type": "string", "const": "foo"}`,
+		},
+		{
+			name:     "not equal to",
+			property: jsonschema.ComparableProperty[int]("p", it.IsNotEqualTo(1)),
+			want:     `{"type": "integer", "not": {"const": 1}}`,
+		},
+		{
+			name:     "less than or equal",
+			property: jsonschema.NumberProperty[int]("p", it.IsLessThanOrEqual(10), it.IsGreaterThanOrEqual(2)),
+			want:     `{"type": "integer", "minimum": 2, "maximum": 10}`,
+		},
+		{
+			name:     "negative",
+			property: jsonschema.NumberProperty[int]("p", it.IsNegative[int]()),
+			want:     `{"type": "integer", "exclusiveMaximum": 0}`,
+		},
+		{
+			name:     "exact count",
+			property: jsonschema.CountableProperty("p", it.HasExactCount(2)),
+			want:     `{"type": "array", "minItems": 2, "maxItems": 2}`,
+		},
+		{
+			name:     "unique values",
+			property: jsonschema.ComparablesProperty[string]("p", it.HasUniqueValues[string]()),
+			want:     `{"type": "array", "items": {"type": "string"}, "uniqueItems": true}`,
+		},
+		{
+			name:     "does not match",
+			property: jsonschema.StringProperty("p", it.DoesNotMatch(regexp.MustCompile(`^\d+$`))),
+			want:     `{"type": "string", "not": {"pattern": "^\\d+$"}}`,
+		},
+		{
+			name: "several patterns",
+			property: jsonschema.StringProperty("p",
+				it.Matches(regexp.MustCompile(`^a`)),
+				it.Matches(regexp.MustCompile(`z$`)),
+			),
+			want: `{"type": "string", "pattern": "^a", "allOf": [{"pattern": "z$"}]}`,
+		},
+		{
+			name:     "date",
+			property: jsonschema.StringProperty("p", it.IsDate()),
+			want:     `{"type": "string", "format": "date"}`,
+		},
+		{
+			name:     "time",
+			property: jsonschema.StringProperty("p", it.IsTime()),
+			want:     `{"type": "string", "pattern": "^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$"}`,
+		},
+		{
+			name:     "hostname",
+			property: jsonschema.StringProperty("p", it.IsHostname()),
+			want:     `{"type": "string", "format": "hostname"}`,
+		},
+		{
+			name:     "relative url",
+			property: jsonschema.StringProperty("p", it.IsURL().WithRelativeSchema()),
+			want:     `{"type": "string", "format": "uri-reference", "pattern": "^(?:(?:http|https):)?//"}`,
+		},
+		{
+			name:     "uuid",
+			property: jsonschema.StringProperty("p", it.IsUUID()),
+			want:     `{"type": "string", "format": "uuid"}`,
+		},
+		{
+			name:     "any ip",
+			property: jsonschema.StringProperty("p", it.IsIP()),
+			want:     `{"type": "string", "anyOf": [{"format": "ipv4"}, {"format": "ipv6"}]}`,
+		},
+		{
+			name:     "disabled constraint",
+			property: jsonschema.StringProperty("p", it.HasMinLength(1).When(false)),
+			want:     `{"type": "string"}`,
+		},
+		{
+			name:     "each number",
+			property: jsonschema.EachNumberProperty[float64]("p", it.IsPositiveOrZero[float64]()),
+			want:     `{"type": "array", "items": {"type": "number", "minimum": 0}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema, err := jsonschema.Export(jsonschema.Define("Root", test.property))
+
+			require.NoError(t, err)
+			data, err := json.Marshal(schema.Defs["Root"].Properties["p"])
+			require.NoError(t, err)
+			assert.JSONEq(t, test.want, string(data))
+		})
+	}
+}
+
+type notDescribableConstraint struct{}
+
+func (notDescribableConstraint) ValidateString(ctx context.Context, validator *validation.Validator, value *string) error {
+	return nil
+}
+
+func isPhone(s string) bool {
+	return len(s) > 5
+}
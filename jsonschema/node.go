@@ -0,0 +1,115 @@
+package jsonschema
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/internal/dynamic"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/schema"
+)
+
+// CompiledSchema is a JSON Schema compiled by [Compile]. It is used to validate the decoded JSON values
+// (map[string]any, []any, string, float64 or [json.Number], bool and nil). It implements the
+// [validation.Constraint] interface for the type any, so it can be used with the [validation.This] argument.
+// Also, it implements the Node interface of the [github.com/muonsoft/validation/schema] package,
+// so the compiled schema can be combined with the schemas defined by Go code.
+type CompiledSchema struct {
+	root schema.Node
+}
+
+// Value argument is used to validate the decoded JSON value by the compiled schema.
+func Value(value any, schema *CompiledSchema) validation.ValidatorArgument {
+	return validation.This[any](value, schema)
+}
+
+// ValueProperty argument is an alias for [Value] that automatically adds property name to the current validation context.
+func ValueProperty(name string, value any, schema *CompiledSchema) validation.ValidatorArgument {
+	return Value(value, schema).At(validation.PropertyName(name))
+}
+
+// Validate validates the value by the schema. Violations of the nested values are created
+// at the property paths of these values.
+func (schema *CompiledSchema) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	return schema.root.Validate(ctx, validator, value)
+}
+
+type property struct {
+	name string
+	node schema.Node
+}
+
+// compiledRef is a placeholder of the compiled schema. It is created before the schema is compiled,
+// so the recursive references are resolved into the same node.
+type compiledRef struct {
+	node schema.Node
+}
+
+func (r *compiledRef) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	return r.node.Validate(ctx, validator, value)
+}
+
+// stringNode checks the "minLength", "pattern" and "format" keywords for the empty string,
+// because the string constraints of the "it" package skip empty strings.
+type stringNode struct {
+	schema.StringNode
+	minLength       int
+	emptyStringErrs []*validation.Error
+}
+
+func (n stringNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if s, ok := value.(string); !ok || s != "" {
+		return n.StringNode.Validate(ctx, validator, value)
+	}
+
+	violations := validation.NewViolationList()
+	if n.minLength > 0 {
+		violations.Append(
+			validator.BuildViolation(ctx, validation.ErrTooShort, validation.ErrTooShort.Message()).
+				WithPluralCount(n.minLength).
+				WithParameters(
+					validation.TemplateParameter{Key: "{{ value }}", Value: `""`},
+					validation.TemplateParameter{Key: "{{ length }}", Value: "0", RawValue: 0},
+					validation.TemplateParameter{Key: "{{ limit }}", Value: strconv.Itoa(n.minLength), RawValue: n.minLength},
+				).
+				WithInvalidValue("").
+				Create(),
+		)
+	}
+	for _, err := range n.emptyStringErrs {
+		violations.Append(
+			validator.BuildViolation(ctx, err, err.Message()).
+				WithParameter("{{ value }}", `""`).
+				WithInvalidValue("").
+				Create(),
+		)
+	}
+
+	return violations.AsError()
+}
+
+// enumNode checks the "enum" keyword, the values are compared by their canonical JSON representation.
+type enumNode struct {
+	values []string
+}
+
+func (n enumNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	return validator.Validate(ctx, validation.Comparable(dynamic.Canonical(value), it.IsOneOf(n.values...)))
+}
+
+// constNode checks the "const" keyword, the values are compared by their canonical JSON representation.
+type constNode struct {
+	value string
+}
+
+func (n constNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if dynamic.Canonical(value) == n.value {
+		return nil
+	}
+
+	return validator.BuildViolation(ctx, validation.ErrNotEqual, validation.ErrNotEqual.Message()).
+		WithParameter("{{ comparedValue }}", n.value).
+		WithInvalidValue(value).
+		Create()
+}
@@ -0,0 +1,46 @@
+// Package jsonschema contains an exporter of validation rules into JSON Schema (draft 2020-12)
+// and a compiler of JSON Schemas into validation logic.
+// The exporter can be used to share the validation rules of the server with the clients (e.g. to generate
+// the client-side validation of the forms) without defining the rules twice.
+//
+// The rules are defined by [Define] and the property functions similar to the arguments
+// of the validator (see [StringProperty], [NumberProperty], [ValidProperty] and others).
+// The constraints must implement the [validation.Describable] interface to be exported.
+// Constraints that cannot be expressed in JSON Schema are reported by [UnsupportedConstraintsError].
+//
+// Also, the package compiles JSON Schemas (a subset of draft 2020-12) into the nodes of the
+// [github.com/muonsoft/validation/schema] package with the constraints of the
+// [github.com/muonsoft/validation/it] package (see [Compile]).
+package jsonschema
+
+// Draft is the URI of the JSON Schema dialect used by the exporter.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema. Only the keywords used by the exporter are supported.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              any                `json:"minimum,omitempty"`
+	ExclusiveMinimum     any                `json:"exclusiveMinimum,omitempty"`
+	Maximum              any                `json:"maximum,omitempty"`
+	ExclusiveMaximum     any                `json:"exclusiveMaximum,omitempty"`
+	MultipleOf           any                `json:"multipleOf,omitempty"`
+	Const                any                `json:"const,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Not                  *Schema            `json:"not,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	UniqueItems          bool               `json:"uniqueItems,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
@@ -0,0 +1,129 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PropertyLabel option sets a human-readable label for the properties matching the pattern.
+// The label is translated by the [Translator] and injected into violation messages
+// via the "{{ label }}" template parameter. See [PropertyPathPattern] for the pattern syntax.
+// To set a label for a single argument, use the [ValidatorArgument.WithLabel] method.
+//
+//	validator, err := validation.NewValidator(
+//	    validation.PropertyLabel("**.email", "E-mail"),
+//	)
+func PropertyLabel(pattern string, label string) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		p, err := CompilePropertyPathPattern(pattern)
+		if err != nil {
+			return fmt.Errorf("set property label: %w", err)
+		}
+		options.labels = append(options.labels, propertyLabel{pattern: p, label: label})
+
+		return nil
+	}
+}
+
+// propertyLabel is a label for an exact property path or for all paths matching the pattern.
+type propertyLabel struct {
+	path    *PropertyPath
+	pattern *PropertyPathPattern
+	label   string
+}
+
+func (l propertyLabel) match(path *PropertyPath) bool {
+	if l.pattern != nil {
+		return l.pattern.Match(path)
+	}
+
+	return l.path.Equal(path)
+}
+
+// labels is a list of property labels. Labels added later take precedence.
+type labels []propertyLabel
+
+func (ls labels) find(path *PropertyPath) (string, bool) {
+	for i := len(ls) - 1; i >= 0; i-- {
+		if ls[i].match(path) {
+			return ls[i].label, true
+		}
+	}
+
+	return "", false
+}
+
+func (ls labels) with(label propertyLabel) labels {
+	extended := make(labels, len(ls), len(ls)+1)
+	copy(extended, ls)
+
+	return append(extended, label)
+}
+
+const (
+	labelParameter    = "{{ label }}"
+	propertyParameter = "{{ property }}"
+
+	// labelPrefix is the prefix of the labelled messages (e.g. [english.LabelledMessages]).
+	// It is removed from the message if the property has no label and no path.
+	labelPrefix = labelParameter + ": "
+)
+
+// labelParameters appends the "{{ label }}" and "{{ property }}" template parameters unless they
+// are already set, so they are available for every violation. If there is no label for the property,
+// the property path is used as the label (it is empty for the root value).
+func (ls labels) labelParameters(path *PropertyPath, parameters []TemplateParameter) []TemplateParameter {
+	label, hasLabel := ls.find(path)
+
+	extended := make([]TemplateParameter, len(parameters), len(parameters)+2)
+	copy(extended, parameters)
+	if !hasParameter(parameters, labelParameter) {
+		if hasLabel {
+			extended = append(extended, TemplateParameter{Key: labelParameter, Value: label, NeedsTranslation: true})
+		} else {
+			extended = append(extended, TemplateParameter{Key: labelParameter, Value: path.String()})
+		}
+	}
+	if !hasParameter(parameters, propertyParameter) {
+		extended = append(extended, TemplateParameter{Key: propertyParameter, Value: path.String()})
+	}
+
+	return extended
+}
+
+// pathParameters appends the "{{ label }}" and "{{ property }}" template parameters with the property path
+// if the message references them and they are not set.
+func pathParameters(message string, path *PropertyPath, parameters []TemplateParameter) []TemplateParameter {
+	for _, key := range []string{labelParameter, propertyParameter} {
+		if strings.Contains(message, key) && !hasParameter(parameters, key) {
+			parameters = append(parameters, TemplateParameter{Key: key, Value: path.String()})
+		}
+	}
+
+	return parameters
+}
+
+// unlabelledMessage returns the message without the label prefix if the label is empty,
+// so the violations of the root value are rendered without the leading ": " by the labelled messages.
+func unlabelledMessage(message string, parameters []TemplateParameter) string {
+	if !strings.HasPrefix(message, labelPrefix) {
+		return message
+	}
+	for _, parameter := range parameters {
+		if parameter.Key == labelParameter && parameter.Value != "" {
+			return message
+		}
+	}
+
+	return strings.TrimPrefix(message, labelPrefix)
+}
+
+func hasParameter(parameters []TemplateParameter, key string) bool {
+	for _, parameter := range parameters {
+		if parameter.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+package validation
+
+import (
+	"log/slog"
+	"strconv"
+)
+
+// LogOption is used to configure the representation of violations in structured logs.
+// Use it with the [ViolationList.LogValuer] method.
+type LogOption func(options *logOptions)
+
+type logOptions struct {
+	limit          int
+	sensitivePaths []*PropertyPathPattern
+}
+
+// LogLimit option limits the number of violations written into the log. The total count of violations
+// is always logged. Zero or negative value means that all violations will be logged.
+func LogLimit(limit int) LogOption {
+	return func(options *logOptions) {
+		options.limit = limit
+	}
+}
+
+// LogSensitivePaths option marks properties matching the patterns as sensitive. Values of template
+// parameters of violations at these properties are replaced by [RedactedValue]. The rendered message
+// may contain these values, so it is replaced by the message template rendered with the redacted parameters.
+// See [PropertyPathPattern] for the pattern syntax.
+func LogSensitivePaths(patterns ...*PropertyPathPattern) LogOption {
+	return func(options *logOptions) {
+		options.sensitivePaths = append(options.sensitivePaths, patterns...)
+	}
+}
+
+func (options *logOptions) isSensitive(path *PropertyPath) bool {
+	for _, pattern := range options.sensitivePaths {
+		if pattern.Match(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LogValue implements the [slog.LogValuer] interface. The property path is logged as a string.
+func (path *PropertyPath) LogValue() slog.Value {
+	return slog.StringValue(path.String())
+}
+
+// LogValue implements the [slog.LogValuer] interface. The list is logged as a group with
+// the count of violations and a nested group for each violation. Use the [ViolationList.LogValuer]
+// method to limit the number of logged violations or to redact sensitive values.
+func (list *ViolationList) LogValue() slog.Value {
+	return list.LogValuer().LogValue()
+}
+
+// LogValuer returns an implementation of the [slog.LogValuer] interface configured by options.
+//
+//	logger.Warn(
+//	    "validation failed",
+//	    slog.Any("violations", violations.LogValuer(
+//	        validation.LogLimit(10),
+//	        validation.LogSensitivePaths(validation.MustCompilePropertyPathPattern("**.password")),
+//	    )),
+//	)
+func (list *ViolationList) LogValuer(options ...LogOption) slog.LogValuer {
+	valuer := &violationListLogValuer{list: list}
+	for _, setOption := range options {
+		setOption(&valuer.options)
+	}
+
+	return valuer
+}
+
+// LogValue implements the [slog.LogValuer] interface. Violation is logged as a group
+// of code, error, message, template, property path and template parameters.
+func (element *ViolationListElement) LogValue() slog.Value {
+	return violationLogValue(element.violation, &logOptions{})
+}
+
+func (v *internalViolation) LogValue() slog.Value {
+	return violationLogValue(v, &logOptions{})
+}
+
+type violationListLogValuer struct {
+	list    *ViolationList
+	options logOptions
+}
+
+func (valuer *violationListLogValuer) LogValue() slog.Value {
+	count := valuer.list.Len()
+	logged := count
+	if valuer.options.limit > 0 && valuer.options.limit < count {
+		logged = valuer.options.limit
+	}
+
+	attrs := make([]slog.Attr, 0, logged+1)
+	attrs = append(attrs, slog.Int("count", count))
+	for i, violation := range valuer.list.All() {
+		if i >= logged {
+			break
+		}
+		attrs = append(attrs, slog.Attr{
+			Key:   strconv.Itoa(i),
+			Value: violationLogValue(violation, &valuer.options),
+		})
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+func violationLogValue(violation Violation, options *logOptions) slog.Value {
+	path := violation.PropertyPath()
+	template := violation.MessageTemplate()
+	message := violation.Message()
+	parameters := violation.Parameters()
+	if options.isSensitive(path) {
+		parameters = redactParameters(parameters)
+		message = renderMessage(template, parameters)
+	}
+
+	attrs := make([]slog.Attr, 0, 6)
+	if err := violation.Unwrap(); err != nil {
+		if code := errorCode(err); code != "" {
+			attrs = append(attrs, slog.String("code", code))
+		}
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	attrs = append(attrs, slog.String("message", message))
+	if template != "" {
+		attrs = append(attrs, slog.String("template", template))
+	}
+	if path != nil {
+		attrs = append(attrs, slog.String("path", path.String()))
+	}
+
+	if len(parameters) > 0 {
+		params := make([]slog.Attr, 0, len(parameters))
+		for _, parameter := range parameters {
+			params = append(params, slog.String(parameterName(parameter.Key), parameter.Value))
+		}
+		attrs = append(attrs, slog.Attr{Key: "parameters", Value: slog.GroupValue(params...)})
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// redactParameters returns a copy of the parameters with the values replaced by [RedactedValue].
+// The label and the property path are kept, because the path is logged anyway.
+func redactParameters(parameters []TemplateParameter) []TemplateParameter {
+	redacted := make([]TemplateParameter, len(parameters))
+	for i, parameter := range parameters {
+		redacted[i] = TemplateParameter{Key: parameter.Key, Value: RedactedValue}
+		if parameter.Key == labelParameter || parameter.Key == propertyParameter {
+			redacted[i].Value = parameter.Value
+		}
+	}
+
+	return redacted
+}
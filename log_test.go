@@ -0,0 +1,147 @@
+package validation_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViolationList_LogValue(t *testing.T) {
+	violations := newLoggedViolations(t)
+
+	logged := logAttribute(slog.Any("violations", violations))
+
+	assert.JSONEq(t, `{
+		"violations": {
+			"count": 2,
+			"0": {
+				"code": "validation.tooShort",
+				"error": "is too short",
+				"message": "This value is too short. It should have 5 characters or more.",
+				"template": "This value is too short. It should have {{ limit }} character(s) or more.",
+				"path": "user.password",
+				"parameters": {
+					"value": "\"abc\"",
+					"length": "3",
+					"limit": "5",
+					"label": "user.password",
+					"property": "user.password"
+				}
+			},
+			"1": {
+				"code": "validation.isBlank",
+				"error": "is blank",
+				"message": "This value should not be blank.",
+				"template": "This value should not be blank.",
+				"path": "user.name",
+				"parameters": {
+					"label": "user.name",
+					"property": "user.name"
+				}
+			}
+		}
+	}`, logged)
+}
+
+func TestViolationList_LogValuer_WhenLimitAndSensitivePaths_ExpectLimitedAndRedacted(t *testing.T) {
+	violations := newLoggedViolations(t)
+
+	logged := logAttribute(slog.Any("violations", violations.LogValuer(
+		validation.LogLimit(1),
+		validation.LogSensitivePaths(validation.MustCompilePropertyPathPattern("**.password")),
+	)))
+
+	assert.JSONEq(t, `{
+		"violations": {
+			"count": 2,
+			"0": {
+				"code": "validation.tooShort",
+				"error": "is too short",
+				"message": "This value is too short. It should have [REDACTED] character(s) or more.",
+				"template": "This value is too short. It should have {{ limit }} character(s) or more.",
+				"path": "user.password",
+				"parameters": {
+					"value": "[REDACTED]",
+					"length": "[REDACTED]",
+					"limit": "[REDACTED]",
+					"label": "user.password",
+					"property": "user.password"
+				}
+			}
+		}
+	}`, logged)
+}
+
+func TestViolationList_LogValuer_WhenSensitivePathHasComparedValue_ExpectNoSecretInLog(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.ComparableProperty[string]("password", "secret", it.IsEqualTo("hunter2")),
+	)
+	violations, ok := validation.UnwrapViolationList(err)
+	if !ok {
+		t.Fatal("expected violation list")
+	}
+
+	logged := logAttribute(slog.Any("violations", violations.LogValuer(
+		validation.LogSensitivePaths(validation.MustCompilePropertyPathPattern("password")),
+	)))
+
+	assert.NotContains(t, logged, "hunter2")
+	assert.NotContains(t, logged, "secret")
+	assert.Contains(t, logged, `"message":"This value should be equal to [REDACTED]."`)
+}
+
+func TestViolation_LogValue(t *testing.T) {
+	violation := newValidator(t).CreateViolation(context.Background(), ErrTest, "message", validation.PropertyName("name"))
+
+	logged := logAttribute(slog.Any("violation", violation))
+
+	assert.JSONEq(t, `{"violation": {
+		"error": "test",
+		"message": "message",
+		"template": "message",
+		"path": "name",
+		"parameters": {"label": "name", "property": "name"}
+	}}`, logged)
+}
+
+func TestPropertyPath_LogValue(t *testing.T) {
+	path := validation.NewPropertyPath(validation.PropertyName("items"), validation.ArrayIndex(1))
+
+	logged := logAttribute(slog.Any("path", path))
+
+	assert.JSONEq(t, `{"path": "items[1]"}`, logged)
+}
+
+func newLoggedViolations(t *testing.T) *validation.ViolationList {
+	t.Helper()
+	err := newValidator(t).AtProperty("user").Validate(
+		context.Background(),
+		validation.StringProperty("password", "abc", it.HasMinLength(5)),
+		validation.StringProperty("name", "", it.IsNotBlank()),
+	)
+	violations, ok := validation.UnwrapViolationList(err)
+	if !ok {
+		t.Fatal("expected violation list")
+	}
+	return violations
+}
+
+func logAttribute(attr slog.Attr) string {
+	buffer := &bytes.Buffer{}
+	handler := slog.NewJSONHandler(buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey || a.Key == slog.MessageKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	slog.New(handler).Info("", attr)
+	return buffer.String()
+}
@@ -0,0 +1,233 @@
+package messageformat
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Style is a style of the date and time formatting.
+type Style string
+
+const (
+	// StyleShort is a short numeric style without seconds (e.g. "1/2/06, 3:04 PM" for English).
+	StyleShort Style = "short"
+
+	// StyleMedium is a medium style with seconds (e.g. "Jan 2, 2006, 3:04:05 PM" for English).
+	StyleMedium Style = "medium"
+)
+
+type localeLayouts struct {
+	date      [2]string
+	time      [2]string
+	separator string
+}
+
+// layouts contains locale date and time patterns for the supported languages.
+// The layouts for the unknown languages are based on ISO 8601.
+var layouts = map[string]localeLayouts{
+	"": {
+		date:      [2]string{"2006-01-02", "2006-01-02"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: " ",
+	},
+	"en": {
+		date:      [2]string{"1/2/06", "Jan 2, 2006"},
+		time:      [2]string{"3:04 PM", "3:04:05 PM"},
+		separator: ", ",
+	},
+	"ru": {
+		date:      [2]string{"02.01.06", "02.01.2006"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: " ",
+	},
+	// the medium patterns with the month names are replaced by the numeric ones,
+	// because the month names are not translated by the time package
+	"de": {
+		date:      [2]string{"02.01.06", "02.01.2006"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: ", ",
+	},
+	"es": {
+		date:      [2]string{"2/1/06", "2/1/2006"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: ", ",
+	},
+	"fr": {
+		date:      [2]string{"02/01/2006", "02/01/2006"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: " ",
+	},
+	"it": {
+		date:      [2]string{"02/01/06", "02/01/2006"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: ", ",
+	},
+	"ja": {
+		date:      [2]string{"2006/01/02", "2006/01/02"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: " ",
+	},
+	"pl": {
+		date:      [2]string{"02.01.2006", "02.01.2006"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: ", ",
+	},
+	"pt": {
+		date:      [2]string{"02/01/2006", "02/01/2006"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: " ",
+	},
+	"tr": {
+		date:      [2]string{"2.01.2006", "2.01.2006"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: " ",
+	},
+	"uk": {
+		date:      [2]string{"02.01.06", "02.01.2006"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: ", ",
+	},
+	"zh": {
+		date:      [2]string{"2006/1/2", "2006年1月2日"},
+		time:      [2]string{"15:04", "15:04:05"},
+		separator: " ",
+	},
+}
+
+// durationUnits contains locale abbreviations of hours, minutes, seconds, and milliseconds
+// (CLDR short units) for the languages of the layouts.
+var durationUnits = map[string][4]string{
+	"":   {"h", "min", "s", "ms"},
+	"ru": {"ч", "мин", "с", "мс"},
+	"de": {"Std.", "Min.", "Sek.", "ms"},
+	"es": {"h", "min", "s", "ms"},
+	"fr": {"h", "min", "s", "ms"},
+	"it": {"h", "min", "s", "ms"},
+	"ja": {"時間", "分", "秒", "ミリ秒"},
+	"pl": {"godz.", "min", "s", "ms"},
+	"pt": {"h", "min", "s", "ms"},
+	"tr": {"sa.", "dk.", "sn.", "msn."},
+	"uk": {"год", "хв", "с", "мс"},
+	"zh": {"小时", "分钟", "秒", "毫秒"},
+}
+
+// FormatNumber formats integer and float values according to the language.
+// It returns false if the value is not a number.
+func FormatNumber(tag language.Tag, value any) (string, bool) {
+	printer := message.NewPrinter(tag)
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return printer.Sprint(number.Decimal(v.Int())), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return printer.Sprint(number.Decimal(v.Uint())), true
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		s := strconv.FormatFloat(f, 'f', -1, v.Type().Bits())
+		digits := 0
+		if i := strings.IndexByte(s, '.'); i >= 0 {
+			digits = len(s) - i - 1
+		}
+		return printer.Sprint(number.Decimal(f, number.MaxFractionDigits(digits))), true
+	}
+
+	return "", false
+}
+
+// FormatDuration formats the duration as a sequence of hours, minutes, seconds, and milliseconds
+// according to the language (e.g. "1 h 30 min" for English).
+func FormatDuration(tag language.Tag, d time.Duration) string {
+	units, exists := durationUnits[baseLanguage(tag)]
+	if !exists {
+		units = durationUnits[""]
+	}
+	printer := message.NewPrinter(tag)
+
+	var s strings.Builder
+	if d < 0 {
+		s.WriteString("-")
+		d = -d
+	}
+	parts := [4]time.Duration{
+		d / time.Hour,
+		d % time.Hour / time.Minute,
+		d % time.Minute / time.Second,
+		d % time.Second / time.Millisecond,
+	}
+	isEmpty := true
+	for i, part := range parts {
+		if part == 0 {
+			continue
+		}
+		if !isEmpty {
+			s.WriteString(" ")
+		}
+		s.WriteString(printer.Sprint(number.Decimal(int64(part))))
+		s.WriteString(" ")
+		s.WriteString(units[i])
+		isEmpty = false
+	}
+	if isEmpty && d == 0 {
+		s.WriteString("0 ")
+		s.WriteString(units[2])
+	} else if isEmpty {
+		s.WriteString(d.String())
+	}
+
+	return s.String()
+}
+
+// FormatDate formats the date part of the time by the locale pattern.
+func FormatDate(tag language.Tag, t time.Time, style Style) string {
+	l := localeLayoutsOf(tag)
+
+	return t.Format(l.date[styleIndex(style)])
+}
+
+// FormatTime formats the time part of the time by the locale pattern.
+func FormatTime(tag language.Tag, t time.Time, style Style) string {
+	l := localeLayoutsOf(tag)
+
+	return t.Format(l.time[styleIndex(style)])
+}
+
+// FormatDateTime formats the date and time by the locale pattern.
+func FormatDateTime(tag language.Tag, t time.Time, style Style) string {
+	l := localeLayoutsOf(tag)
+	i := styleIndex(style)
+
+	return t.Format(l.date[i] + l.separator + l.time[i])
+}
+
+func localeLayoutsOf(tag language.Tag) localeLayouts {
+	l, exists := layouts[baseLanguage(tag)]
+	if !exists {
+		return layouts[""]
+	}
+
+	return l
+}
+
+func styleIndex(style Style) int {
+	if style == StyleShort {
+		return 0
+	}
+
+	return 1
+}
+
+func baseLanguage(tag language.Tag) string {
+	base, confidence := tag.Base()
+	if confidence == language.No {
+		return ""
+	}
+
+	return base.String()
+}
@@ -0,0 +1,423 @@
+// Package messageformat implements a subset of the ICU MessageFormat syntax that can be used
+// for violation message templates. It supports:
+//
+//   - simple arguments: "{name}";
+//   - formatted arguments: "{count, number}", "{count, number, integer}", "{ratio, number, percent}",
+//     "{deadline, date}", "{deadline, date, short}", "{deadline, time}", "{timeout, duration}";
+//   - plural arguments with exact matches, offset and "#" placeholder:
+//     "{count, plural, =0 {no items} one {# item} other {# items}}";
+//   - ordinal arguments: "{place, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}";
+//   - select arguments: "{gender, select, female {she} male {he} other {they}}".
+//
+// Apostrophes are used for quoting special characters: "'{'" is rendered as "{",
+// and a doubled apostrophe is rendered as a single one.
+// A single apostrophe that is not followed by a special character is rendered as is.
+//
+// Placeholders in double curly braces (e.g. "{{ value }}") are not parsed and kept as is,
+// so the templates of the validation package can be mixed with MessageFormat arguments.
+package messageformat
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Message is a compiled message template.
+type Message struct {
+	pattern string
+	parts   []part
+}
+
+// Compile parses a message template and, if successful, returns a [Message]
+// that can be used to format messages with arguments.
+func Compile(pattern string) (*Message, error) {
+	p := parser{pattern: pattern}
+	parts, err := p.parseMessage(0, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{pattern: pattern, parts: parts}, nil
+}
+
+// MustCompile is like [Compile] but panics if the template cannot be parsed.
+func MustCompile(pattern string) *Message {
+	m, err := Compile(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("compile message %q: %s", pattern, err))
+	}
+
+	return m
+}
+
+// String returns the source template used to compile the message.
+func (m *Message) String() string {
+	return m.pattern
+}
+
+// Format renders the message for the language using the arguments. Argument values may be
+// numbers, strings, [time.Time], [time.Duration], or any other values formatted by [fmt.Sprint].
+// Arguments missing in the map are rendered as is, e.g. "{name}".
+func (m *Message) Format(tag language.Tag, arguments map[string]any) string {
+	f := formatter{tag: tag, arguments: arguments}
+	f.formatParts(m.parts)
+
+	return f.s.String()
+}
+
+// DefaultCacheSize is the maximum number of messages in the [Cache] if its size is not set.
+const DefaultCacheSize = 1000
+
+// Cache is a concurrency safe cache of compiled messages. The zero value is ready to use.
+// The number of cached messages is limited, so the templates built at runtime (e.g. containing
+// the validated values) do not grow the cache without bound.
+type Cache struct {
+	// Size is the maximum number of cached messages. If it is zero, the [DefaultCacheSize] is used.
+	Size int
+
+	messages sync.Map
+	count    atomic.Int64
+}
+
+// Compile returns the compiled message from the cache or compiles the template. Only successfully
+// compiled messages are cached. When the cache is full, the new templates are compiled on every call
+// and the cached ones are kept.
+func (c *Cache) Compile(pattern string) (*Message, error) {
+	if cached, ok := c.messages.Load(pattern); ok {
+		return cached.(*Message), nil
+	}
+
+	m, err := Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if c.count.Add(1) > int64(c.size()) {
+		c.count.Add(-1)
+		return m, nil
+	}
+	if _, loaded := c.messages.LoadOrStore(pattern, m); loaded {
+		c.count.Add(-1)
+	}
+
+	return m, nil
+}
+
+func (c *Cache) size() int {
+	if c.Size > 0 {
+		return c.Size
+	}
+
+	return DefaultCacheSize
+}
+
+type part interface {
+	format(f *formatter)
+}
+
+type textPart string
+
+func (p textPart) format(f *formatter) {
+	f.s.WriteString(string(p))
+}
+
+type argumentKind byte
+
+const (
+	simpleArgument argumentKind = iota
+	numberArgument
+	dateArgument
+	timeArgument
+	durationArgument
+)
+
+type argumentPart struct {
+	name  string
+	kind  argumentKind
+	style string
+}
+
+func (p argumentPart) format(f *formatter) {
+	value, exists := f.arguments[p.name]
+	if !exists {
+		f.s.WriteString("{" + p.name + "}")
+		return
+	}
+
+	switch p.kind {
+	case numberArgument:
+		f.s.WriteString(formatNumberStyle(f.tag, value, p.style))
+	case dateArgument, timeArgument:
+		if t, ok := value.(time.Time); ok {
+			f.s.WriteString(formatTimeStyle(f.tag, t, p.kind, p.style))
+			return
+		}
+		f.s.WriteString(formatValue(f.tag, value))
+	case durationArgument:
+		if d, ok := value.(time.Duration); ok {
+			f.s.WriteString(FormatDuration(f.tag, d))
+			return
+		}
+		f.s.WriteString(formatValue(f.tag, value))
+	default:
+		f.s.WriteString(formatValue(f.tag, value))
+	}
+}
+
+type pluralCase struct {
+	selector string
+	exact    float64
+	isExact  bool
+	message  []part
+}
+
+type pluralPart struct {
+	name      string
+	isOrdinal bool
+	offset    float64
+	cases     []pluralCase
+}
+
+func (p pluralPart) format(f *formatter) {
+	value, exists := f.arguments[p.name]
+	if !exists {
+		f.s.WriteString("{" + p.name + "}")
+		return
+	}
+	n, ok := newNumber(value)
+	if !ok {
+		f.formatParts(p.find("other"))
+		return
+	}
+	for _, c := range p.cases {
+		if c.isExact && c.exact == n.f {
+			f.formatPluralParts(c.message, n)
+			return
+		}
+	}
+
+	n = n.subtract(p.offset)
+	f.formatPluralParts(p.find(n.pluralForm(f.tag, p.isOrdinal)), n)
+}
+
+func (p pluralPart) find(selector string) []part {
+	var other []part
+	for _, c := range p.cases {
+		if c.isExact {
+			continue
+		}
+		if c.selector == selector {
+			return c.message
+		}
+		if c.selector == "other" {
+			other = c.message
+		}
+	}
+
+	return other
+}
+
+type selectPart struct {
+	name  string
+	cases map[string][]part
+}
+
+func (p selectPart) format(f *formatter) {
+	value, exists := f.arguments[p.name]
+	if !exists {
+		f.s.WriteString("{" + p.name + "}")
+		return
+	}
+	if message, ok := p.cases[fmt.Sprint(value)]; ok {
+		f.formatParts(message)
+		return
+	}
+
+	f.formatParts(p.cases["other"])
+}
+
+type hashPart struct{}
+
+func (p hashPart) format(f *formatter) {
+	if len(f.numbers) == 0 {
+		f.s.WriteString("#")
+		return
+	}
+	f.s.WriteString(f.numbers[len(f.numbers)-1].format(f.tag))
+}
+
+type formatter struct {
+	tag       language.Tag
+	arguments map[string]any
+	numbers   []pluralNumber
+	s         strings.Builder
+}
+
+func (f *formatter) formatParts(parts []part) {
+	for _, p := range parts {
+		p.format(f)
+	}
+}
+
+func (f *formatter) formatPluralParts(parts []part, n pluralNumber) {
+	f.numbers = append(f.numbers, n)
+	f.formatParts(parts)
+	f.numbers = f.numbers[:len(f.numbers)-1]
+}
+
+// pluralNumber is a number used to select the plural form. The decimal string representation
+// is used to calculate plural operands, the original value is used for formatting.
+type pluralNumber struct {
+	value   any
+	f       float64
+	decimal string
+}
+
+func newNumber(value any) (pluralNumber, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return pluralNumber{value: value, f: float64(v.Int()), decimal: strconv.FormatInt(v.Int(), 10)}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return pluralNumber{value: value, f: float64(v.Uint()), decimal: strconv.FormatUint(v.Uint(), 10)}, true
+	case reflect.Float32, reflect.Float64:
+		return pluralNumber{
+			value:   value,
+			f:       v.Float(),
+			decimal: strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()),
+		}, true
+	case reflect.String:
+		s := strings.TrimSpace(v.String())
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return pluralNumber{}, false
+		}
+		return pluralNumber{value: f, f: f, decimal: strings.TrimPrefix(s, "+")}, true
+	}
+
+	return pluralNumber{}, false
+}
+
+func (n pluralNumber) subtract(offset float64) pluralNumber {
+	if offset == 0 {
+		return n
+	}
+	f := n.f - offset
+
+	return pluralNumber{value: f, f: f, decimal: strconv.FormatFloat(f, 'f', -1, 64)}
+}
+
+func (n pluralNumber) format(tag language.Tag) string {
+	if s, ok := FormatNumber(tag, n.value); ok {
+		return s
+	}
+
+	return n.decimal
+}
+
+func (n pluralNumber) pluralForm(tag language.Tag, isOrdinal bool) string {
+	i, v, w, f, t := n.operands()
+	rules := plural.Cardinal
+	if isOrdinal {
+		rules = plural.Ordinal
+	}
+
+	switch rules.MatchPlural(tag, i, v, w, f, t) {
+	case plural.Zero:
+		return "zero"
+	case plural.One:
+		return "one"
+	case plural.Two:
+		return "two"
+	case plural.Few:
+		return "few"
+	case plural.Many:
+		return "many"
+	}
+
+	return "other"
+}
+
+// operands returns the plural operands as defined by the Unicode CLDR:
+// integer digits, number of visible fraction digits with and without trailing zeros,
+// visible fraction digits with and without trailing zeros.
+func (n pluralNumber) operands() (i, v, w, f, t int) {
+	integer, fraction, _ := strings.Cut(strings.TrimPrefix(n.decimal, "-"), ".")
+	i = parseDigits(integer)
+	v = len(fraction)
+	f = parseDigits(fraction)
+	trimmed := strings.TrimRight(fraction, "0")
+	w = len(trimmed)
+	t = parseDigits(trimmed)
+
+	return i, v, w, f, t
+}
+
+// parseDigits parses only the last digits fitting into int because plural rules
+// use only the remainders of the division.
+func parseDigits(s string) int {
+	const maxDigits = 18
+	if len(s) > maxDigits {
+		s = s[len(s)-maxDigits:]
+	}
+	d, _ := strconv.Atoi(s)
+
+	return d
+}
+
+func formatValue(tag language.Tag, value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case time.Time:
+		return FormatDateTime(tag, v, StyleMedium)
+	case time.Duration:
+		return FormatDuration(tag, v)
+	case fmt.Stringer:
+		return v.String()
+	}
+	if s, ok := FormatNumber(tag, value); ok {
+		return s
+	}
+
+	return fmt.Sprint(value)
+}
+
+func formatNumberStyle(tag language.Tag, value any, style string) string {
+	n, ok := newNumber(value)
+	if !ok {
+		return formatValue(tag, value)
+	}
+
+	printer := message.NewPrinter(tag)
+	switch style {
+	case "integer":
+		return printer.Sprint(number.Decimal(n.f, number.MaxFractionDigits(0)))
+	case "percent":
+		return printer.Sprint(number.Percent(n.f))
+	}
+
+	return n.format(tag)
+}
+
+func formatTimeStyle(tag language.Tag, t time.Time, kind argumentKind, style string) string {
+	s := StyleMedium
+	if style == string(StyleShort) {
+		s = StyleShort
+	}
+	if kind == timeArgument {
+		return FormatTime(tag, t, s)
+	}
+
+	return FormatDate(tag, t, s)
+}
@@ -0,0 +1,298 @@
+package messageformat_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation/message/messageformat"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestMessage_Format(t *testing.T) {
+	deadline := time.Date(2023, time.March, 5, 14, 30, 15, 0, time.UTC)
+	tests := []struct {
+		name      string
+		pattern   string
+		language  language.Tag
+		arguments map[string]any
+		expected  string
+	}{
+		{
+			name:     "plain text",
+			pattern:  "This value is not valid.",
+			expected: "This value is not valid.",
+		},
+		{
+			name:      "simple argument",
+			pattern:   "Hello, {name}!",
+			arguments: map[string]any{"name": "John"},
+			expected:  "Hello, John!",
+		},
+		{
+			name:     "missing argument",
+			pattern:  "Hello, {name}!",
+			expected: "Hello, {name}!",
+		},
+		{
+			name:      "number argument",
+			pattern:   "{count, number} of {max, number}",
+			language:  language.English,
+			arguments: map[string]any{"count": 1500, "max": 2000.5},
+			expected:  "1,500 of 2,000.5",
+		},
+		{
+			name:      "integer number argument",
+			pattern:   "{count, number, integer}",
+			language:  language.English,
+			arguments: map[string]any{"count": 1500.75},
+			expected:  "1,501",
+		},
+		{
+			name:      "percent number argument",
+			pattern:   "{ratio, number, percent}",
+			language:  language.English,
+			arguments: map[string]any{"ratio": 0.25},
+			expected:  "25%",
+		},
+		{
+			name:      "date and time arguments",
+			pattern:   "{deadline, date} {deadline, time, short}",
+			language:  language.English,
+			arguments: map[string]any{"deadline": deadline},
+			expected:  "Mar 5, 2023 2:30 PM",
+		},
+		{
+			name:      "short russian date",
+			pattern:   "{deadline, date, short}",
+			language:  language.Russian,
+			arguments: map[string]any{"deadline": deadline},
+			expected:  "05.03.23",
+		},
+		{
+			name:      "duration argument",
+			pattern:   "{timeout, duration}",
+			language:  language.English,
+			arguments: map[string]any{"timeout": 90 * time.Minute},
+			expected:  "1 h 30 min",
+		},
+		{
+			name:      "german duration argument",
+			pattern:   "{timeout, duration}",
+			language:  language.German,
+			arguments: map[string]any{"timeout": 90*time.Minute + 5*time.Second},
+			expected:  "1 Std. 30 Min. 5 Sek.",
+		},
+		{
+			name:      "ukrainian duration argument",
+			pattern:   "{timeout, duration}",
+			language:  language.Ukrainian,
+			arguments: map[string]any{"timeout": 90 * time.Minute},
+			expected:  "1 год 30 хв",
+		},
+		{
+			name:      "japanese duration argument",
+			pattern:   "{timeout, duration}",
+			language:  language.Japanese,
+			arguments: map[string]any{"timeout": 1500 * time.Millisecond},
+			expected:  "1 秒 500 ミリ秒",
+		},
+		{
+			name:      "independent plural arguments",
+			pattern:   "You selected {count, plural, one {# item} other {# items}} but at most {max, plural, one {# is} other {# are}} allowed.",
+			language:  language.English,
+			arguments: map[string]any{"count": 3, "max": 1},
+			expected:  "You selected 3 items but at most 1 is allowed.",
+		},
+		{
+			name:      "russian plural",
+			pattern:   "{count, plural, one {# элемент} few {# элемента} many {# элементов} other {# элемента}}",
+			language:  language.Russian,
+			arguments: map[string]any{"count": 22},
+			expected:  "22 элемента",
+		},
+		{
+			name:      "russian plural many",
+			pattern:   "{count, plural, one {# элемент} few {# элемента} many {# элементов} other {# элемента}}",
+			language:  language.Russian,
+			arguments: map[string]any{"count": 11},
+			expected:  "11 элементов",
+		},
+		{
+			name:      "plural with fraction",
+			pattern:   "{count, plural, one {# item} other {# items}}",
+			language:  language.English,
+			arguments: map[string]any{"count": 1.5},
+			expected:  "1.5 items",
+		},
+		{
+			name:      "exact plural match",
+			pattern:   "{count, plural, =0 {no items} one {# item} other {# items}}",
+			language:  language.English,
+			arguments: map[string]any{"count": 0},
+			expected:  "no items",
+		},
+		{
+			name:      "plural with offset",
+			pattern:   "{count, plural, offset:1 =0 {nobody} =1 {you} one {you and # other} other {you and # others}}",
+			language:  language.English,
+			arguments: map[string]any{"count": 3},
+			expected:  "you and 2 others",
+		},
+		{
+			name:      "plural by string number",
+			pattern:   "{count, plural, one {# item} other {# items}}",
+			language:  language.English,
+			arguments: map[string]any{"count": "1"},
+			expected:  "1 item",
+		},
+		{
+			name:      "selectordinal",
+			pattern:   "{place, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}",
+			language:  language.English,
+			arguments: map[string]any{"place": 23},
+			expected:  "23rd",
+		},
+		{
+			name:      "select",
+			pattern:   "{gender, select, female {She} male {He} other {They}} left.",
+			arguments: map[string]any{"gender": "female"},
+			expected:  "She left.",
+		},
+		{
+			name:      "select other",
+			pattern:   "{gender, select, female {She} male {He} other {They}} left.",
+			arguments: map[string]any{"gender": "unknown"},
+			expected:  "They left.",
+		},
+		{
+			name:      "nested select and plural",
+			pattern:   "{gender, select, female {{count, plural, one {She has # item} other {She has # items}}} other {{count, plural, one {They have # item} other {They have # items}}}}",
+			language:  language.English,
+			arguments: map[string]any{"gender": "female", "count": 2},
+			expected:  "She has 2 items",
+		},
+		{
+			name:     "quoting",
+			pattern:  "Use '{name}' or '#' and it''s fine, it's ok",
+			expected: "Use {name} or '#' and it's fine, it's ok",
+		},
+		{
+			name:      "quoted hash in plural",
+			pattern:   "{count, plural, other {'#'#}}",
+			language:  language.English,
+			arguments: map[string]any{"count": 5},
+			expected:  "#5",
+		},
+		{
+			name:      "double curly braces placeholders",
+			pattern:   "{count, plural, one {# item} other {# items}}, limit is {{ limit }}.",
+			language:  language.English,
+			arguments: map[string]any{"count": 2},
+			expected:  "2 items, limit is {{ limit }}.",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, err := messageformat.Compile(test.pattern)
+			require.NoError(t, err)
+
+			message := m.Format(test.language, test.arguments)
+
+			assert.Equal(t, test.expected, message)
+		})
+	}
+}
+
+func TestCompile_WhenInvalidPattern_ExpectError(t *testing.T) {
+	tests := []struct {
+		pattern       string
+		expectedError string
+	}{
+		{
+			pattern:       "{}",
+			expectedError: `parsing message "{}" at char #1: empty argument name`,
+		},
+		{
+			pattern:       "{count",
+			expectedError: `parsing message "{count" at char #6: expected comma or closing brace`,
+		},
+		{
+			pattern:       "value}",
+			expectedError: `parsing message "value}" at char #5: unexpected closing brace`,
+		},
+		{
+			pattern:       "{count, unknown}",
+			expectedError: `parsing message "{count, unknown}" at char #15: unknown argument type "unknown"`,
+		},
+		{
+			pattern:       "{count, plural, one {# item}}",
+			expectedError: `parsing message "{count, plural, one {# item}}" at char #29: missing "other" plural case`,
+		},
+		{
+			pattern:       "{count, plural, single {# item} other {# items}}",
+			expectedError: `parsing message "{count, plural, single {# item} other {# items}}" at char #22: invalid plural selector single`,
+		},
+		{
+			pattern:       "{gender, select, male {he}}",
+			expectedError: `parsing message "{gender, select, male {he}}" at char #27: missing "other" select case`,
+		},
+		{
+			pattern:       "{count, plural, other {# items}",
+			expectedError: `parsing message "{count, plural, other {# items}" at char #31: expected plural selector`,
+		},
+		{
+			pattern:       "{{ value",
+			expectedError: `parsing message "{{ value" at char #0: unclosed placeholder`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			m, err := messageformat.Compile(test.pattern)
+
+			assert.Nil(t, m)
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
+
+func TestMustCompile_WhenInvalidPattern_ExpectPanic(t *testing.T) {
+	assert.Panics(t, func() {
+		messageformat.MustCompile("{")
+	})
+}
+
+func TestCache_Compile(t *testing.T) {
+	var cache messageformat.Cache
+
+	first, err := cache.Compile("{count, plural, one {# item} other {# items}}")
+	require.NoError(t, err)
+	second, err := cache.Compile("{count, plural, one {# item} other {# items}}")
+	require.NoError(t, err)
+
+	assert.Same(t, first, second)
+	_, err = cache.Compile("{")
+	assert.Error(t, err)
+	_, err = cache.Compile("{")
+	assert.Error(t, err)
+}
+
+func TestCache_Compile_WhenCacheIsFull_ExpectNewMessagesNotCached(t *testing.T) {
+	cache := messageformat.Cache{Size: 1}
+
+	first, err := cache.Compile("{count, number}")
+	require.NoError(t, err)
+	_, err = cache.Compile("{")
+	require.Error(t, err)
+	second, err := cache.Compile("{limit, number}")
+	require.NoError(t, err)
+
+	cachedFirst, err := cache.Compile("{count, number}")
+	require.NoError(t, err)
+	assert.Same(t, first, cachedFirst)
+	notCachedSecond, err := cache.Compile("{limit, number}")
+	require.NoError(t, err)
+	assert.NotSame(t, second, notCachedSecond)
+	assert.Equal(t, second.String(), notCachedSecond.String())
+}
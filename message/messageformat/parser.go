@@ -0,0 +1,325 @@
+package messageformat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type parser struct {
+	pattern  string
+	position int
+}
+
+// parseMessage parses the message text until the end of the pattern or until the closing brace
+// of the nested message. If inPlural is true, then "#" is parsed as the number placeholder.
+func (p *parser) parseMessage(depth int, inPlural bool) ([]part, error) {
+	var parts []part
+	var text strings.Builder
+	flushText := func() {
+		if text.Len() > 0 {
+			parts = append(parts, textPart(text.String()))
+			text.Reset()
+		}
+	}
+
+	for p.position < len(p.pattern) {
+		c := p.pattern[p.position]
+		switch {
+		case c == '\'':
+			p.parseQuoted(&text, inPlural)
+		case c == '{' && strings.HasPrefix(p.pattern[p.position:], "{{"):
+			end := strings.Index(p.pattern[p.position+2:], "}}")
+			if end < 0 {
+				return nil, p.newError("unclosed placeholder")
+			}
+			text.WriteString(p.pattern[p.position : p.position+end+4])
+			p.position += end + 4
+		case c == '{':
+			flushText()
+			argument, err := p.parseArgument(depth)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, argument)
+		case c == '}':
+			if depth == 0 {
+				return nil, p.newError("unexpected closing brace")
+			}
+			flushText()
+			return parts, nil
+		case c == '#' && inPlural:
+			flushText()
+			parts = append(parts, hashPart{})
+			p.position++
+		default:
+			text.WriteByte(c)
+			p.position++
+		}
+	}
+	if depth > 0 {
+		return nil, p.newError("unclosed nested message")
+	}
+	flushText()
+
+	return parts, nil
+}
+
+// parseQuoted parses apostrophe quoting: a doubled apostrophe is a single apostrophe and an apostrophe
+// followed by the special character starts the quoted literal text until the next single apostrophe.
+func (p *parser) parseQuoted(text *strings.Builder, inPlural bool) {
+	p.position++
+	if p.position >= len(p.pattern) {
+		text.WriteByte('\'')
+		return
+	}
+	c := p.pattern[p.position]
+	if c == '\'' {
+		text.WriteByte('\'')
+		p.position++
+		return
+	}
+	if c != '{' && c != '}' && !(c == '#' && inPlural) {
+		text.WriteByte('\'')
+		return
+	}
+
+	for p.position < len(p.pattern) {
+		c = p.pattern[p.position]
+		p.position++
+		if c != '\'' {
+			text.WriteByte(c)
+			continue
+		}
+		if p.position < len(p.pattern) && p.pattern[p.position] == '\'' {
+			text.WriteByte('\'')
+			p.position++
+			continue
+		}
+		return
+	}
+}
+
+func (p *parser) parseArgument(depth int) (part, error) {
+	p.position++
+	p.skipSpaces()
+	name := p.parseIdentifier()
+	if name == "" {
+		return nil, p.newError("empty argument name")
+	}
+	p.skipSpaces()
+	if p.consume('}') {
+		return argumentPart{name: name}, nil
+	}
+	if !p.consume(',') {
+		return nil, p.newError("expected comma or closing brace")
+	}
+	p.skipSpaces()
+	kind := p.parseIdentifier()
+	p.skipSpaces()
+
+	switch kind {
+	case "number", "date", "time", "duration":
+		return p.parseFormattedArgument(name, kind)
+	case "plural", "selectordinal":
+		if !p.consume(',') {
+			return nil, p.newError("expected comma")
+		}
+		return p.parsePlural(name, kind == "selectordinal", depth)
+	case "select":
+		if !p.consume(',') {
+			return nil, p.newError("expected comma")
+		}
+		return p.parseSelect(name, depth)
+	}
+
+	return nil, p.newError(fmt.Sprintf("unknown argument type %q", kind))
+}
+
+func (p *parser) parseFormattedArgument(name, kind string) (part, error) {
+	argument := argumentPart{name: name}
+	switch kind {
+	case "number":
+		argument.kind = numberArgument
+	case "date":
+		argument.kind = dateArgument
+	case "time":
+		argument.kind = timeArgument
+	case "duration":
+		argument.kind = durationArgument
+	}
+
+	if p.consume(',') {
+		end := strings.IndexByte(p.pattern[p.position:], '}')
+		if end < 0 {
+			return nil, p.newError("unclosed argument")
+		}
+		argument.style = strings.TrimSpace(p.pattern[p.position : p.position+end])
+		p.position += end
+	}
+	if !p.consume('}') {
+		return nil, p.newError("expected closing brace")
+	}
+
+	return argument, nil
+}
+
+func (p *parser) parsePlural(name string, isOrdinal bool, depth int) (part, error) {
+	plural := pluralPart{name: name, isOrdinal: isOrdinal}
+	p.skipSpaces()
+	if strings.HasPrefix(p.pattern[p.position:], "offset:") {
+		p.position += len("offset:")
+		p.skipSpaces()
+		offset, err := strconv.ParseFloat(p.parseSelector(), 64)
+		if err != nil {
+			return nil, p.newError("invalid offset")
+		}
+		plural.offset = offset
+	}
+
+	hasOther := false
+	for {
+		p.skipSpaces()
+		if p.consume('}') {
+			break
+		}
+		selector := p.parseSelector()
+		if selector == "" {
+			return nil, p.newError("expected plural selector")
+		}
+		c := pluralCase{selector: selector}
+		if strings.HasPrefix(selector, "=") {
+			exact, err := strconv.ParseFloat(selector[1:], 64)
+			if err != nil {
+				return nil, p.newError("invalid exact plural selector " + selector)
+			}
+			c.exact = exact
+			c.isExact = true
+		} else if !isPluralKeyword(selector) {
+			return nil, p.newError("invalid plural selector " + selector)
+		}
+		message, err := p.parseNestedMessage(depth, true)
+		if err != nil {
+			return nil, err
+		}
+		c.message = message
+		plural.cases = append(plural.cases, c)
+		hasOther = hasOther || selector == "other"
+	}
+	if !hasOther {
+		return nil, p.newError(`missing "other" plural case`)
+	}
+
+	return plural, nil
+}
+
+func (p *parser) parseSelect(name string, depth int) (part, error) {
+	selection := selectPart{name: name, cases: map[string][]part{}}
+	for {
+		p.skipSpaces()
+		if p.consume('}') {
+			break
+		}
+		selector := p.parseSelector()
+		if selector == "" {
+			return nil, p.newError("expected select selector")
+		}
+		message, err := p.parseNestedMessage(depth, false)
+		if err != nil {
+			return nil, err
+		}
+		selection.cases[selector] = message
+	}
+	if _, exists := selection.cases["other"]; !exists {
+		return nil, p.newError(`missing "other" select case`)
+	}
+
+	return selection, nil
+}
+
+func (p *parser) parseNestedMessage(depth int, inPlural bool) ([]part, error) {
+	p.skipSpaces()
+	if !p.consume('{') {
+		return nil, p.newError("expected nested message")
+	}
+	message, err := p.parseMessage(depth+1, inPlural)
+	if err != nil {
+		return nil, err
+	}
+	if !p.consume('}') {
+		return nil, p.newError("expected closing brace")
+	}
+
+	return message, nil
+}
+
+func (p *parser) parseIdentifier() string {
+	start := p.position
+	for p.position < len(p.pattern) {
+		c, size := utf8.DecodeRuneInString(p.pattern[p.position:])
+		if c != '_' && !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			break
+		}
+		p.position += size
+	}
+
+	return p.pattern[start:p.position]
+}
+
+func (p *parser) parseSelector() string {
+	start := p.position
+	for p.position < len(p.pattern) {
+		c, size := utf8.DecodeRuneInString(p.pattern[p.position:])
+		if c == '{' || c == '}' || unicode.IsSpace(c) {
+			break
+		}
+		p.position += size
+	}
+
+	return p.pattern[start:p.position]
+}
+
+func (p *parser) skipSpaces() {
+	for p.position < len(p.pattern) {
+		c, size := utf8.DecodeRuneInString(p.pattern[p.position:])
+		if !unicode.IsSpace(c) {
+			return
+		}
+		p.position += size
+	}
+}
+
+func (p *parser) consume(c byte) bool {
+	if p.position < len(p.pattern) && p.pattern[p.position] == c {
+		p.position++
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) newError(message string) *ParsingError {
+	return &ParsingError{Pattern: p.pattern, Position: p.position, Message: message}
+}
+
+func isPluralKeyword(s string) bool {
+	switch s {
+	case "zero", "one", "two", "few", "many", "other":
+		return true
+	}
+
+	return false
+}
+
+// ParsingError is returned when the message template cannot be parsed.
+type ParsingError struct {
+	Pattern  string
+	Position int
+	Message  string
+}
+
+func (err *ParsingError) Error() string {
+	return fmt.Sprintf("parsing message %q at char #%d: %s", err.Pattern, err.Position, err.Message)
+}
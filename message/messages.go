@@ -36,6 +36,7 @@ const (
 	InvalidIP         = "This is not a valid IP address."
 	InvalidJSON       = "This value should be valid JSON."
 	InvalidTime       = "This value is not a valid time."
+	InvalidType       = "This value should be of type {{ type }}."
 	InvalidULID       = "This is not a valid ULID."
 	InvalidUPCA       = "This value is not a valid UPC-A."
 	InvalidUPCE       = "This value is not a valid UPC-E."
@@ -44,6 +45,7 @@ const (
 	IsBlank           = "This value should not be blank."
 	IsEqual           = "This value should not be equal to {{ comparedValue }}."
 	IsNil             = "This value should not be nil."
+	MissingProperty   = "This field is missing."
 	NoSuchChoice      = "The value you selected is not a valid choice."
 	NotBlank          = "This value should be blank."
 	NotDivisible      = "This value should be a multiple of {{ comparedValue }}."
@@ -60,6 +62,7 @@ const (
 	NotNumeric        = "This value is not a numeric."
 	NotPositive       = "This value should be positive."
 	NotPositiveOrZero = "This value should be either positive or zero."
+	NotSatisfied      = "This value should satisfy the expression {{ expression }}."
 	NotTrue           = "This value should be true."
 	NotUnique         = "This collection should contain only unique elements."
 	NotValid          = "This value is not valid."
@@ -77,4 +80,5 @@ const (
 	TooLowOrEqual     = "This value should be greater than or equal to {{ comparedValue }}."
 	TooManyElements   = "This collection should contain {{ limit }} element(s) or less."
 	TooShort          = "This value is too short. It should have {{ limit }} character(s) or more."
+	UnknownProperty   = "This field was not expected."
 )
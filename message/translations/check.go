@@ -0,0 +1,258 @@
+package translations
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// Report contains the results of the translations check made by [Check].
+type Report struct {
+	Languages []LanguageReport
+}
+
+// LanguageReport contains the issues found in the translations of the language.
+type LanguageReport struct {
+	Language language.Tag
+
+	// MissingKeys are the message templates that have no translation.
+	MissingKeys []string
+
+	// ExtraKeys are the translated messages that do not correspond to any of the templates.
+	ExtraKeys []string
+
+	// PlaceholderMismatches are the translations with placeholders different from the template.
+	PlaceholderMismatches []PlaceholderMismatch
+
+	// MissingPluralForms are the pluralized translations that do not cover
+	// all the plural forms of the language.
+	MissingPluralForms []MissingPluralForms
+}
+
+// PlaceholderMismatch describes the translation with placeholders different from the message template.
+type PlaceholderMismatch struct {
+	Key string
+	// Form is the plural form or the exact match of the translation (e.g. "one" or "=0").
+	// It is empty for translations without plural forms.
+	Form       string
+	Missing    []string
+	Unexpected []string
+}
+
+// MissingPluralForms describes the pluralized translation that has no case for some plural forms
+// of the language.
+type MissingPluralForms struct {
+	Key   string
+	Forms []string
+}
+
+// IsEmpty returns true if no issues were found for the language.
+func (r LanguageReport) IsEmpty() bool {
+	return len(r.MissingKeys) == 0 &&
+		len(r.ExtraKeys) == 0 &&
+		len(r.PlaceholderMismatches) == 0 &&
+		len(r.MissingPluralForms) == 0
+}
+
+// HasIssues returns true if any issues were found.
+func (r *Report) HasIssues() bool {
+	for _, l := range r.Languages {
+		if !l.IsEmpty() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the list of issues, one per line.
+func (r *Report) String() string {
+	var s strings.Builder
+	for _, l := range r.Languages {
+		for _, key := range l.MissingKeys {
+			fmt.Fprintf(&s, "%s: missing translation for message %q\n", l.Language, key)
+		}
+		for _, key := range l.ExtraKeys {
+			fmt.Fprintf(&s, "%s: unknown message template %q\n", l.Language, key)
+		}
+		for _, m := range l.PlaceholderMismatches {
+			fmt.Fprintf(&s, "%s: message %q", l.Language, m.Key)
+			if m.Form != "" {
+				fmt.Fprintf(&s, " (form %s)", m.Form)
+			}
+			if len(m.Missing) > 0 {
+				fmt.Fprintf(&s, ": missing placeholders %s", strings.Join(m.Missing, ", "))
+			}
+			if len(m.Unexpected) > 0 {
+				fmt.Fprintf(&s, ": unexpected placeholders %s", strings.Join(m.Unexpected, ", "))
+			}
+			s.WriteString("\n")
+		}
+		for _, m := range l.MissingPluralForms {
+			fmt.Fprintf(&s, "%s: message %q: missing plural forms %s\n", l.Language, m.Key, strings.Join(m.Forms, ", "))
+		}
+	}
+
+	return s.String()
+}
+
+// commonPlaceholders are available in every message and may be omitted
+// or added by translations freely.
+var commonPlaceholders = map[string]bool{
+	"{{ label }}":    true,
+	"{{ property }}": true,
+}
+
+var placeholderPattern = regexp.MustCompile(`{{\s*(\w+)\s*}}`)
+
+// Plural creates the message selecting the translation by the plural count in the same way
+// as [plural.Selectf]: the selector ([plural.Form] or the exact value like "=0") is followed by the translation.
+// Unlike the message created by [plural.Selectf], the plural forms of the message can be checked by [Check].
+func Plural(cases ...any) catalog.Message {
+	return plurals.New(cases...)
+}
+
+// Check checks the translations against the message templates. It reports the missing and extra keys
+// for every language, placeholders mismatches between templates and translations, and pluralized translations
+// that do not cover all the plural forms of the language. Placeholders "{{ label }}" and "{{ property }}"
+// are available for all messages and are not checked. Only the messages created by [catalog.String]
+// and [Plural] (the built-in and loaded translations) are checked for placeholders and plural forms.
+//
+// Use the keys of [english.Messages] to get the templates of the built-in messages.
+func Check(templates []string, messages map[language.Tag]map[string]catalog.Message) *Report {
+	known := make(map[string]bool, len(templates))
+	for _, template := range templates {
+		known[template] = true
+	}
+
+	report := &Report{Languages: make([]LanguageReport, 0, len(messages))}
+	for tag, tagMessages := range messages {
+		report.Languages = append(report.Languages, checkLanguage(tag, templates, known, tagMessages))
+	}
+	sort.Slice(report.Languages, func(i, j int) bool {
+		return report.Languages[i].Language.String() < report.Languages[j].Language.String()
+	})
+
+	return report
+}
+
+func checkLanguage(
+	tag language.Tag,
+	templates []string,
+	known map[string]bool,
+	messages map[string]catalog.Message,
+) LanguageReport {
+	report := LanguageReport{Language: tag}
+	categories := languagePluralCategories(tag)
+
+	for _, template := range templates {
+		if _, exists := messages[template]; !exists {
+			report.MissingKeys = append(report.MissingKeys, template)
+		}
+	}
+	for key, msg := range messages {
+		if !known[key] {
+			report.ExtraKeys = append(report.ExtraKeys, key)
+			continue
+		}
+		forms, ok := messageForms(msg)
+		if !ok {
+			continue
+		}
+		report.PlaceholderMismatches = append(report.PlaceholderMismatches, checkPlaceholders(key, forms)...)
+		if missing := missingPluralForms(categories, forms); len(missing) > 0 {
+			report.MissingPluralForms = append(report.MissingPluralForms, MissingPluralForms{Key: key, Forms: missing})
+		}
+	}
+
+	sort.Strings(report.MissingKeys)
+	sort.Strings(report.ExtraKeys)
+	sort.Slice(report.PlaceholderMismatches, func(i, j int) bool {
+		a, b := report.PlaceholderMismatches[i], report.PlaceholderMismatches[j]
+		return a.Key < b.Key || a.Key == b.Key && a.Form < b.Form
+	})
+	sort.Slice(report.MissingPluralForms, func(i, j int) bool {
+		return report.MissingPluralForms[i].Key < report.MissingPluralForms[j].Key
+	})
+
+	return report
+}
+
+func checkPlaceholders(key string, forms map[string]string) []PlaceholderMismatch {
+	expected := placeholders(key)
+
+	var mismatches []PlaceholderMismatch
+	for form, translation := range forms {
+		actual := placeholders(translation)
+		mismatch := PlaceholderMismatch{Key: key, Form: form}
+		for p := range expected {
+			if !actual[p] {
+				mismatch.Missing = append(mismatch.Missing, p)
+			}
+		}
+		for p := range actual {
+			if !expected[p] {
+				mismatch.Unexpected = append(mismatch.Unexpected, p)
+			}
+		}
+		if len(mismatch.Missing) > 0 || len(mismatch.Unexpected) > 0 {
+			sort.Strings(mismatch.Missing)
+			sort.Strings(mismatch.Unexpected)
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+
+	return mismatches
+}
+
+// placeholders returns the set of normalized placeholders (e.g. "{{ limit }}") except the common ones.
+func placeholders(s string) map[string]bool {
+	set := map[string]bool{}
+	for _, match := range placeholderPattern.FindAllStringSubmatch(s, -1) {
+		p := "{{ " + match[1] + " }}"
+		if !commonPlaceholders[p] {
+			set[p] = true
+		}
+	}
+
+	return set
+}
+
+// missingPluralForms returns the plural categories of the language that have no own case. The "other" case
+// does not cover the missing categories, because it may be a wrong form for them (e.g. "few" in Russian).
+func missingPluralForms(categories []string, forms map[string]string) []string {
+	if _, isPlain := forms[""]; isPlain {
+		return nil
+	}
+
+	var missing []string
+	for _, category := range categories {
+		if _, exists := forms[category]; !exists {
+			missing = append(missing, category)
+		}
+	}
+
+	return missing
+}
+
+// messageForms returns the texts of the message by the plural forms. The form of the plain string message
+// is empty. It returns false if the message type is not supported: only the messages created
+// by [catalog.String] and [Plural] can be inspected.
+func messageForms(msg catalog.Message) (map[string]string, bool) {
+	if m, ok := msg.(*plurals.Message); ok {
+		forms := m.Forms()
+		return forms, forms != nil
+	}
+	// the type of the string message is not exported, so it is recognized by comparison
+	// with the message created from its text
+	if text := fmt.Sprint(msg); msg == catalog.String(text) {
+		return map[string]string{"": text}, true
+	}
+
+	return nil, false
+}
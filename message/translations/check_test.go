@@ -0,0 +1,143 @@
+package translations_test
+
+import (
+	"testing"
+
+	"github.com/muonsoft/validation/message/translations"
+	"github.com/muonsoft/validation/message/translations/english"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestCheck_WhenBuiltinTranslations_ExpectNoIssues(t *testing.T) {
+	templates := make([]string, 0, len(english.Messages[language.English]))
+	for template := range english.Messages[language.English] {
+		templates = append(templates, template)
+	}
+
+	report := translations.Check(templates, russian.Messages)
+
+	assert.False(t, report.HasIssues(), report.String())
+}
+
+func TestCheck_WhenInvalidTranslations_ExpectIssuesReported(t *testing.T) {
+	templates := []string{
+		"This value is required.",
+		"This value should be {{ limit }} or less.",
+		"This collection should contain {{ limit }} element(s).",
+	}
+	messages := map[language.Tag]map[string]catalog.Message{
+		language.Russian: {
+			"This value should be {{ limit }} or less.": catalog.String("Значение должно быть {{ max }} или меньше."),
+			"This collection should contain {{ limit }} element(s).": translations.Plural(
+				plural.One, "{{ label }}: коллекция должна содержать {{ limit }} элемент.",
+				plural.Few, "Коллекция должна содержать {{ limit }} элемента.",
+				plural.Many, "Коллекция должна содержать много элементов."),
+			"Unknown message.": catalog.String("Неизвестное сообщение."),
+		},
+		language.German: {
+			"This value is required.":                   catalog.String("Dieser Wert ist erforderlich."),
+			"This value should be {{ limit }} or less.": catalog.String("Dieser Wert sollte {{ limit }} oder weniger sein."),
+			"This collection should contain {{ limit }} element(s).": translations.Plural(
+				"=0", "Diese Sammlung sollte leer sein.",
+				plural.One, "Diese Sammlung sollte {{ limit }} Element enthalten.",
+				plural.Other, "Diese Sammlung sollte {{ limit }} Elemente enthalten."),
+		},
+	}
+
+	report := translations.Check(templates, messages)
+
+	assert.True(t, report.HasIssues())
+	assert.Equal(t, []translations.LanguageReport{
+		{
+			Language: language.German,
+			PlaceholderMismatches: []translations.PlaceholderMismatch{
+				{
+					Key:     "This collection should contain {{ limit }} element(s).",
+					Form:    "=0",
+					Missing: []string{"{{ limit }}"},
+				},
+			},
+		},
+		{
+			Language:    language.Russian,
+			MissingKeys: []string{"This value is required."},
+			ExtraKeys:   []string{"Unknown message."},
+			PlaceholderMismatches: []translations.PlaceholderMismatch{
+				{
+					Key:     "This collection should contain {{ limit }} element(s).",
+					Form:    "many",
+					Missing: []string{"{{ limit }}"},
+				},
+				{
+					Key:        "This value should be {{ limit }} or less.",
+					Missing:    []string{"{{ limit }}"},
+					Unexpected: []string{"{{ max }}"},
+				},
+			},
+		},
+	}, report.Languages)
+	assert.Equal(t,
+		"de: message \"This collection should contain {{ limit }} element(s).\" (form =0): missing placeholders {{ limit }}\n"+
+			"ru: missing translation for message \"This value is required.\"\n"+
+			"ru: unknown message template \"Unknown message.\"\n"+
+			"ru: message \"This collection should contain {{ limit }} element(s).\" (form many): missing placeholders {{ limit }}\n"+
+			"ru: message \"This value should be {{ limit }} or less.\": missing placeholders {{ limit }}: unexpected placeholders {{ max }}\n",
+		report.String(),
+	)
+}
+
+func TestCheck_WhenPluralFormsNotCovered_ExpectMissingPluralForms(t *testing.T) {
+	templates := []string{"This collection should contain {{ limit }} element(s)."}
+	messages := map[language.Tag]map[string]catalog.Message{
+		language.Polish: {
+			"This collection should contain {{ limit }} element(s).": translations.Plural(
+				plural.One, "Ten zbiór powinien zawierać {{ limit }} element.",
+				plural.Few, "Ten zbiór powinien zawierać {{ limit }} elementy."),
+		},
+	}
+
+	report := translations.Check(templates, messages)
+
+	assert.Equal(t, []translations.MissingPluralForms{
+		{Key: "This collection should contain {{ limit }} element(s).", Forms: []string{"many"}},
+	}, report.Languages[0].MissingPluralForms)
+	assert.Equal(t,
+		"pl: message \"This collection should contain {{ limit }} element(s).\": missing plural forms many\n",
+		report.String(),
+	)
+}
+
+func TestCheck_WhenOtherFormCoversMissingForms_ExpectAllMissingFormsReported(t *testing.T) {
+	templates := []string{"This collection should contain {{ limit }} element(s)."}
+	messages := map[language.Tag]map[string]catalog.Message{
+		language.Russian: {
+			"This collection should contain {{ limit }} element(s).": translations.Plural(
+				plural.One, "Коллекция должна содержать {{ limit }} элемент.",
+				plural.Other, "Коллекция должна содержать {{ limit }} элементов."),
+		},
+	}
+
+	report := translations.Check(templates, messages)
+
+	assert.Equal(t, []translations.MissingPluralForms{
+		{Key: "This collection should contain {{ limit }} element(s).", Forms: []string{"few", "many"}},
+	}, report.Languages[0].MissingPluralForms)
+}
+
+func TestCheck_WhenMessageIsNotInspectable_ExpectMessageSkipped(t *testing.T) {
+	templates := []string{"This collection should contain {{ limit }} element(s)."}
+	messages := map[language.Tag]map[string]catalog.Message{
+		language.Russian: {
+			"This collection should contain {{ limit }} element(s).": plural.Selectf(1, "",
+				plural.Other, "Коллекция должна содержать элементы."),
+		},
+	}
+
+	report := translations.Check(templates, messages)
+
+	assert.False(t, report.HasIssues(), report.String())
+}
@@ -0,0 +1,91 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.zh_CN.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package chinese contains violation message texts translated into Chinese language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package chinese
+
+import (
+	"github.com/muonsoft/validation/message"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.Chinese: {
+		message.NotBlank:          catalog.String("该值应为空。"),
+		message.NotDivisible:      catalog.String("该值应当是 {{ comparedValue }} 的倍数。"),
+		message.NotDivisibleCount: catalog.String("该集合的元素数量应当是 {{ divisibleBy }} 的倍数。"),
+		message.NotExactCount:     catalog.String("该集合应当恰好包含 {{ limit }} 个元素。"),
+		message.TooFewElements:    catalog.String("该集合应当至少包含 {{ limit }} 个元素。"),
+		message.TooManyElements:   catalog.String("该集合最多只能包含 {{ limit }} 个元素。"),
+		message.NotEqual:          catalog.String("该值应当等于 {{ comparedValue }}。"),
+		message.NotFalse:          catalog.String("该值应为 false。"),
+		message.InvalidDate:       catalog.String("该值不是有效的日期。"),
+		message.InvalidDateTime:   catalog.String("该值不是有效的日期时间。"),
+		message.InvalidEAN13:      catalog.String("该值不是有效的 EAN-13。"),
+		message.InvalidEAN8:       catalog.String("该值不是有效的 EAN-8。"),
+		message.InvalidEmail:      catalog.String("该值不是有效的电子邮件地址。"),
+		message.InvalidHostname:   catalog.String("该值不是有效的主机名。"),
+		message.InvalidIP:         catalog.String("该值不是有效的 IP 地址。"),
+		message.InvalidJSON:       catalog.String("该值应当是有效的 JSON。"),
+		message.InvalidTime:       catalog.String("该值不是有效的时间。"),
+		message.InvalidULID:       catalog.String("该值不是有效的 ULID。"),
+		message.InvalidUPCA:       catalog.String("该值不是有效的 UPC-A。"),
+		message.InvalidUPCE:       catalog.String("该值不是有效的 UPC-E。"),
+		message.InvalidURL:        catalog.String("该值不是有效的 URL。"),
+		message.InvalidUUID:       catalog.String("该值不是有效的 UUID。"),
+		message.NotExactLength:    catalog.String("该值的长度应当恰好为 {{ limit }} 个字符。"),
+		message.TooShort:          catalog.String("该值太短，应当至少包含 {{ limit }} 个字符。"),
+		message.TooLong:           catalog.String("该值太长，最多只能包含 {{ limit }} 个字符。"),
+		message.NotNil:            catalog.String("该值应为 nil。"),
+		message.NoSuchChoice:      catalog.String("选定的值不是有效的选项。"),
+		message.IsBlank:           catalog.String("该值不应为空。"),
+		message.IsEqual:           catalog.String("该值不应等于 {{ comparedValue }}。"),
+		message.NotInRange:        catalog.String("该值应当在 {{ min }} 和 {{ max }} 之间。"),
+		message.NotInteger:        catalog.String("该值不是整数。"),
+		message.NotNegative:       catalog.String("该值应为负数。"),
+		message.NotNegativeOrZero: catalog.String("该值应为负数或零。"),
+		message.IsNil:             catalog.String("该值不应为 nil。"),
+		message.NotNumeric:        catalog.String("该值不是数字。"),
+		message.NotPositive:       catalog.String("该值应为正数。"),
+		message.NotPositiveOrZero: catalog.String("该值应为正数或零。"),
+		message.NotUnique:         catalog.String("该集合只能包含唯一的元素。"),
+		message.NotValid:          catalog.String("该值无效。"),
+		message.ProhibitedIP:      catalog.String("禁止使用该 IP 地址。"),
+		message.ProhibitedURL:     catalog.String("禁止使用该 URL。"),
+		message.TooEarly:          catalog.String("该值应晚于 {{ comparedValue }}。"),
+		message.TooEarlyOrEqual:   catalog.String("该值应晚于或等于 {{ comparedValue }}。"),
+		message.TooHigh:           catalog.String("该值应小于 {{ comparedValue }}。"),
+		message.TooHighOrEqual:    catalog.String("该值应小于或等于 {{ comparedValue }}。"),
+		message.TooLate:           catalog.String("该值应早于 {{ comparedValue }}。"),
+		message.TooLateOrEqual:    catalog.String("该值应早于或等于 {{ comparedValue }}。"),
+		message.TooLow:            catalog.String("该值应大于 {{ comparedValue }}。"),
+		message.TooLowOrEqual:     catalog.String("该值应大于或等于 {{ comparedValue }}。"),
+		message.NotTrue:           catalog.String("该值应为 true。"),
+		message.InvalidType:       catalog.String("该值的类型应为 {{ type }}。"),
+		message.MissingProperty:   catalog.String("该字段缺失。"),
+		message.UnknownProperty:   catalog.String("该字段是多余的。"),
+		message.NotSatisfied:      catalog.String("该值应满足表达式 {{ expression }}。"),
+	},
+}
@@ -0,0 +1,84 @@
+package english
+
+import (
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// LabelledMessages contains violation message texts translated into English language
+// that are prefixed by the "{{ label }}" template parameter. Load them to show the label of the property
+// in every message, e.g. "E-mail: This value is not a valid email address.".
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+var LabelledMessages = map[language.Tag]map[string]catalog.Message{
+	language.English: {
+		message.NotBlank:          catalog.String("{{ label }}: " + message.NotBlank),
+		message.NotDivisible:      catalog.String("{{ label }}: " + message.NotDivisible),
+		message.NotDivisibleCount: catalog.String("{{ label }}: " + message.NotDivisibleCount),
+		message.NotExactCount: plurals.New(
+			plural.One, "{{ label }}: This collection should contain exactly {{ limit }} element.",
+			plural.Other, "{{ label }}: This collection should contain exactly {{ limit }} elements."),
+		message.TooFewElements: plurals.New(
+			plural.One, "{{ label }}: This collection should contain {{ limit }} element or more.",
+			plural.Other, "{{ label }}: This collection should contain {{ limit }} elements or more."),
+		message.TooManyElements: plurals.New(
+			plural.One, "{{ label }}: This collection should contain {{ limit }} element or less.",
+			plural.Other, "{{ label }}: This collection should contain {{ limit }} elements or less."),
+		message.NotEqual:        catalog.String("{{ label }}: " + message.NotEqual),
+		message.NotFalse:        catalog.String("{{ label }}: " + message.NotFalse),
+		message.InvalidDate:     catalog.String("{{ label }}: " + message.InvalidDate),
+		message.InvalidDateTime: catalog.String("{{ label }}: " + message.InvalidDateTime),
+		message.InvalidEAN13:    catalog.String("{{ label }}: " + message.InvalidEAN13),
+		message.InvalidEAN8:     catalog.String("{{ label }}: " + message.InvalidEAN8),
+		message.InvalidEmail:    catalog.String("{{ label }}: " + message.InvalidEmail),
+		message.InvalidHostname: catalog.String("{{ label }}: " + message.InvalidHostname),
+		message.InvalidIP:       catalog.String("{{ label }}: " + message.InvalidIP),
+		message.InvalidJSON:     catalog.String("{{ label }}: " + message.InvalidJSON),
+		message.InvalidTime:     catalog.String("{{ label }}: " + message.InvalidTime),
+		message.InvalidULID:     catalog.String("{{ label }}: " + message.InvalidULID),
+		message.InvalidUPCA:     catalog.String("{{ label }}: " + message.InvalidUPCA),
+		message.InvalidUPCE:     catalog.String("{{ label }}: " + message.InvalidUPCE),
+		message.InvalidURL:      catalog.String("{{ label }}: " + message.InvalidURL),
+		message.InvalidUUID:     catalog.String("{{ label }}: " + message.InvalidUUID),
+		message.NotExactLength: plurals.New(
+			plural.One, "{{ label }}: This value should have exactly {{ limit }} character.",
+			plural.Other, "{{ label }}: This value should have exactly {{ limit }} characters."),
+		message.TooShort: plurals.New(
+			plural.One, "{{ label }}: This value is too short. It should have {{ limit }} character or more.",
+			plural.Other, "{{ label }}: This value is too short. It should have {{ limit }} characters or more."),
+		message.TooLong: plurals.New(
+			plural.One, "{{ label }}: This value is too long. It should have {{ limit }} character or less.",
+			plural.Other, "{{ label }}: This value is too long. It should have {{ limit }} characters or less."),
+		message.NotNil:            catalog.String("{{ label }}: " + message.NotNil),
+		message.NoSuchChoice:      catalog.String("{{ label }}: " + message.NoSuchChoice),
+		message.IsBlank:           catalog.String("{{ label }}: " + message.IsBlank),
+		message.IsEqual:           catalog.String("{{ label }}: " + message.IsEqual),
+		message.NotInRange:        catalog.String("{{ label }}: " + message.NotInRange),
+		message.NotInteger:        catalog.String("{{ label }}: " + message.NotInteger),
+		message.NotNegative:       catalog.String("{{ label }}: " + message.NotNegative),
+		message.NotNegativeOrZero: catalog.String("{{ label }}: " + message.NotNegativeOrZero),
+		message.IsNil:             catalog.String("{{ label }}: " + message.IsNil),
+		message.NotNumeric:        catalog.String("{{ label }}: " + message.NotNumeric),
+		message.NotPositive:       catalog.String("{{ label }}: " + message.NotPositive),
+		message.NotPositiveOrZero: catalog.String("{{ label }}: " + message.NotPositiveOrZero),
+		message.NotUnique:         catalog.String("{{ label }}: " + message.NotUnique),
+		message.NotValid:          catalog.String("{{ label }}: " + message.NotValid),
+		message.ProhibitedIP:      catalog.String("{{ label }}: " + message.ProhibitedIP),
+		message.ProhibitedURL:     catalog.String("{{ label }}: " + message.ProhibitedURL),
+		message.TooEarly:          catalog.String("{{ label }}: " + message.TooEarly),
+		message.TooEarlyOrEqual:   catalog.String("{{ label }}: " + message.TooEarlyOrEqual),
+		message.TooHigh:           catalog.String("{{ label }}: " + message.TooHigh),
+		message.TooHighOrEqual:    catalog.String("{{ label }}: " + message.TooHighOrEqual),
+		message.TooLate:           catalog.String("{{ label }}: " + message.TooLate),
+		message.TooLateOrEqual:    catalog.String("{{ label }}: " + message.TooLateOrEqual),
+		message.TooLow:            catalog.String("{{ label }}: " + message.TooLow),
+		message.TooLowOrEqual:     catalog.String("{{ label }}: " + message.TooLowOrEqual),
+		message.NotTrue:           catalog.String("{{ label }}: " + message.NotTrue),
+		message.InvalidType:       catalog.String("{{ label }}: " + message.InvalidType),
+		message.MissingProperty:   catalog.String("{{ label }}: " + message.MissingProperty),
+		message.UnknownProperty:   catalog.String("{{ label }}: " + message.UnknownProperty),
+		message.NotSatisfied:      catalog.String("{{ label }}: " + message.NotSatisfied),
+	},
+}
@@ -27,6 +27,7 @@ package english
 
 import (
 	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
 	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message/catalog"
@@ -37,13 +38,13 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.NotBlank:          catalog.String(message.NotBlank),
 		message.NotDivisible:      catalog.String(message.NotDivisible),
 		message.NotDivisibleCount: catalog.String(message.NotDivisibleCount),
-		message.NotExactCount: plural.Selectf(1, "",
+		message.NotExactCount: plurals.New(
 			plural.One, "This collection should contain exactly {{ limit }} element.",
 			plural.Other, "This collection should contain exactly {{ limit }} elements."),
-		message.TooFewElements: plural.Selectf(1, "",
+		message.TooFewElements: plurals.New(
 			plural.One, "This collection should contain {{ limit }} element or more.",
 			plural.Other, "This collection should contain {{ limit }} elements or more."),
-		message.TooManyElements: plural.Selectf(1, "",
+		message.TooManyElements: plurals.New(
 			plural.One, "This collection should contain {{ limit }} element or less.",
 			plural.Other, "This collection should contain {{ limit }} elements or less."),
 		message.NotEqual:        catalog.String(message.NotEqual),
@@ -62,13 +63,13 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.InvalidUPCE:     catalog.String(message.InvalidUPCE),
 		message.InvalidURL:      catalog.String(message.InvalidURL),
 		message.InvalidUUID:     catalog.String(message.InvalidUUID),
-		message.NotExactLength: plural.Selectf(1, "",
+		message.NotExactLength: plurals.New(
 			plural.One, "This value should have exactly {{ limit }} character.",
 			plural.Other, "This value should have exactly {{ limit }} characters."),
-		message.TooShort: plural.Selectf(1, "",
+		message.TooShort: plurals.New(
 			plural.One, "This value is too short. It should have {{ limit }} character or more.",
 			plural.Other, "This value is too short. It should have {{ limit }} characters or more."),
-		message.TooLong: plural.Selectf(1, "",
+		message.TooLong: plurals.New(
 			plural.One, "This value is too long. It should have {{ limit }} character or less.",
 			plural.Other, "This value is too long. It should have {{ limit }} characters or less."),
 		message.NotNil:            catalog.String(message.NotNil),
@@ -96,5 +97,9 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.TooLow:            catalog.String(message.TooLow),
 		message.TooLowOrEqual:     catalog.String(message.TooLowOrEqual),
 		message.NotTrue:           catalog.String(message.NotTrue),
+		message.InvalidType:       catalog.String(message.InvalidType),
+		message.MissingProperty:   catalog.String(message.MissingProperty),
+		message.UnknownProperty:   catalog.String(message.UnknownProperty),
+		message.NotSatisfied:      catalog.String(message.NotSatisfied),
 	},
 }
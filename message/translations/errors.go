@@ -2,4 +2,19 @@ package translations
 
 import "errors"
 
-var errDefaultLanguageNotLoaded = errors.New("default language is not loaded")
+var (
+	errDefaultLanguageNotLoaded  = errors.New("default language is not loaded")
+	errFallbackLanguageNotLoaded = errors.New("fallback language is not loaded")
+)
+
+var (
+	// ErrUnknownTemplate is returned when a translation file contains a message
+	// that does not correspond to any of the known message templates.
+	ErrUnknownTemplate = errors.New("unknown message template")
+
+	// ErrMalformedTranslation is returned when a translation file contains a malformed entry.
+	ErrMalformedTranslation = errors.New("malformed translation")
+
+	// ErrUnsupportedFormat is returned when a translation file has an unknown extension.
+	ErrUnsupportedFormat = errors.New("unsupported translation file format")
+)
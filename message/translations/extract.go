@@ -0,0 +1,204 @@
+package translations
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExtractedTemplate is a message template found in the source code by [ExtractTemplates].
+type ExtractedTemplate struct {
+	Template string
+	// Position is the location of the template in the source code in form of "file:line:column".
+	Position string
+}
+
+var messageMethodPattern = regexp.MustCompile(`^With\w*Message$`)
+
+// ExtractTemplates finds the message templates passed as string literals into the methods
+// of constraints like WithMessage, WithMinMessage, WithMaxMessage, etc. It scans all Go files
+// of the file system except the "vendor" and "testdata" directories. Templates are sorted by positions.
+func ExtractTemplates(fsys fs.FS) ([]ExtractedTemplate, error) {
+	var templates []ExtractedTemplate
+
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if name != "." && (entry.Name() == "vendor" || entry.Name() == "testdata" || strings.HasPrefix(entry.Name(), ".")) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if path.Ext(name) != ".go" {
+			return nil
+		}
+
+		found, err := extractFileTemplates(fsys, name)
+		if err != nil {
+			return err
+		}
+		templates = append(templates, found...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("extract message templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+func extractFileTemplates(fsys fs.FS, name string) ([]ExtractedTemplate, error) {
+	source, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	files := token.NewFileSet()
+	file, err := parser.ParseFile(files, name, source, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	var arguments []ast.Expr
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !messageMethodPattern.MatchString(selector.Sel.Name) {
+			return true
+		}
+		arguments = append(arguments, call.Args[0])
+		return true
+	})
+	// chained calls are visited from the outermost one, so the order of the source code is restored
+	sort.Slice(arguments, func(i, j int) bool {
+		return arguments[i].Pos() < arguments[j].Pos()
+	})
+
+	var templates []ExtractedTemplate
+	for _, argument := range arguments {
+		if template, ok := stringLiteral(argument); ok {
+			templates = append(templates, ExtractedTemplate{
+				Template: template,
+				Position: files.Position(argument.Pos()).String(),
+			})
+		}
+	}
+
+	return templates, nil
+}
+
+// stringLiteral returns the value of the string literal or the concatenation of string literals.
+func stringLiteral(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		s, err := strconv.Unquote(e.Value)
+		return s, err == nil
+	case *ast.ParenExpr:
+		return stringLiteral(e.X)
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return "", false
+		}
+		x, ok := stringLiteral(e.X)
+		if !ok {
+			return "", false
+		}
+		y, ok := stringLiteral(e.Y)
+		return x + y, ok
+	}
+
+	return "", false
+}
+
+// Skeleton formats.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatPO   = "po"
+)
+
+// WriteSkeleton writes the catalog of untranslated messages for the templates in the format
+// supported by [ReadFiles] (see [FormatJSON], [FormatYAML] and [FormatPO]). Duplicated templates
+// are written once, templates are sorted alphabetically.
+func WriteSkeleton(w io.Writer, format string, templates []string) error {
+	unique := make(map[string]struct{}, len(templates))
+	keys := make([]string, 0, len(templates))
+	for _, template := range templates {
+		if _, exists := unique[template]; !exists {
+			unique[template] = struct{}{}
+			keys = append(keys, template)
+		}
+	}
+	sort.Strings(keys)
+
+	switch format {
+	case FormatJSON:
+		return writeJSONSkeleton(w, keys)
+	case FormatYAML:
+		return writeYAMLSkeleton(w, keys)
+	case FormatPO:
+		return writePOSkeleton(w, keys)
+	}
+
+	return fmt.Errorf(`%w "%s"`, ErrUnsupportedFormat, format)
+}
+
+func writeJSONSkeleton(w io.Writer, keys []string) error {
+	messages := make(map[string]string, len(keys))
+	for _, key := range keys {
+		messages[key] = ""
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(messages)
+}
+
+func writeYAMLSkeleton(w io.Writer, keys []string) error {
+	document := &yaml.Node{Kind: yaml.MappingNode}
+	for _, key := range keys {
+		document.Content = append(document.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: key, Style: yaml.DoubleQuotedStyle},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "", Style: yaml.DoubleQuotedStyle},
+		)
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	err := encoder.Encode(document)
+	if err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+func writePOSkeleton(w io.Writer, keys []string) error {
+	b := bufio.NewWriter(w)
+	b.WriteString("msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n")
+	for _, key := range keys {
+		fmt.Fprintf(b, "\nmsgid %s\nmsgstr \"\"\n", strconv.Quote(key))
+	}
+
+	return b.Flush()
+}
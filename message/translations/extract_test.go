@@ -0,0 +1,101 @@
+package translations_test
+
+import (
+	"bytes"
+	"testing"
+	"testing/fstest"
+
+	"github.com/muonsoft/validation/message/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+const extractSource = `package app
+
+import "github.com/muonsoft/validation/it"
+
+const customMessage = "Not extracted."
+
+var constraints = []any{
+	it.IsNotBlank().WithMessage("Name is required."),
+	it.HasLengthBetween(1, 10).
+		WithMinMessage("Too short: " + "{{ limit }}.").
+		WithMaxMessage(` + "`Too long: {{ limit }}.`" + `),
+	it.IsNotBlank().WithMessage(customMessage),
+	it.IsNotBlank().WithMessage("Name is required."),
+}
+`
+
+func TestExtractTemplates(t *testing.T) {
+	fsys := fstest.MapFS{
+		"app/constraints.go":        {Data: []byte(extractSource)},
+		"app/readme.md":             {Data: []byte(`WithMessage("Not a Go file.")`)},
+		"vendor/lib/constraints.go": {Data: []byte(`package lib; var _ = x.WithMessage("Vendored.")`)},
+	}
+
+	templates, err := translations.ExtractTemplates(fsys)
+
+	require.NoError(t, err)
+	assert.Equal(t, []translations.ExtractedTemplate{
+		{Template: "Name is required.", Position: "app/constraints.go:8:30"},
+		{Template: "Too short: {{ limit }}.", Position: "app/constraints.go:10:18"},
+		{Template: "Too long: {{ limit }}.", Position: "app/constraints.go:11:18"},
+		{Template: "Name is required.", Position: "app/constraints.go:13:30"},
+	}, templates)
+}
+
+func TestExtractTemplates_WhenInvalidSource_ExpectError(t *testing.T) {
+	fsys := fstest.MapFS{"app.go": {Data: []byte(`package`)}}
+
+	templates, err := translations.ExtractTemplates(fsys)
+
+	assert.Nil(t, templates)
+	assert.ErrorContains(t, err, "extract message templates: app.go:1:8: expected 'IDENT'")
+}
+
+func TestWriteSkeleton(t *testing.T) {
+	templates := []string{"B {{ limit }}.", "A \"quoted\".", "B {{ limit }}."}
+	tests := []struct {
+		format   string
+		file     string
+		expected string
+	}{
+		{
+			format:   translations.FormatJSON,
+			file:     "ru.json",
+			expected: "{\n  \"A \\\"quoted\\\".\": \"\",\n  \"B {{ limit }}.\": \"\"\n}\n",
+		},
+		{
+			format:   translations.FormatYAML,
+			file:     "ru.yaml",
+			expected: "\"A \\\"quoted\\\".\": \"\"\n\"B {{ limit }}.\": \"\"\n",
+		},
+		{
+			format: translations.FormatPO,
+			file:   "ru.po",
+			expected: "msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n" +
+				"\nmsgid \"A \\\"quoted\\\".\"\nmsgstr \"\"\n" +
+				"\nmsgid \"B {{ limit }}.\"\nmsgstr \"\"\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			var b bytes.Buffer
+
+			err := translations.WriteSkeleton(&b, test.format, templates)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, b.String())
+			messages, err := translations.ReadFiles(fstest.MapFS{test.file: {Data: b.Bytes()}}, test.file)
+			require.NoError(t, err)
+			assert.Empty(t, messages[language.Russian])
+		})
+	}
+}
+
+func TestWriteSkeleton_WhenUnsupportedFormat_ExpectError(t *testing.T) {
+	err := translations.WriteSkeleton(&bytes.Buffer{}, "xml", nil)
+
+	assert.ErrorIs(t, err, translations.ErrUnsupportedFormat)
+}
@@ -0,0 +1,314 @@
+package translations
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadFiles option is used to load translation messages from the files of the file system
+// (e.g. [embed.FS]) matching the patterns (see [fs.Glob] for the syntax). See [ReadFiles] for
+// the supported formats.
+//
+// Every message key must correspond to one of the known message templates: the templates of
+// the built-in messages or the templates loaded by the previous options (e.g. by [SetTranslations]).
+// Otherwise, an error wrapping [ErrUnknownTemplate] is returned.
+func LoadFiles(fsys fs.FS, patterns ...string) TranslatorOption {
+	return func(translator *Translator) error {
+		messages, err := ReadFiles(fsys, patterns...)
+		if err != nil {
+			return fmt.Errorf("load translations: %w", err)
+		}
+		err = translator.checkTemplates(messages)
+		if err != nil {
+			return fmt.Errorf("load translations: %w", err)
+		}
+
+		return translator.setMessages(messages)
+	}
+}
+
+// ReadFiles reads translation messages from the files of the file system matching the patterns
+// (see [fs.Glob] for the syntax). The format of the file is detected by its extension:
+//
+//   - ".json" and ".yaml" (".yml") files contain an object of message templates mapped to translations;
+//     pluralized translations are objects of the plural forms ("zero", "one", "two", "few", "many", "other")
+//     or exact matches (e.g. "=0") mapped to translations;
+//   - ".po" files are gettext catalogs, plural forms "msgstr[N]" are mapped to the plural forms
+//     of the language by the formula of the "Plural-Forms" header; without the header, they follow
+//     the order of the CLDR categories (e.g. "one", "few", "many" for Russian). The file is rejected
+//     if the header does not match the CLDR plural rules of the language;
+//   - ".xlf" and ".xliff" files are XLIFF 1.2 documents, plural forms are read from the groups
+//     with "x-gettext-plurals" resource type in the same way as for gettext catalogs.
+//
+// The language of gettext catalogs and XLIFF documents is taken from the file headers.
+// Otherwise, it is detected by the file name, which must be a language tag or end with
+// the language tag separated by a dot (e.g. "ru.json" or "validators.ru.yaml").
+//
+// Empty translations are considered untranslated and skipped. Gettext entries marked as fuzzy are skipped too.
+func ReadFiles(fsys fs.FS, patterns ...string) (map[language.Tag]map[string]catalog.Message, error) {
+	messages := map[language.Tag]map[string]catalog.Message{}
+
+	for _, pattern := range patterns {
+		names, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return nil, fmt.Errorf(`read translation files "%s": %w`, pattern, err)
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf(`read translation files "%s": no files match the pattern`, pattern)
+		}
+		for _, name := range names {
+			err = readFile(fsys, name, messages)
+			if err != nil {
+				return nil, fmt.Errorf(`read translation file "%s": %w`, name, err)
+			}
+		}
+	}
+
+	return messages, nil
+}
+
+// translationFile contains the messages read from the file. The language is empty
+// if the file format has no language information. Gettext-style indexed plural forms
+// are resolved when the language of the file is detected, the order of the forms is
+// defined by the "Plural-Forms" header of the gettext catalog.
+type translationFile struct {
+	language       string
+	pluralForms    string
+	messages       map[string]catalog.Message
+	indexedPlurals map[string][]string
+}
+
+func readFile(fsys fs.FS, name string, messages map[language.Tag]map[string]catalog.Message) error {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return err
+	}
+
+	var file *translationFile
+	switch ext := strings.ToLower(path.Ext(name)); ext {
+	case ".json":
+		file, err = readJSON(data)
+	case ".yaml", ".yml":
+		file, err = readYAML(data)
+	case ".po":
+		file, err = readPO(data)
+	case ".xlf", ".xliff":
+		file, err = readXLIFF(data)
+	default:
+		return fmt.Errorf(`%w "%s"`, ErrUnsupportedFormat, ext)
+	}
+	if err != nil {
+		return err
+	}
+
+	tag, err := fileLanguage(name, file.language)
+	if err != nil {
+		return err
+	}
+	var indexes map[string]int
+	if len(file.indexedPlurals) > 0 || file.pluralForms != "" {
+		indexes, err = pluralIndexes(tag, file.pluralForms)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrMalformedTranslation, err)
+		}
+	}
+	for key, translations := range file.indexedPlurals {
+		msg, err := newIndexedPluralMessage(tag, indexes, translations)
+		if err != nil {
+			return fmt.Errorf(`%w: message "%s": %w`, ErrMalformedTranslation, key, err)
+		}
+		if msg != nil {
+			file.messages[key] = msg
+		}
+	}
+	if messages[tag] == nil {
+		messages[tag] = make(map[string]catalog.Message, len(file.messages))
+	}
+	for key, msg := range file.messages {
+		messages[tag][key] = msg
+	}
+
+	return nil
+}
+
+func fileLanguage(name, lang string) (language.Tag, error) {
+	if lang == "" {
+		lang = strings.TrimSuffix(path.Base(name), path.Ext(name))
+		if i := strings.LastIndexByte(lang, '.'); i >= 0 {
+			lang = lang[i+1:]
+		}
+	}
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return language.Und, fmt.Errorf(`detect language "%s": %w`, lang, err)
+	}
+
+	return tag, nil
+}
+
+func readJSON(data []byte) (*translationFile, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var entries map[string]any
+	err := decoder.Decode(&entries)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON: %w", ErrMalformedTranslation, err)
+	}
+
+	return readEntries(entries)
+}
+
+func readYAML(data []byte) (*translationFile, error) {
+	var entries map[string]any
+	err := yaml.Unmarshal(data, &entries)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid YAML: %w", ErrMalformedTranslation, err)
+	}
+
+	return readEntries(entries)
+}
+
+// readEntries converts the decoded JSON or YAML object into catalog messages.
+func readEntries(entries map[string]any) (*translationFile, error) {
+	file := &translationFile{messages: make(map[string]catalog.Message, len(entries))}
+
+	for key, value := range entries {
+		switch v := value.(type) {
+		case string:
+			if v != "" {
+				file.messages[key] = catalog.String(v)
+			}
+		case map[string]any:
+			forms := make(map[string]string, len(v))
+			for form, translation := range v {
+				s, ok := translation.(string)
+				if !ok {
+					return nil, fmt.Errorf(
+						`%w: message "%s": plural form "%s" must be a string`,
+						ErrMalformedTranslation, key, form,
+					)
+				}
+				forms[form] = s
+			}
+			msg, err := newPluralMessage(forms)
+			if err != nil {
+				return nil, fmt.Errorf(`%w: message "%s": %w`, ErrMalformedTranslation, key, err)
+			}
+			if msg != nil {
+				file.messages[key] = msg
+			}
+		default:
+			return nil, fmt.Errorf(
+				`%w: message "%s": translation must be a string or an object of plural forms`,
+				ErrMalformedTranslation, key,
+			)
+		}
+	}
+
+	return file, nil
+}
+
+// pluralCategories are the plural forms of the CLDR in the canonical order.
+var pluralCategories = plurals.Categories
+
+var pluralForms = plurals.Forms
+
+// newPluralMessage creates the message selecting the translation by the plural forms or by the exact
+// values (e.g. "=0"). Exact values go first, then the plural forms in the canonical order.
+// It returns nil if all the translations are empty.
+func newPluralMessage(forms map[string]string) (catalog.Message, error) {
+	exact := make([]string, 0, len(forms))
+	for form, translation := range forms {
+		if strings.HasPrefix(form, "=") {
+			if _, err := strconv.Atoi(form[1:]); err != nil {
+				return nil, fmt.Errorf(`invalid plural form "%s"`, form)
+			}
+			if translation != "" {
+				exact = append(exact, form)
+			}
+		} else if _, exists := pluralForms[form]; !exists {
+			return nil, fmt.Errorf(`invalid plural form "%s"`, form)
+		}
+	}
+	sort.Strings(exact)
+
+	cases := make([]any, 0, 2*len(forms))
+	for _, form := range exact {
+		cases = append(cases, form, forms[form])
+	}
+	for _, category := range pluralCategories {
+		if forms[category] != "" {
+			cases = append(cases, pluralForms[category], forms[category])
+		}
+	}
+	if len(cases) == 0 {
+		return nil, nil
+	}
+
+	return plurals.New(cases...), nil
+}
+
+// languagePluralCategories returns the plural categories used by the language for integer numbers
+// in the canonical order. This order is used by gettext catalogs for the indexed plural forms.
+func languagePluralCategories(tag language.Tag) []string {
+	used := map[plural.Form]bool{}
+	for i := 0; i <= 1000; i++ {
+		used[plural.Cardinal.MatchPlural(tag, i, 0, 0, 0, 0)] = true
+	}
+
+	categories := make([]string, 0, len(pluralCategories))
+	for _, category := range pluralCategories {
+		if used[pluralForms[category]] {
+			categories = append(categories, category)
+		}
+	}
+
+	return categories
+}
+
+// newIndexedPluralMessage creates the plural message from the gettext-style indexed plural forms.
+// The indexes of the forms by the plural categories are returned by [pluralIndexes].
+// If the language has no "other" category for integer numbers, then the last form is used as "other" too.
+func newIndexedPluralMessage(tag language.Tag, indexes map[string]int, translations []string) (catalog.Message, error) {
+	categories := languagePluralCategories(tag)
+	if len(translations) != len(categories) {
+		return nil, fmt.Errorf(
+			"expected %d plural forms (%s) for language %s, got %d",
+			len(categories), strings.Join(categories, ", "), tag, len(translations),
+		)
+	}
+
+	forms := make(map[string]string, len(categories)+1)
+	for _, category := range categories {
+		forms[category] = translations[indexes[category]]
+	}
+	if _, exists := forms["other"]; !exists {
+		forms["other"] = translations[len(translations)-1]
+	}
+
+	return newPluralMessage(forms)
+}
+
+func (translator *Translator) checkTemplates(messages map[language.Tag]map[string]catalog.Message) error {
+	for tag, tagMessages := range messages {
+		for key := range tagMessages {
+			if _, exists := translator.templates[key]; !exists {
+				return fmt.Errorf(`%w "%s" for language %s`, ErrUnknownTemplate, key, tag)
+			}
+		}
+	}
+
+	return nil
+}
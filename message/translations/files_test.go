@@ -0,0 +1,319 @@
+package translations_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+const jsonTranslations = `{
+	"This value should not be blank.": "Значение не должно быть пустым.",
+	"This value is not valid.": "",
+	"This collection should contain {{ limit }} element(s) or less.": {
+		"=0": "Эта коллекция должна быть пустой.",
+		"one": "Эта коллекция должна содержать {{ limit }} элемент или меньше.",
+		"few": "Эта коллекция должна содержать {{ limit }} элемента или меньше.",
+		"other": "Эта коллекция должна содержать {{ limit }} элементов или меньше."
+	}
+}`
+
+const yamlTranslations = `
+"This value should not be blank.": "Значение не должно быть пустым."
+"This collection should contain {{ limit }} element(s) or less.":
+  one: "Эта коллекция должна содержать {{ limit }} элемент или меньше."
+  few: "Эта коллекция должна содержать {{ limit }} элемента или меньше."
+  other: "Эта коллекция должна содержать {{ limit }} элементов или меньше."
+`
+
+const poTranslations = `# Russian translations.
+msgid ""
+msgstr ""
+"Language: ru\n"
+"Plural-Forms: nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+msgid "This value should not be blank."
+msgstr "Значение не должно "
+"быть пустым."
+
+#, fuzzy
+msgid "This value is not valid."
+msgstr "Значение недопустимо?"
+
+msgid "This collection should contain {{ limit }} element(s) or less."
+msgid_plural "This collection should contain {{ limit }} element(s) or less."
+msgstr[0] "Эта коллекция должна содержать {{ limit }} элемент или меньше."
+msgstr[1] "Эта коллекция должна содержать {{ limit }} элемента или меньше."
+msgstr[2] "Эта коллекция должна содержать {{ limit }} элементов или меньше."
+
+#~ msgid "Obsolete message."
+#~ msgstr "Устаревшее сообщение."
+`
+
+const xliffTranslations = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+	<file source-language="en" target-language="ru" datatype="plaintext" original="validators.en.xlf">
+		<body>
+			<trans-unit id="1">
+				<source>This value should not be blank.</source>
+				<target>Значение не должно быть пустым.</target>
+			</trans-unit>
+			<trans-unit id="2" resname="This value is not valid.">
+				<source>Invalid value</source>
+				<target></target>
+			</trans-unit>
+			<group id="3" restype="x-gettext-plurals">
+				<trans-unit id="3[0]">
+					<source>This collection should contain {{ limit }} element(s) or less.</source>
+					<target>Эта коллекция должна содержать {{ limit }} элемент или меньше.</target>
+				</trans-unit>
+				<trans-unit id="3[1]">
+					<source>This collection should contain {{ limit }} element(s) or less.</source>
+					<target>Эта коллекция должна содержать {{ limit }} элемента или меньше.</target>
+				</trans-unit>
+				<trans-unit id="3[2]">
+					<source>This collection should contain {{ limit }} element(s) or less.</source>
+					<target>Эта коллекция должна содержать {{ limit }} элементов или меньше.</target>
+				</trans-unit>
+			</group>
+		</body>
+	</file>
+</xliff>`
+
+func TestLoadFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{name: "json", file: "translations/ru.json", content: jsonTranslations},
+		{name: "yaml", file: "translations/validators.ru.yaml", content: yamlTranslations},
+		{name: "po", file: "locale/validators.po", content: poTranslations},
+		{name: "xliff", file: "translations/validators.xlf", content: xliffTranslations},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fsys := fstest.MapFS{test.file: {Data: []byte(test.content)}}
+
+			translator, err := translations.NewTranslator(translations.LoadFiles(fsys, "*/*"))
+
+			require.NoError(t, err)
+			assert.Equal(t, "Значение не должно быть пустым.", translator.Translate(language.Russian, message.IsBlank, 0))
+			assert.Equal(t, message.NotValid, translator.Translate(language.Russian, message.NotValid, 0))
+			assert.Equal(t,
+				"Эта коллекция должна содержать {{ limit }} элемент или меньше.",
+				translator.Translate(language.Russian, message.TooManyElements, 21),
+			)
+			assert.Equal(t,
+				"Эта коллекция должна содержать {{ limit }} элемента или меньше.",
+				translator.Translate(language.Russian, message.TooManyElements, 3),
+			)
+			assert.Equal(t,
+				"Эта коллекция должна содержать {{ limit }} элементов или меньше.",
+				translator.Translate(language.Russian, message.TooManyElements, 11),
+			)
+		})
+	}
+}
+
+func TestLoadFiles_WhenPOPluralFormulaHasCustomOrder_ExpectFormsSelectedByFormula(t *testing.T) {
+	fsys := fstest.MapFS{"ru.po": {Data: []byte(`msgid ""
+msgstr ""
+"Plural-Forms: nplurals=3; plural=(n%10==1 && n%100!=11 ? 2 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 0 : 1);\n"
+
+msgid "This collection should contain {{ limit }} element(s) or less."
+msgid_plural "This collection should contain {{ limit }} element(s) or less."
+msgstr[0] "Эта коллекция должна содержать {{ limit }} элемента или меньше."
+msgstr[1] "Эта коллекция должна содержать {{ limit }} элементов или меньше."
+msgstr[2] "Эта коллекция должна содержать {{ limit }} элемент или меньше."
+`)}}
+
+	translator, err := translations.NewTranslator(translations.LoadFiles(fsys, "ru.po"))
+
+	require.NoError(t, err)
+	assert.Equal(t,
+		"Эта коллекция должна содержать {{ limit }} элемент или меньше.",
+		translator.Translate(language.Russian, message.TooManyElements, 21),
+	)
+	assert.Equal(t,
+		"Эта коллекция должна содержать {{ limit }} элемента или меньше.",
+		translator.Translate(language.Russian, message.TooManyElements, 3),
+	)
+	assert.Equal(t,
+		"Эта коллекция должна содержать {{ limit }} элементов или меньше.",
+		translator.Translate(language.Russian, message.TooManyElements, 11),
+	)
+}
+
+func TestLoadFiles_WhenExactPluralForm_ExpectExactMatchSelected(t *testing.T) {
+	fsys := fstest.MapFS{"ru.json": {Data: []byte(jsonTranslations)}}
+
+	translator, err := translations.NewTranslator(translations.LoadFiles(fsys, "ru.json"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Эта коллекция должна быть пустой.", translator.Translate(language.Russian, message.TooManyElements, 0))
+}
+
+func TestLoadFiles_WhenCustomTemplateIsSetBefore_ExpectTranslationLoaded(t *testing.T) {
+	fsys := fstest.MapFS{"ru.json": {Data: []byte(`{"Custom message.": "Сообщение."}`)}}
+
+	translator, err := translations.NewTranslator(
+		translations.SetTranslations(map[language.Tag]map[string]catalog.Message{
+			language.English: {"Custom message.": catalog.String("Custom message.")},
+		}),
+		translations.LoadFiles(fsys, "ru.json"),
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Сообщение.", translator.Translate(language.Russian, "Custom message.", 0))
+}
+
+func TestLoadFiles_WhenInvalidFile_ExpectError(t *testing.T) {
+	tests := []struct {
+		name          string
+		file          string
+		content       string
+		expectedError string
+		expectedIs    error
+	}{
+		{
+			name:    "unknown template",
+			file:    "ru.json",
+			content: `{"Unknown message.": "Неизвестное сообщение."}`,
+			expectedError: `load translations: unknown message template "Unknown message." ` +
+				`for language ru`,
+			expectedIs: translations.ErrUnknownTemplate,
+		},
+		{
+			name:          "unsupported format",
+			file:          "ru.txt",
+			content:       `text`,
+			expectedError: `load translations: read translation file "ru.txt": unsupported translation file format ".txt"`,
+			expectedIs:    translations.ErrUnsupportedFormat,
+		},
+		{
+			name:    "invalid json",
+			file:    "ru.json",
+			content: `{`,
+			expectedError: `load translations: read translation file "ru.json": ` +
+				`malformed translation: invalid JSON: unexpected EOF`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+		{
+			name:    "invalid json translation",
+			file:    "ru.json",
+			content: `{"This value should not be blank.": 1}`,
+			expectedError: `load translations: read translation file "ru.json": malformed translation: ` +
+				`message "This value should not be blank.": translation must be a string or an object of plural forms`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+		{
+			name:    "invalid yaml plural form",
+			file:    "ru.yaml",
+			content: "\"This value should not be blank.\":\n  single: \"Значение\"\n",
+			expectedError: `load translations: read translation file "ru.yaml": malformed translation: ` +
+				`message "This value should not be blank.": invalid plural form "single"`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+		{
+			name:    "unknown language",
+			file:    "messages.json",
+			content: `{}`,
+			expectedError: `load translations: read translation file "messages.json": ` +
+				`detect language "messages": language: tag is not well-formed`,
+		},
+		{
+			name: "unexpected po keyword",
+			file: "ru.po",
+			content: "msgid \"This value should not be blank.\"\n" +
+				"msgstr[0] \"Значение не должно быть пустым.\"\n",
+			expectedError: `load translations: read translation file "ru.po": ` +
+				`malformed translation: line 2: unexpected msgstr[0]`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+		{
+			name:    "unquoted po string",
+			file:    "ru.po",
+			content: "msgid This value should not be blank.\n",
+			expectedError: `load translations: read translation file "ru.po": ` +
+				`malformed translation: line 1: expected quoted string`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+		{
+			name: "po plural forms count mismatch",
+			file: "ru.po",
+			content: "msgid \"This collection should contain {{ limit }} element(s) or less.\"\n" +
+				"msgid_plural \"This collection should contain {{ limit }} element(s) or less.\"\n" +
+				"msgstr[0] \"Эта коллекция должна содержать {{ limit }} элемент или меньше.\"\n" +
+				"msgstr[1] \"Эта коллекция должна содержать {{ limit }} элементов или меньше.\"\n",
+			expectedError: `load translations: read translation file "ru.po": malformed translation: ` +
+				`message "This collection should contain {{ limit }} element(s) or less.": ` +
+				`expected 3 plural forms (one, few, many) for language ru, got 2`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+		{
+			name: "po plural forms header count mismatch",
+			file: "ru.po",
+			content: "msgid \"\"\n" +
+				"msgstr \"Plural-Forms: nplurals=2; plural=(n != 1);\\n\"\n\n" +
+				"msgid \"This collection should contain {{ limit }} element(s) or less.\"\n" +
+				"msgid_plural \"This collection should contain {{ limit }} element(s) or less.\"\n" +
+				"msgstr[0] \"Эта коллекция должна содержать {{ limit }} элемент или меньше.\"\n" +
+				"msgstr[1] \"Эта коллекция должна содержать {{ limit }} элементов или меньше.\"\n",
+			expectedError: `load translations: read translation file "ru.po": malformed translation: ` +
+				`"Plural-Forms" header declares 2 plural forms, but language ru has 3 (one, few, many)`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+		{
+			name: "po plural formula mismatch",
+			file: "ru.po",
+			content: "msgid \"\"\n" +
+				"msgstr \"Plural-Forms: nplurals=3; plural=(n==1 ? 0 : n==2 ? 1 : 2);\\n\"\n",
+			expectedError: `load translations: read translation file "ru.po": malformed translation: ` +
+				`"Plural-Forms" formula returns form 2 for 3, but form 1 is used for plural category "few" of language ru`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+		{
+			name: "invalid po plural formula",
+			file: "ru.po",
+			content: "msgid \"\"\n" +
+				"msgstr \"Plural-Forms: nplurals=3; plural=(n %% 10;\\n\"\n",
+			expectedError: `load translations: read translation file "ru.po": malformed translation: ` +
+				`invalid "Plural-Forms" header: plural formula: unexpected "%"`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+		{
+			name:    "unsupported xliff version",
+			file:    "ru.xlf",
+			content: `<xliff version="2.0" srcLang="en" trgLang="ru"></xliff>`,
+			expectedError: `load translations: read translation file "ru.xlf": ` +
+				`malformed translation: unsupported XLIFF version "2.0"`,
+			expectedIs: translations.ErrMalformedTranslation,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fsys := fstest.MapFS{test.file: {Data: []byte(test.content)}}
+
+			translator, err := translations.NewTranslator(translations.LoadFiles(fsys, test.file))
+
+			assert.Nil(t, translator)
+			assert.EqualError(t, err, test.expectedError)
+			if test.expectedIs != nil {
+				assert.ErrorIs(t, err, test.expectedIs)
+			}
+		})
+	}
+}
+
+func TestReadFiles_WhenPatternMatchesNoFiles_ExpectError(t *testing.T) {
+	messages, err := translations.ReadFiles(fstest.MapFS{}, "translations/*.po")
+
+	assert.Nil(t, messages)
+	assert.EqualError(t, err, `read translation files "translations/*.po": no files match the pattern`)
+}
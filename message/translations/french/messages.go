@@ -0,0 +1,105 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.fr.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package french contains violation message texts translated into French language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package french
+
+import (
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.French: {
+		message.NotBlank:          catalog.String("Cette valeur doit être vide."),
+		message.NotDivisible:      catalog.String("Cette valeur doit être un multiple de {{ comparedValue }}."),
+		message.NotDivisibleCount: catalog.String("Le nombre d'éléments de cette collection doit être un multiple de {{ divisibleBy }}."),
+		message.NotExactCount: plurals.New(
+			plural.One, "Cette collection doit contenir exactement {{ limit }} élément.",
+			plural.Other, "Cette collection doit contenir exactement {{ limit }} éléments."),
+		message.TooFewElements: plurals.New(
+			plural.One, "Cette collection doit contenir {{ limit }} élément ou plus.",
+			plural.Other, "Cette collection doit contenir {{ limit }} éléments ou plus."),
+		message.TooManyElements: plurals.New(
+			plural.One, "Cette collection doit contenir {{ limit }} élément ou moins.",
+			plural.Other, "Cette collection doit contenir {{ limit }} éléments ou moins."),
+		message.NotEqual:        catalog.String("Cette valeur doit être égale à {{ comparedValue }}."),
+		message.NotFalse:        catalog.String("Cette valeur doit être fausse."),
+		message.InvalidDate:     catalog.String("Cette valeur n'est pas une date valide."),
+		message.InvalidDateTime: catalog.String("Cette valeur n'est pas une date et une heure valides."),
+		message.InvalidEAN13:    catalog.String("Cette valeur n'est pas un code EAN-13 valide."),
+		message.InvalidEAN8:     catalog.String("Cette valeur n'est pas un code EAN-8 valide."),
+		message.InvalidEmail:    catalog.String("Cette valeur n'est pas une adresse email valide."),
+		message.InvalidHostname: catalog.String("Cette valeur n'est pas un nom d'hôte valide."),
+		message.InvalidIP:       catalog.String("Cette adresse IP n'est pas valide."),
+		message.InvalidJSON:     catalog.String("Cette valeur doit être un JSON valide."),
+		message.InvalidTime:     catalog.String("Cette valeur n'est pas une heure valide."),
+		message.InvalidULID:     catalog.String("Ceci n'est pas un ULID valide."),
+		message.InvalidUPCA:     catalog.String("Cette valeur n'est pas un code UPC-A valide."),
+		message.InvalidUPCE:     catalog.String("Cette valeur n'est pas un code UPC-E valide."),
+		message.InvalidURL:      catalog.String("Cette valeur n'est pas une URL valide."),
+		message.InvalidUUID:     catalog.String("Ceci n'est pas un UUID valide."),
+		message.NotExactLength: plurals.New(
+			plural.One, "Cette chaîne doit avoir exactement {{ limit }} caractère.",
+			plural.Other, "Cette chaîne doit avoir exactement {{ limit }} caractères."),
+		message.TooShort: plurals.New(
+			plural.One, "Cette chaîne est trop courte. Elle doit avoir au minimum {{ limit }} caractère.",
+			plural.Other, "Cette chaîne est trop courte. Elle doit avoir au minimum {{ limit }} caractères."),
+		message.TooLong: plurals.New(
+			plural.One, "Cette chaîne est trop longue. Elle doit avoir au maximum {{ limit }} caractère.",
+			plural.Other, "Cette chaîne est trop longue. Elle doit avoir au maximum {{ limit }} caractères."),
+		message.NotNil:            catalog.String("Cette valeur doit être nil."),
+		message.NoSuchChoice:      catalog.String("Cette valeur doit être l'un des choix proposés."),
+		message.IsBlank:           catalog.String("Cette valeur ne doit pas être vide."),
+		message.IsEqual:           catalog.String("Cette valeur ne doit pas être égale à {{ comparedValue }}."),
+		message.NotInRange:        catalog.String("Cette valeur doit être comprise entre {{ min }} et {{ max }}."),
+		message.NotInteger:        catalog.String("Cette valeur n'est pas un nombre entier."),
+		message.NotNegative:       catalog.String("Cette valeur doit être négative."),
+		message.NotNegativeOrZero: catalog.String("Cette valeur doit être négative ou égale à zéro."),
+		message.IsNil:             catalog.String("Cette valeur ne doit pas être nil."),
+		message.NotNumeric:        catalog.String("Cette valeur n'est pas numérique."),
+		message.NotPositive:       catalog.String("Cette valeur doit être positive."),
+		message.NotPositiveOrZero: catalog.String("Cette valeur doit être positive ou égale à zéro."),
+		message.NotUnique:         catalog.String("Cette collection ne doit contenir que des éléments uniques."),
+		message.NotValid:          catalog.String("Cette valeur n'est pas valide."),
+		message.ProhibitedIP:      catalog.String("L'utilisation de cette adresse IP est interdite."),
+		message.ProhibitedURL:     catalog.String("L'utilisation de cette URL est interdite."),
+		message.TooEarly:          catalog.String("Cette valeur doit être postérieure à {{ comparedValue }}."),
+		message.TooEarlyOrEqual:   catalog.String("Cette valeur doit être postérieure ou égale à {{ comparedValue }}."),
+		message.TooHigh:           catalog.String("Cette valeur doit être inférieure à {{ comparedValue }}."),
+		message.TooHighOrEqual:    catalog.String("Cette valeur doit être inférieure ou égale à {{ comparedValue }}."),
+		message.TooLate:           catalog.String("Cette valeur doit être antérieure à {{ comparedValue }}."),
+		message.TooLateOrEqual:    catalog.String("Cette valeur doit être antérieure ou égale à {{ comparedValue }}."),
+		message.TooLow:            catalog.String("Cette valeur doit être supérieure à {{ comparedValue }}."),
+		message.TooLowOrEqual:     catalog.String("Cette valeur doit être supérieure ou égale à {{ comparedValue }}."),
+		message.NotTrue:           catalog.String("Cette valeur doit être vraie."),
+		message.InvalidType:       catalog.String("Cette valeur doit être de type {{ type }}."),
+		message.MissingProperty:   catalog.String("Ce champ est manquant."),
+		message.UnknownProperty:   catalog.String("Ce champ n'a pas été prévu."),
+		message.NotSatisfied:      catalog.String("Cette valeur doit satisfaire l'expression {{ expression }}."),
+	},
+}
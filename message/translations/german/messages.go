@@ -0,0 +1,105 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.de.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package german contains violation message texts translated into German language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package german
+
+import (
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.German: {
+		message.NotBlank:          catalog.String("Dieser Wert sollte leer sein."),
+		message.NotDivisible:      catalog.String("Dieser Wert sollte ein Vielfaches von {{ comparedValue }} sein."),
+		message.NotDivisibleCount: catalog.String("Die Anzahl an Elementen in dieser Sammlung sollte ein Vielfaches von {{ divisibleBy }} sein."),
+		message.NotExactCount: plurals.New(
+			plural.One, "Diese Sammlung sollte genau {{ limit }} Element enthalten.",
+			plural.Other, "Diese Sammlung sollte genau {{ limit }} Elemente enthalten."),
+		message.TooFewElements: plurals.New(
+			plural.One, "Diese Sammlung sollte {{ limit }} oder mehr Element enthalten.",
+			plural.Other, "Diese Sammlung sollte {{ limit }} oder mehr Elemente enthalten."),
+		message.TooManyElements: plurals.New(
+			plural.One, "Diese Sammlung sollte {{ limit }} oder weniger Element enthalten.",
+			plural.Other, "Diese Sammlung sollte {{ limit }} oder weniger Elemente enthalten."),
+		message.NotEqual:        catalog.String("Dieser Wert sollte gleich {{ comparedValue }} sein."),
+		message.NotFalse:        catalog.String("Dieser Wert sollte false sein."),
+		message.InvalidDate:     catalog.String("Dieser Wert entspricht keiner gültigen Datumsangabe."),
+		message.InvalidDateTime: catalog.String("Dieser Wert entspricht keiner gültigen Datums- und Zeitangabe."),
+		message.InvalidEAN13:    catalog.String("Dieser Wert ist keine gültige EAN-13."),
+		message.InvalidEAN8:     catalog.String("Dieser Wert ist keine gültige EAN-8."),
+		message.InvalidEmail:    catalog.String("Dieser Wert ist keine gültige E-Mail-Adresse."),
+		message.InvalidHostname: catalog.String("Dieser Wert ist kein gültiger Hostname."),
+		message.InvalidIP:       catalog.String("Dies ist keine gültige IP-Adresse."),
+		message.InvalidJSON:     catalog.String("Dieser Wert sollte gültiges JSON sein."),
+		message.InvalidTime:     catalog.String("Dieser Wert entspricht keiner gültigen Zeitangabe."),
+		message.InvalidULID:     catalog.String("Dies ist keine gültige ULID."),
+		message.InvalidUPCA:     catalog.String("Dieser Wert ist keine gültige UPC-A."),
+		message.InvalidUPCE:     catalog.String("Dieser Wert ist keine gültige UPC-E."),
+		message.InvalidURL:      catalog.String("Dieser Wert ist keine gültige URL."),
+		message.InvalidUUID:     catalog.String("Dies ist keine gültige UUID."),
+		message.NotExactLength: plurals.New(
+			plural.One, "Dieser Wert sollte genau {{ limit }} Zeichen lang sein.",
+			plural.Other, "Dieser Wert sollte genau {{ limit }} Zeichen lang sein."),
+		message.TooShort: plurals.New(
+			plural.One, "Diese Zeichenkette ist zu kurz. Sie sollte mindestens {{ limit }} Zeichen haben.",
+			plural.Other, "Diese Zeichenkette ist zu kurz. Sie sollte mindestens {{ limit }} Zeichen haben."),
+		message.TooLong: plurals.New(
+			plural.One, "Diese Zeichenkette ist zu lang. Sie sollte höchstens {{ limit }} Zeichen haben.",
+			plural.Other, "Diese Zeichenkette ist zu lang. Sie sollte höchstens {{ limit }} Zeichen haben."),
+		message.NotNil:            catalog.String("Dieser Wert sollte nil sein."),
+		message.NoSuchChoice:      catalog.String("Sie haben einen ungültigen Wert ausgewählt."),
+		message.IsBlank:           catalog.String("Dieser Wert sollte nicht leer sein."),
+		message.IsEqual:           catalog.String("Dieser Wert sollte nicht gleich {{ comparedValue }} sein."),
+		message.NotInRange:        catalog.String("Dieser Wert sollte zwischen {{ min }} und {{ max }} liegen."),
+		message.NotInteger:        catalog.String("Dieser Wert ist keine ganze Zahl."),
+		message.NotNegative:       catalog.String("Dieser Wert sollte negativ sein."),
+		message.NotNegativeOrZero: catalog.String("Dieser Wert sollte kleiner oder gleich Null sein."),
+		message.IsNil:             catalog.String("Dieser Wert sollte nicht nil sein."),
+		message.NotNumeric:        catalog.String("Dieser Wert ist keine Zahl."),
+		message.NotPositive:       catalog.String("Dieser Wert sollte positiv sein."),
+		message.NotPositiveOrZero: catalog.String("Dieser Wert sollte größer oder gleich Null sein."),
+		message.NotUnique:         catalog.String("Diese Sammlung darf nur eindeutige Elemente enthalten."),
+		message.NotValid:          catalog.String("Dieser Wert ist ungültig."),
+		message.ProhibitedIP:      catalog.String("Die Verwendung dieser IP-Adresse ist nicht erlaubt."),
+		message.ProhibitedURL:     catalog.String("Die Verwendung dieser URL ist nicht erlaubt."),
+		message.TooEarly:          catalog.String("Dieser Wert sollte später als {{ comparedValue }} sein."),
+		message.TooEarlyOrEqual:   catalog.String("Dieser Wert sollte später als oder gleich {{ comparedValue }} sein."),
+		message.TooHigh:           catalog.String("Dieser Wert sollte kleiner als {{ comparedValue }} sein."),
+		message.TooHighOrEqual:    catalog.String("Dieser Wert sollte kleiner oder gleich {{ comparedValue }} sein."),
+		message.TooLate:           catalog.String("Dieser Wert sollte früher als {{ comparedValue }} sein."),
+		message.TooLateOrEqual:    catalog.String("Dieser Wert sollte früher als oder gleich {{ comparedValue }} sein."),
+		message.TooLow:            catalog.String("Dieser Wert sollte größer als {{ comparedValue }} sein."),
+		message.TooLowOrEqual:     catalog.String("Dieser Wert sollte größer oder gleich {{ comparedValue }} sein."),
+		message.NotTrue:           catalog.String("Dieser Wert sollte true sein."),
+		message.InvalidType:       catalog.String("Dieser Wert sollte vom Typ {{ type }} sein."),
+		message.MissingProperty:   catalog.String("Dieses Feld fehlt."),
+		message.UnknownProperty:   catalog.String("Dieses Feld wurde nicht erwartet."),
+		message.NotSatisfied:      catalog.String("Dieser Wert sollte den Ausdruck {{ expression }} erfüllen."),
+	},
+}
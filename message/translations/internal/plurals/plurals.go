@@ -0,0 +1,76 @@
+// Package plurals contains the catalog message selecting the translation by the plural forms.
+// Unlike the message created by [plural.Selectf], it keeps the translations by the forms, so they can be checked.
+package plurals
+
+import (
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/message/catalog"
+)
+
+// Categories are the plural forms of the CLDR in the canonical order.
+var Categories = []string{"zero", "one", "two", "few", "many", "other"}
+
+// Forms maps the names of the plural forms to the values of the [plural.Form].
+var Forms = map[string]plural.Form{
+	"zero":  plural.Zero,
+	"one":   plural.One,
+	"two":   plural.Two,
+	"few":   plural.Few,
+	"many":  plural.Many,
+	"other": plural.Other,
+}
+
+// Message selects the translation by the plural form of the first argument (the plural count).
+type Message struct {
+	catalog.Message
+	forms map[string]string
+}
+
+// New creates the message from the cases in the same way as [plural.Selectf]: the selector
+// ([plural.Form] or the exact value like "=0") is followed by the translation.
+func New(cases ...any) *Message {
+	return &Message{
+		Message: plural.Selectf(1, "", cases...),
+		forms:   casesForms(cases),
+	}
+}
+
+// Forms returns the translations by the names of the plural forms or by the exact values (e.g. "=0").
+// It returns nil if the cases are not the pairs of selectors and strings.
+func (m *Message) Forms() map[string]string {
+	return m.forms
+}
+
+func casesForms(cases []any) map[string]string {
+	if len(cases)%2 != 0 {
+		return nil
+	}
+
+	forms := make(map[string]string, len(cases)/2)
+	for i := 0; i < len(cases); i += 2 {
+		text, ok := cases[i+1].(string)
+		if !ok {
+			return nil
+		}
+		switch selector := cases[i].(type) {
+		case string:
+			forms[selector] = text
+		case plural.Form:
+			forms[formName(selector)] = text
+		default:
+			return nil
+		}
+	}
+
+	return forms
+}
+
+func formName(form plural.Form) string {
+	for name, f := range Forms {
+		if f == form {
+			return name
+		}
+	}
+
+	return "other"
+}
@@ -0,0 +1,105 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.it.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package italian contains violation message texts translated into Italian language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package italian
+
+import (
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.Italian: {
+		message.NotBlank:          catalog.String("Questo valore dovrebbe essere vuoto."),
+		message.NotDivisible:      catalog.String("Questo valore dovrebbe essere un multiplo di {{ comparedValue }}."),
+		message.NotDivisibleCount: catalog.String("Il numero di elementi in questa collezione dovrebbe essere un multiplo di {{ divisibleBy }}."),
+		message.NotExactCount: plurals.New(
+			plural.One, "Questa collezione dovrebbe contenere esattamente {{ limit }} elemento.",
+			plural.Other, "Questa collezione dovrebbe contenere esattamente {{ limit }} elementi."),
+		message.TooFewElements: plurals.New(
+			plural.One, "Questa collezione dovrebbe contenere {{ limit }} elemento o più.",
+			plural.Other, "Questa collezione dovrebbe contenere {{ limit }} elementi o più."),
+		message.TooManyElements: plurals.New(
+			plural.One, "Questa collezione dovrebbe contenere {{ limit }} elemento o meno.",
+			plural.Other, "Questa collezione dovrebbe contenere {{ limit }} elementi o meno."),
+		message.NotEqual:        catalog.String("Questo valore dovrebbe essere uguale a {{ comparedValue }}."),
+		message.NotFalse:        catalog.String("Questo valore dovrebbe essere falso."),
+		message.InvalidDate:     catalog.String("Questo valore non è una data valida."),
+		message.InvalidDateTime: catalog.String("Questo valore non è una data e ora valida."),
+		message.InvalidEAN13:    catalog.String("Questo valore non è un EAN-13 valido."),
+		message.InvalidEAN8:     catalog.String("Questo valore non è un EAN-8 valido."),
+		message.InvalidEmail:    catalog.String("Questo valore non è un indirizzo email valido."),
+		message.InvalidHostname: catalog.String("Questo valore non è un nome di host valido."),
+		message.InvalidIP:       catalog.String("Questo valore non è un indirizzo IP valido."),
+		message.InvalidJSON:     catalog.String("Questo valore dovrebbe essere un JSON valido."),
+		message.InvalidTime:     catalog.String("Questo valore non è un'ora valida."),
+		message.InvalidULID:     catalog.String("Questo non è un ULID valido."),
+		message.InvalidUPCA:     catalog.String("Questo valore non è un UPC-A valido."),
+		message.InvalidUPCE:     catalog.String("Questo valore non è un UPC-E valido."),
+		message.InvalidURL:      catalog.String("Questo valore non è un URL valido."),
+		message.InvalidUUID:     catalog.String("Questo non è un UUID valido."),
+		message.NotExactLength: plurals.New(
+			plural.One, "Questo valore dovrebbe contenere esattamente {{ limit }} carattere.",
+			plural.Other, "Questo valore dovrebbe contenere esattamente {{ limit }} caratteri."),
+		message.TooShort: plurals.New(
+			plural.One, "Questo valore è troppo corto. Dovrebbe contenere {{ limit }} carattere o più.",
+			plural.Other, "Questo valore è troppo corto. Dovrebbe contenere {{ limit }} caratteri o più."),
+		message.TooLong: plurals.New(
+			plural.One, "Questo valore è troppo lungo. Dovrebbe contenere {{ limit }} carattere o meno.",
+			plural.Other, "Questo valore è troppo lungo. Dovrebbe contenere {{ limit }} caratteri o meno."),
+		message.NotNil:            catalog.String("Questo valore dovrebbe essere nil."),
+		message.NoSuchChoice:      catalog.String("Questo valore dovrebbe essere una delle opzioni disponibili."),
+		message.IsBlank:           catalog.String("Questo valore non dovrebbe essere vuoto."),
+		message.IsEqual:           catalog.String("Questo valore non dovrebbe essere uguale a {{ comparedValue }}."),
+		message.NotInRange:        catalog.String("Questo valore dovrebbe essere compreso tra {{ min }} e {{ max }}."),
+		message.NotInteger:        catalog.String("Questo valore non è un numero intero."),
+		message.NotNegative:       catalog.String("Questo valore dovrebbe essere negativo."),
+		message.NotNegativeOrZero: catalog.String("Questo valore dovrebbe essere negativo oppure zero."),
+		message.IsNil:             catalog.String("Questo valore non dovrebbe essere nil."),
+		message.NotNumeric:        catalog.String("Questo valore non è numerico."),
+		message.NotPositive:       catalog.String("Questo valore dovrebbe essere positivo."),
+		message.NotPositiveOrZero: catalog.String("Questo valore dovrebbe essere positivo oppure zero."),
+		message.NotUnique:         catalog.String("Questa collezione dovrebbe contenere solo elementi unici."),
+		message.NotValid:          catalog.String("Questo valore non è valido."),
+		message.ProhibitedIP:      catalog.String("L'uso di questo indirizzo IP è vietato."),
+		message.ProhibitedURL:     catalog.String("L'uso di questo URL è vietato."),
+		message.TooEarly:          catalog.String("Questo valore dovrebbe essere successivo a {{ comparedValue }}."),
+		message.TooEarlyOrEqual:   catalog.String("Questo valore dovrebbe essere successivo o uguale a {{ comparedValue }}."),
+		message.TooHigh:           catalog.String("Questo valore dovrebbe essere minore di {{ comparedValue }}."),
+		message.TooHighOrEqual:    catalog.String("Questo valore dovrebbe essere minore o uguale a {{ comparedValue }}."),
+		message.TooLate:           catalog.String("Questo valore dovrebbe essere precedente a {{ comparedValue }}."),
+		message.TooLateOrEqual:    catalog.String("Questo valore dovrebbe essere precedente o uguale a {{ comparedValue }}."),
+		message.TooLow:            catalog.String("Questo valore dovrebbe essere maggiore di {{ comparedValue }}."),
+		message.TooLowOrEqual:     catalog.String("Questo valore dovrebbe essere maggiore o uguale a {{ comparedValue }}."),
+		message.NotTrue:           catalog.String("Questo valore dovrebbe essere vero."),
+		message.InvalidType:       catalog.String("Questo valore dovrebbe essere di tipo {{ type }}."),
+		message.MissingProperty:   catalog.String("Questo campo è mancante."),
+		message.UnknownProperty:   catalog.String("Questo campo non è stato previsto."),
+		message.NotSatisfied:      catalog.String("Questo valore dovrebbe soddisfare l'espressione {{ expression }}."),
+	},
+}
@@ -0,0 +1,91 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.ja.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package japanese contains violation message texts translated into Japanese language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package japanese
+
+import (
+	"github.com/muonsoft/validation/message"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.Japanese: {
+		message.NotBlank:          catalog.String("値は空でなければなりません。"),
+		message.NotDivisible:      catalog.String("値は {{ comparedValue }} の倍数でなければなりません。"),
+		message.NotDivisibleCount: catalog.String("このコレクションの要素数は {{ divisibleBy }} の倍数でなければなりません。"),
+		message.NotExactCount:     catalog.String("このコレクションはちょうど {{ limit }} 個の要素を含まなければなりません。"),
+		message.TooFewElements:    catalog.String("このコレクションは {{ limit }} 個以上の要素を含まなければなりません。"),
+		message.TooManyElements:   catalog.String("このコレクションは {{ limit }} 個以下の要素を含まなければなりません。"),
+		message.NotEqual:          catalog.String("値は {{ comparedValue }} と等しくなければなりません。"),
+		message.NotFalse:          catalog.String("値は false でなければなりません。"),
+		message.InvalidDate:       catalog.String("有効な日付ではありません。"),
+		message.InvalidDateTime:   catalog.String("有効な日時ではありません。"),
+		message.InvalidEAN13:      catalog.String("有効な EAN-13 ではありません。"),
+		message.InvalidEAN8:       catalog.String("有効な EAN-8 ではありません。"),
+		message.InvalidEmail:      catalog.String("有効なメールアドレスではありません。"),
+		message.InvalidHostname:   catalog.String("有効なホスト名ではありません。"),
+		message.InvalidIP:         catalog.String("有効な IP アドレスではありません。"),
+		message.InvalidJSON:       catalog.String("値は有効な JSON でなければなりません。"),
+		message.InvalidTime:       catalog.String("有効な時刻ではありません。"),
+		message.InvalidULID:       catalog.String("有効な ULID ではありません。"),
+		message.InvalidUPCA:       catalog.String("有効な UPC-A ではありません。"),
+		message.InvalidUPCE:       catalog.String("有効な UPC-E ではありません。"),
+		message.InvalidURL:        catalog.String("有効な URL ではありません。"),
+		message.InvalidUUID:       catalog.String("有効な UUID ではありません。"),
+		message.NotExactLength:    catalog.String("値はちょうど {{ limit }} 文字でなければなりません。"),
+		message.TooShort:          catalog.String("値が短すぎます。{{ limit }} 文字以上でなければなりません。"),
+		message.TooLong:           catalog.String("値が長すぎます。{{ limit }} 文字以下でなければなりません。"),
+		message.NotNil:            catalog.String("値は nil でなければなりません。"),
+		message.NoSuchChoice:      catalog.String("選択された値は有効な選択肢ではありません。"),
+		message.IsBlank:           catalog.String("値は空であってはなりません。"),
+		message.IsEqual:           catalog.String("値は {{ comparedValue }} と等しくてはなりません。"),
+		message.NotInRange:        catalog.String("値は {{ min }} から {{ max }} の間でなければなりません。"),
+		message.NotInteger:        catalog.String("値は整数ではありません。"),
+		message.NotNegative:       catalog.String("値は負の数でなければなりません。"),
+		message.NotNegativeOrZero: catalog.String("値は負の数またはゼロでなければなりません。"),
+		message.IsNil:             catalog.String("値は nil であってはなりません。"),
+		message.NotNumeric:        catalog.String("値は数値ではありません。"),
+		message.NotPositive:       catalog.String("値は正の数でなければなりません。"),
+		message.NotPositiveOrZero: catalog.String("値は正の数またはゼロでなければなりません。"),
+		message.NotUnique:         catalog.String("このコレクションは一意の要素のみを含まなければなりません。"),
+		message.NotValid:          catalog.String("値が無効です。"),
+		message.ProhibitedIP:      catalog.String("この IP アドレスの使用は禁止されています。"),
+		message.ProhibitedURL:     catalog.String("この URL の使用は禁止されています。"),
+		message.TooEarly:          catalog.String("値は {{ comparedValue }} より後でなければなりません。"),
+		message.TooEarlyOrEqual:   catalog.String("値は {{ comparedValue }} 以降でなければなりません。"),
+		message.TooHigh:           catalog.String("値は {{ comparedValue }} 未満でなければなりません。"),
+		message.TooHighOrEqual:    catalog.String("値は {{ comparedValue }} 以下でなければなりません。"),
+		message.TooLate:           catalog.String("値は {{ comparedValue }} より前でなければなりません。"),
+		message.TooLateOrEqual:    catalog.String("値は {{ comparedValue }} 以前でなければなりません。"),
+		message.TooLow:            catalog.String("値は {{ comparedValue }} より大きくなければなりません。"),
+		message.TooLowOrEqual:     catalog.String("値は {{ comparedValue }} 以上でなければなりません。"),
+		message.NotTrue:           catalog.String("値は true でなければなりません。"),
+		message.InvalidType:       catalog.String("値は {{ type }} 型でなければなりません。"),
+		message.MissingProperty:   catalog.String("このフィールドは欠落しています。"),
+		message.UnknownProperty:   catalog.String("このフィールドは予期されていませんでした。"),
+		message.NotSatisfied:      catalog.String("この値は式 {{ expression }} を満たす必要があります。"),
+	},
+}
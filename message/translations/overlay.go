@@ -0,0 +1,82 @@
+package translations
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+// Overlay is a set of translations that takes precedence over the translations of the base translator
+// (e.g. custom wording of messages for a tenant). Unlike the [Translator], it has no built-in messages
+// and no default language, so it is able to report that the message has no translation
+// (see [Overlay.Lookup]). The overlay is immutable and can be created once and used concurrently.
+//
+// Use the [github.com/muonsoft/validation.ContextWithTranslator] function or
+// the [github.com/muonsoft/validation.Validator.WithTranslator] method to apply the overlay to the validation.
+type Overlay struct {
+	translated map[language.Tag]map[string]struct{}
+	printers   map[language.Tag]*message.Printer
+	languages  []language.Tag
+	matcher    language.Matcher
+}
+
+// NewOverlay creates an [Overlay] of the translation messages.
+func NewOverlay(messages map[language.Tag]map[string]catalog.Message) (*Overlay, error) {
+	builder := catalog.NewBuilder()
+	overlay := &Overlay{
+		translated: make(map[language.Tag]map[string]struct{}, len(messages)),
+		printers:   make(map[language.Tag]*message.Printer, len(messages)),
+	}
+
+	for tag, tagMessages := range messages {
+		overlay.translated[tag] = make(map[string]struct{}, len(tagMessages))
+		for key, msg := range tagMessages {
+			err := builder.Set(tag, key, msg)
+			if err != nil {
+				return nil, fmt.Errorf(`load overlay translations: set message "%s" for language %s: %w`, key, tag, err)
+			}
+			overlay.translated[tag][key] = struct{}{}
+		}
+	}
+	for _, tag := range builder.Languages() {
+		overlay.printers[tag] = message.NewPrinter(tag, message.Catalog(builder))
+		overlay.languages = append(overlay.languages, tag)
+	}
+	overlay.matcher = language.NewMatcher(overlay.languages)
+
+	return overlay, nil
+}
+
+// Translate translates the message into the language. If the overlay has no translation
+// of the message, then the message is returned as is.
+func (overlay *Overlay) Translate(tag language.Tag, message string, pluralCount int) string {
+	translated, _, found := overlay.Lookup(tag, message, pluralCount)
+	if !found {
+		return message
+	}
+
+	return translated
+}
+
+// Lookup translates the message into the language and returns the language that was used for translation.
+// The message is translated if the overlay has the translation into the language or into the loaded
+// language that is the best match for it (e.g. "en" for "en-GB"). Otherwise, it returns false.
+func (overlay *Overlay) Lookup(tag language.Tag, message string, pluralCount int) (string, language.Tag, bool) {
+	if len(overlay.languages) == 0 {
+		return "", language.Und, false
+	}
+
+	candidates := []language.Tag{tag}
+	if _, index, confidence := overlay.matcher.Match(tag); confidence >= language.High {
+		candidates = append(candidates, overlay.languages[index])
+	}
+	for _, candidate := range candidates {
+		if _, exists := overlay.translated[candidate][message]; exists {
+			return overlay.printers[candidate].Sprintf(message, pluralCount), candidate, true
+		}
+	}
+
+	return "", language.Und, false
+}
@@ -0,0 +1,89 @@
+package translations_test
+
+import (
+	"testing"
+
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestOverlay_Lookup(t *testing.T) {
+	overlay, err := translations.NewOverlay(map[language.Tag]map[string]catalog.Message{
+		language.English: {message.IsBlank: catalog.String("Please fill in this field.")},
+		language.Russian: {
+			message.TooManyElements: plural.Selectf(1, "",
+				plural.One, "Выберите не более {{ limit }} варианта.",
+				plural.Other, "Выберите не более {{ limit }} вариантов."),
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		tag              language.Tag
+		message          string
+		pluralCount      int
+		expectedMessage  string
+		expectedLanguage language.Tag
+		expectedFound    bool
+	}{
+		{
+			name:             "exact language",
+			tag:              language.English,
+			message:          message.IsBlank,
+			expectedMessage:  "Please fill in this field.",
+			expectedLanguage: language.English,
+			expectedFound:    true,
+		},
+		{
+			name:             "matched language",
+			tag:              language.BritishEnglish,
+			message:          message.IsBlank,
+			expectedMessage:  "Please fill in this field.",
+			expectedLanguage: language.English,
+			expectedFound:    true,
+		},
+		{
+			name:             "plural form",
+			tag:              language.Russian,
+			message:          message.TooManyElements,
+			pluralCount:      21,
+			expectedMessage:  "Выберите не более {{ limit }} варианта.",
+			expectedLanguage: language.Russian,
+			expectedFound:    true,
+		},
+		{
+			name:             "message not translated",
+			tag:              language.Russian,
+			message:          message.IsBlank,
+			expectedLanguage: language.Und,
+		},
+		{
+			name:             "language not loaded",
+			tag:              language.German,
+			message:          message.IsBlank,
+			expectedLanguage: language.Und,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			translated, used, found := overlay.Lookup(test.tag, test.message, test.pluralCount)
+
+			assert.Equal(t, test.expectedMessage, translated)
+			assert.Equal(t, test.expectedLanguage, used)
+			assert.Equal(t, test.expectedFound, found)
+		})
+	}
+}
+
+func TestOverlay_Translate_WhenMessageNotTranslated_ExpectMessageAsIs(t *testing.T) {
+	overlay, err := translations.NewOverlay(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, message.IsBlank, overlay.Translate(language.English, message.IsBlank, 0))
+}
@@ -0,0 +1,345 @@
+package translations
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// maxPluralNumber is the maximum number used to compare the gettext plural formula
+// with the CLDR plural rules of the language.
+const maxPluralNumber = 1000
+
+// pluralIndexes returns the indexes of the gettext plural forms "msgstr[N]" by the CLDR plural categories
+// of the language. The indexes are calculated from the "Plural-Forms" header of the gettext catalog
+// (e.g. "nplurals=2; plural=(n != 1);"). If the header is empty, the forms follow the order of the CLDR categories.
+//
+// An error is returned if the number of the forms or the formula do not match the CLDR plural rules
+// of the language, because the translations would be shown for the wrong numbers.
+func pluralIndexes(tag language.Tag, header string) (map[string]int, error) {
+	categories := languagePluralCategories(tag)
+	indexes := make(map[string]int, len(categories))
+	if header == "" {
+		for i, category := range categories {
+			indexes[category] = i
+		}
+		return indexes, nil
+	}
+
+	count, formula, err := parsePluralForms(header)
+	if err != nil {
+		return nil, fmt.Errorf(`invalid "Plural-Forms" header: %w`, err)
+	}
+	if count != len(categories) {
+		return nil, fmt.Errorf(
+			`"Plural-Forms" header declares %d plural forms, but language %s has %d (%s)`,
+			count, tag, len(categories), strings.Join(categories, ", "),
+		)
+	}
+	if formula == nil {
+		for i, category := range categories {
+			indexes[category] = i
+		}
+		return indexes, nil
+	}
+
+	categoryByIndex := make(map[int]string, count)
+	for n := 0; n <= maxPluralNumber; n++ {
+		category := pluralCategory(plural.Cardinal.MatchPlural(tag, n, 0, 0, 0, 0))
+		index := formula(n)
+		if index < 0 || index >= count {
+			return nil, fmt.Errorf(`"Plural-Forms" formula returns form %d for %d, expected 0..%d`, index, n, count-1)
+		}
+		expected, exists := indexes[category]
+		if !exists {
+			if other, isUsed := categoryByIndex[index]; isUsed {
+				return nil, fmt.Errorf(
+					`"Plural-Forms" formula uses form %d for plural categories "%s" and "%s" of language %s`,
+					index, other, category, tag,
+				)
+			}
+			indexes[category] = index
+			categoryByIndex[index] = category
+		} else if expected != index {
+			return nil, fmt.Errorf(
+				`"Plural-Forms" formula returns form %d for %d, but form %d is used for plural category "%s" of language %s`,
+				index, n, expected, category, tag,
+			)
+		}
+	}
+
+	return indexes, nil
+}
+
+func pluralCategory(form plural.Form) string {
+	for category, f := range pluralForms {
+		if f == form {
+			return category
+		}
+	}
+
+	return "other"
+}
+
+// parsePluralForms parses the value of the "Plural-Forms" header. The formula is nil
+// if the header declares only the number of the forms.
+func parsePluralForms(header string) (int, func(n int) int, error) {
+	count := -1
+	var formula func(n int) int
+	for _, part := range strings.Split(header, ";") {
+		name, value, found := strings.Cut(part, "=")
+		name = strings.TrimSpace(name)
+		if !found {
+			if name != "" {
+				return 0, nil, fmt.Errorf(`unexpected "%s"`, name)
+			}
+			continue
+		}
+		switch name {
+		case "nplurals":
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || n < 1 {
+				return 0, nil, fmt.Errorf(`invalid nplurals "%s"`, strings.TrimSpace(value))
+			}
+			count = n
+		case "plural":
+			f, err := parsePluralFormula(value)
+			if err != nil {
+				return 0, nil, fmt.Errorf("plural formula: %w", err)
+			}
+			formula = f
+		default:
+			return 0, nil, fmt.Errorf(`unknown parameter "%s"`, name)
+		}
+	}
+	if count < 0 {
+		return 0, nil, errors.New("nplurals is missing")
+	}
+
+	return count, formula, nil
+}
+
+// parsePluralFormula parses the C expression of the gettext plural formula (e.g. "(n != 1)").
+func parsePluralFormula(formula string) (func(n int) int, error) {
+	p := &formulaParser{tokens: tokenizeFormula(formula)}
+	f, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf(`unexpected "%s"`, p.tokens[p.pos])
+	}
+
+	return f, nil
+}
+
+func tokenizeFormula(formula string) []string {
+	tokens := make([]string, 0, len(formula))
+	for i := 0; i < len(formula); {
+		c := formula[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(formula) && formula[j] >= '0' && formula[j] <= '9' {
+				j++
+			}
+			tokens = append(tokens, formula[i:j])
+			i = j
+		case i+1 < len(formula) && isOneOf(formula[i:i+2], []string{"==", "!=", "<=", ">=", "&&", "||"}):
+			tokens = append(tokens, formula[i:i+2])
+			i += 2
+		default:
+			tokens = append(tokens, formula[i:i+1])
+			i++
+		}
+	}
+
+	return tokens
+}
+
+// formulaParser is a recursive descent parser of the gettext plural formulas. The operators
+// have the precedence of the C language.
+type formulaParser struct {
+	tokens []string
+	pos    int
+}
+
+type formulaFunc = func(n int) int
+
+// binaryOperators are the binary operators grouped by the precedence from the lowest to the highest.
+var binaryOperators = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", ">", "<=", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *formulaParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ""
+}
+
+func (p *formulaParser) expect(token string) error {
+	if p.peek() != token {
+		return fmt.Errorf(`expected "%s"`, token)
+	}
+	p.pos++
+
+	return nil
+}
+
+func (p *formulaParser) parseTernary() (formulaFunc, error) {
+	condition, err := p.parseBinary(0)
+	if err != nil || p.peek() != "?" {
+		return condition, err
+	}
+	p.pos++
+	then, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(n int) int {
+		if condition(n) != 0 {
+			return then(n)
+		}
+		return otherwise(n)
+	}, nil
+}
+
+func (p *formulaParser) parseBinary(level int) (formulaFunc, error) {
+	if level == len(binaryOperators) {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for isOneOf(p.peek(), binaryOperators[level]) {
+		operator := p.peek()
+		p.pos++
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = binaryFunc(operator, left, right)
+	}
+
+	return left, nil
+}
+
+func (p *formulaParser) parseUnary() (formulaFunc, error) {
+	token := p.peek()
+	switch {
+	case token == "!":
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(n int) int { return boolToInt(operand(n) == 0) }, nil
+	case token == "(":
+		p.pos++
+		f, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case token == "n":
+		p.pos++
+		return func(n int) int { return n }, nil
+	case token != "" && token[0] >= '0' && token[0] <= '9':
+		p.pos++
+		value, err := strconv.Atoi(token)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid number "%s"`, token)
+		}
+		return func(int) int { return value }, nil
+	case token == "":
+		return nil, errors.New("unexpected end of formula")
+	}
+
+	return nil, fmt.Errorf(`unexpected "%s"`, token)
+}
+
+func binaryFunc(operator string, left, right formulaFunc) formulaFunc {
+	switch operator {
+	case "||":
+		return func(n int) int { return boolToInt(left(n) != 0 || right(n) != 0) }
+	case "&&":
+		return func(n int) int { return boolToInt(left(n) != 0 && right(n) != 0) }
+	case "==":
+		return func(n int) int { return boolToInt(left(n) == right(n)) }
+	case "!=":
+		return func(n int) int { return boolToInt(left(n) != right(n)) }
+	case "<":
+		return func(n int) int { return boolToInt(left(n) < right(n)) }
+	case ">":
+		return func(n int) int { return boolToInt(left(n) > right(n)) }
+	case "<=":
+		return func(n int) int { return boolToInt(left(n) <= right(n)) }
+	case ">=":
+		return func(n int) int { return boolToInt(left(n) >= right(n)) }
+	case "+":
+		return func(n int) int { return left(n) + right(n) }
+	case "-":
+		return func(n int) int { return left(n) - right(n) }
+	case "*":
+		return func(n int) int { return left(n) * right(n) }
+	case "/":
+		return func(n int) int { return safeDivide(left(n), right(n), false) }
+	}
+
+	return func(n int) int { return safeDivide(left(n), right(n), true) }
+}
+
+// safeDivide returns 0 for the division by zero instead of panicking.
+func safeDivide(a, b int, isRemainder bool) int {
+	if b == 0 {
+		return 0
+	}
+	if isRemainder {
+		return a % b
+	}
+
+	return a / b
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func isOneOf(token string, tokens []string) bool {
+	for _, t := range tokens {
+		if token == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,168 @@
+package translations
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/message/catalog"
+)
+
+// poEntry is an entry of the gettext catalog.
+type poEntry struct {
+	id           string
+	idPlural     string
+	translation  string
+	translations []string
+	hasID        bool
+	hasPlural    bool
+	isFuzzy      bool
+}
+
+// poReader reads the gettext catalog line by line. The current field is a pointer to the string
+// that is continued by the following quoted lines.
+type poReader struct {
+	file    *translationFile
+	entry   poEntry
+	field   *string
+	isFuzzy bool
+}
+
+func readPO(data []byte) (*translationFile, error) {
+	r := &poReader{
+		file: &translationFile{
+			messages:       map[string]catalog.Message{},
+			indexedPlurals: map[string][]string{},
+		},
+	}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		err := r.readLine(strings.TrimSpace(line))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrMalformedTranslation, i+1, err)
+		}
+	}
+	r.finishEntry()
+
+	return r.file, nil
+}
+
+func (r *poReader) readLine(line string) error {
+	switch {
+	case line == "":
+		r.finishEntry()
+		return nil
+	case strings.HasPrefix(line, "#~"):
+		return nil
+	case strings.HasPrefix(line, "#"):
+		r.finishEntry()
+		if strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy") {
+			r.isFuzzy = true
+		}
+		return nil
+	case strings.HasPrefix(line, `"`):
+		if r.field == nil {
+			return errors.New("unexpected string")
+		}
+		s, err := unquotePO(line)
+		if err != nil {
+			return err
+		}
+		*r.field += s
+		return nil
+	}
+
+	keyword, value, _ := strings.Cut(line, " ")
+	s, err := unquotePO(strings.TrimSpace(value))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case keyword == "msgctxt":
+		return errors.New("message context is not supported")
+	case keyword == "msgid":
+		r.finishEntry()
+		r.entry = poEntry{id: s, hasID: true, isFuzzy: r.isFuzzy}
+		r.isFuzzy = false
+		r.field = &r.entry.id
+	case keyword == "msgid_plural":
+		if !r.entry.hasID || r.entry.hasPlural {
+			return errors.New("unexpected msgid_plural")
+		}
+		r.entry.idPlural = s
+		r.entry.hasPlural = true
+		r.field = &r.entry.idPlural
+	case keyword == "msgstr":
+		if !r.entry.hasID || r.entry.hasPlural {
+			return errors.New("unexpected msgstr")
+		}
+		r.entry.translation = s
+		r.field = &r.entry.translation
+	case strings.HasPrefix(keyword, "msgstr["):
+		index, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(keyword, "msgstr["), "]"))
+		if err != nil || !r.entry.hasPlural || index != len(r.entry.translations) {
+			return fmt.Errorf("unexpected %s", keyword)
+		}
+		r.entry.translations = append(r.entry.translations, s)
+		r.field = &r.entry.translations[index]
+	default:
+		return fmt.Errorf(`unknown keyword "%s"`, keyword)
+	}
+
+	return nil
+}
+
+func (r *poReader) finishEntry() {
+	entry := r.entry
+	r.entry = poEntry{}
+	r.field = nil
+	if !entry.hasID {
+		return
+	}
+
+	if entry.id == "" {
+		r.readHeader(entry.translation)
+		return
+	}
+	if entry.isFuzzy {
+		return
+	}
+	if entry.hasPlural {
+		if strings.Join(entry.translations, "") != "" {
+			r.file.indexedPlurals[entry.id] = entry.translations
+		}
+		return
+	}
+	if entry.translation != "" {
+		r.file.messages[entry.id] = catalog.String(entry.translation)
+	}
+}
+
+func (r *poReader) readHeader(header string) {
+	for _, line := range strings.Split(header, "\n") {
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		switch strings.TrimSpace(name) {
+		case "Language":
+			r.file.language = strings.ReplaceAll(strings.TrimSpace(value), "_", "-")
+		case "Plural-Forms":
+			r.file.pluralForms = strings.TrimSpace(value)
+		}
+	}
+}
+
+func unquotePO(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", errors.New("expected quoted string")
+	}
+	unquoted, err := strconv.Unquote(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid quoted string %s", s)
+	}
+
+	return unquoted, nil
+}
@@ -0,0 +1,117 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.pl.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package polish contains violation message texts translated into Polish language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package polish
+
+import (
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.Polish: {
+		message.NotBlank:          catalog.String("Ta wartość powinna być pusta."),
+		message.NotDivisible:      catalog.String("Ta wartość powinna być wielokrotnością {{ comparedValue }}."),
+		message.NotDivisibleCount: catalog.String("Liczba elementów w tym zbiorze powinna być wielokrotnością {{ divisibleBy }}."),
+		message.NotExactCount: plurals.New(
+			plural.One, "Ten zbiór powinien zawierać dokładnie {{ limit }} element.",
+			plural.Few, "Ten zbiór powinien zawierać dokładnie {{ limit }} elementy.",
+			plural.Many, "Ten zbiór powinien zawierać dokładnie {{ limit }} elementów.",
+			plural.Other, "Ten zbiór powinien zawierać dokładnie {{ limit }} elementu."),
+		message.TooFewElements: plurals.New(
+			plural.One, "Ten zbiór powinien zawierać co najmniej {{ limit }} element.",
+			plural.Few, "Ten zbiór powinien zawierać co najmniej {{ limit }} elementy.",
+			plural.Many, "Ten zbiór powinien zawierać co najmniej {{ limit }} elementów.",
+			plural.Other, "Ten zbiór powinien zawierać co najmniej {{ limit }} elementu."),
+		message.TooManyElements: plurals.New(
+			plural.One, "Ten zbiór powinien zawierać maksymalnie {{ limit }} element.",
+			plural.Few, "Ten zbiór powinien zawierać maksymalnie {{ limit }} elementy.",
+			plural.Many, "Ten zbiór powinien zawierać maksymalnie {{ limit }} elementów.",
+			plural.Other, "Ten zbiór powinien zawierać maksymalnie {{ limit }} elementu."),
+		message.NotEqual:        catalog.String("Ta wartość powinna być równa {{ comparedValue }}."),
+		message.NotFalse:        catalog.String("Ta wartość powinna być fałszem."),
+		message.InvalidDate:     catalog.String("Ta wartość nie jest prawidłową datą."),
+		message.InvalidDateTime: catalog.String("Ta wartość nie jest prawidłową datą i czasem."),
+		message.InvalidEAN13:    catalog.String("Ta wartość nie jest prawidłowym kodem EAN-13."),
+		message.InvalidEAN8:     catalog.String("Ta wartość nie jest prawidłowym kodem EAN-8."),
+		message.InvalidEmail:    catalog.String("Ta wartość nie jest prawidłowym adresem email."),
+		message.InvalidHostname: catalog.String("Ta wartość nie jest prawidłową nazwą hosta."),
+		message.InvalidIP:       catalog.String("To nie jest prawidłowy adres IP."),
+		message.InvalidJSON:     catalog.String("Ta wartość powinna być prawidłowym formatem JSON."),
+		message.InvalidTime:     catalog.String("Ta wartość nie jest prawidłowym czasem."),
+		message.InvalidULID:     catalog.String("To nie jest prawidłowy ULID."),
+		message.InvalidUPCA:     catalog.String("Ta wartość nie jest prawidłowym kodem UPC-A."),
+		message.InvalidUPCE:     catalog.String("Ta wartość nie jest prawidłowym kodem UPC-E."),
+		message.InvalidURL:      catalog.String("Ta wartość nie jest prawidłowym adresem URL."),
+		message.InvalidUUID:     catalog.String("To nie jest prawidłowy UUID."),
+		message.NotExactLength: plurals.New(
+			plural.One, "Ta wartość powinna mieć dokładnie {{ limit }} znak.",
+			plural.Few, "Ta wartość powinna mieć dokładnie {{ limit }} znaki.",
+			plural.Many, "Ta wartość powinna mieć dokładnie {{ limit }} znaków.",
+			plural.Other, "Ta wartość powinna mieć dokładnie {{ limit }} znaku."),
+		message.TooShort: plurals.New(
+			plural.One, "Ta wartość jest zbyt krótka. Powinna mieć co najmniej {{ limit }} znak.",
+			plural.Few, "Ta wartość jest zbyt krótka. Powinna mieć co najmniej {{ limit }} znaki.",
+			plural.Many, "Ta wartość jest zbyt krótka. Powinna mieć co najmniej {{ limit }} znaków.",
+			plural.Other, "Ta wartość jest zbyt krótka. Powinna mieć co najmniej {{ limit }} znaku."),
+		message.TooLong: plurals.New(
+			plural.One, "Ta wartość jest zbyt długa. Powinna mieć maksymalnie {{ limit }} znak.",
+			plural.Few, "Ta wartość jest zbyt długa. Powinna mieć maksymalnie {{ limit }} znaki.",
+			plural.Many, "Ta wartość jest zbyt długa. Powinna mieć maksymalnie {{ limit }} znaków.",
+			plural.Other, "Ta wartość jest zbyt długa. Powinna mieć maksymalnie {{ limit }} znaku."),
+		message.NotNil:            catalog.String("Ta wartość powinna być nil."),
+		message.NoSuchChoice:      catalog.String("Ta wartość powinna być jedną z podanych opcji."),
+		message.IsBlank:           catalog.String("Ta wartość nie powinna być pusta."),
+		message.IsEqual:           catalog.String("Ta wartość nie powinna być równa {{ comparedValue }}."),
+		message.NotInRange:        catalog.String("Ta wartość powinna być pomiędzy {{ min }} a {{ max }}."),
+		message.NotInteger:        catalog.String("Ta wartość nie jest liczbą całkowitą."),
+		message.NotNegative:       catalog.String("Ta wartość powinna być ujemna."),
+		message.NotNegativeOrZero: catalog.String("Ta wartość powinna być ujemna lub równa zero."),
+		message.IsNil:             catalog.String("Ta wartość nie powinna być nil."),
+		message.NotNumeric:        catalog.String("Ta wartość nie jest liczbą."),
+		message.NotPositive:       catalog.String("Ta wartość powinna być dodatnia."),
+		message.NotPositiveOrZero: catalog.String("Ta wartość powinna być dodatnia lub równa zero."),
+		message.NotUnique:         catalog.String("Ten zbiór powinien zawierać tylko unikalne elementy."),
+		message.NotValid:          catalog.String("Ta wartość jest nieprawidłowa."),
+		message.ProhibitedIP:      catalog.String("Używanie tego adresu IP jest zabronione."),
+		message.ProhibitedURL:     catalog.String("Używanie tego adresu URL jest zabronione."),
+		message.TooEarly:          catalog.String("Ta wartość powinna być późniejsza niż {{ comparedValue }}."),
+		message.TooEarlyOrEqual:   catalog.String("Ta wartość powinna być późniejsza lub równa {{ comparedValue }}."),
+		message.TooHigh:           catalog.String("Ta wartość powinna być mniejsza niż {{ comparedValue }}."),
+		message.TooHighOrEqual:    catalog.String("Ta wartość powinna być mniejsza lub równa {{ comparedValue }}."),
+		message.TooLate:           catalog.String("Ta wartość powinna być wcześniejsza niż {{ comparedValue }}."),
+		message.TooLateOrEqual:    catalog.String("Ta wartość powinna być wcześniejsza lub równa {{ comparedValue }}."),
+		message.TooLow:            catalog.String("Ta wartość powinna być większa niż {{ comparedValue }}."),
+		message.TooLowOrEqual:     catalog.String("Ta wartość powinna być większa lub równa {{ comparedValue }}."),
+		message.NotTrue:           catalog.String("Ta wartość powinna być prawdą."),
+		message.InvalidType:       catalog.String("Ta wartość powinna być typu {{ type }}."),
+		message.MissingProperty:   catalog.String("Tego pola brakuje."),
+		message.UnknownProperty:   catalog.String("Tego pola się nie spodziewano."),
+		message.NotSatisfied:      catalog.String("Ta wartość powinna spełniać wyrażenie {{ expression }}."),
+	},
+}
@@ -0,0 +1,105 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.pt.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package portuguese contains violation message texts translated into Portuguese language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package portuguese
+
+import (
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.Portuguese: {
+		message.NotBlank:          catalog.String("Este valor deve estar vazio."),
+		message.NotDivisible:      catalog.String("Este valor deve ser múltiplo de {{ comparedValue }}."),
+		message.NotDivisibleCount: catalog.String("O número de elementos desta coleção deve ser múltiplo de {{ divisibleBy }}."),
+		message.NotExactCount: plurals.New(
+			plural.One, "Esta coleção deve conter exatamente {{ limit }} elemento.",
+			plural.Other, "Esta coleção deve conter exatamente {{ limit }} elementos."),
+		message.TooFewElements: plurals.New(
+			plural.One, "Esta coleção deve conter {{ limit }} elemento ou mais.",
+			plural.Other, "Esta coleção deve conter {{ limit }} elementos ou mais."),
+		message.TooManyElements: plurals.New(
+			plural.One, "Esta coleção deve conter {{ limit }} elemento ou menos.",
+			plural.Other, "Esta coleção deve conter {{ limit }} elementos ou menos."),
+		message.NotEqual:        catalog.String("Este valor deve ser igual a {{ comparedValue }}."),
+		message.NotFalse:        catalog.String("Este valor deve ser falso."),
+		message.InvalidDate:     catalog.String("Este valor não é uma data válida."),
+		message.InvalidDateTime: catalog.String("Este valor não é uma data e hora válida."),
+		message.InvalidEAN13:    catalog.String("Este valor não é um EAN-13 válido."),
+		message.InvalidEAN8:     catalog.String("Este valor não é um EAN-8 válido."),
+		message.InvalidEmail:    catalog.String("Este valor não é um endereço de e-mail válido."),
+		message.InvalidHostname: catalog.String("Este valor não é um nome de host válido."),
+		message.InvalidIP:       catalog.String("Este não é um endereço IP válido."),
+		message.InvalidJSON:     catalog.String("Este valor deve ser um JSON válido."),
+		message.InvalidTime:     catalog.String("Este valor não é uma hora válida."),
+		message.InvalidULID:     catalog.String("Este não é um ULID válido."),
+		message.InvalidUPCA:     catalog.String("Este valor não é um UPC-A válido."),
+		message.InvalidUPCE:     catalog.String("Este valor não é um UPC-E válido."),
+		message.InvalidURL:      catalog.String("Este valor não é uma URL válida."),
+		message.InvalidUUID:     catalog.String("Este não é um UUID válido."),
+		message.NotExactLength: plurals.New(
+			plural.One, "Este valor deve ter exatamente {{ limit }} caractere.",
+			plural.Other, "Este valor deve ter exatamente {{ limit }} caracteres."),
+		message.TooShort: plurals.New(
+			plural.One, "Este valor é muito curto. Deve ter {{ limit }} caractere ou mais.",
+			plural.Other, "Este valor é muito curto. Deve ter {{ limit }} caracteres ou mais."),
+		message.TooLong: plurals.New(
+			plural.One, "Este valor é muito longo. Deve ter {{ limit }} caractere ou menos.",
+			plural.Other, "Este valor é muito longo. Deve ter {{ limit }} caracteres ou menos."),
+		message.NotNil:            catalog.String("Este valor deve ser nil."),
+		message.NoSuchChoice:      catalog.String("O valor selecionado não é uma opção válida."),
+		message.IsBlank:           catalog.String("Este valor não deve estar vazio."),
+		message.IsEqual:           catalog.String("Este valor não deve ser igual a {{ comparedValue }}."),
+		message.NotInRange:        catalog.String("Este valor deve estar entre {{ min }} e {{ max }}."),
+		message.NotInteger:        catalog.String("Este valor não é um número inteiro."),
+		message.NotNegative:       catalog.String("Este valor deve ser negativo."),
+		message.NotNegativeOrZero: catalog.String("Este valor deve ser negativo ou igual a zero."),
+		message.IsNil:             catalog.String("Este valor não deve ser nil."),
+		message.NotNumeric:        catalog.String("Este valor não é numérico."),
+		message.NotPositive:       catalog.String("Este valor deve ser positivo."),
+		message.NotPositiveOrZero: catalog.String("Este valor deve ser positivo ou igual a zero."),
+		message.NotUnique:         catalog.String("Esta coleção deve conter somente elementos únicos."),
+		message.NotValid:          catalog.String("Este valor não é válido."),
+		message.ProhibitedIP:      catalog.String("É proibido usar este endereço IP."),
+		message.ProhibitedURL:     catalog.String("É proibido usar esta URL."),
+		message.TooEarly:          catalog.String("Este valor deve ser posterior a {{ comparedValue }}."),
+		message.TooEarlyOrEqual:   catalog.String("Este valor deve ser posterior ou igual a {{ comparedValue }}."),
+		message.TooHigh:           catalog.String("Este valor deve ser menor que {{ comparedValue }}."),
+		message.TooHighOrEqual:    catalog.String("Este valor deve ser menor ou igual a {{ comparedValue }}."),
+		message.TooLate:           catalog.String("Este valor deve ser anterior a {{ comparedValue }}."),
+		message.TooLateOrEqual:    catalog.String("Este valor deve ser anterior ou igual a {{ comparedValue }}."),
+		message.TooLow:            catalog.String("Este valor deve ser maior que {{ comparedValue }}."),
+		message.TooLowOrEqual:     catalog.String("Este valor deve ser maior ou igual a {{ comparedValue }}."),
+		message.NotTrue:           catalog.String("Este valor deve ser verdadeiro."),
+		message.InvalidType:       catalog.String("Este valor deve ser do tipo {{ type }}."),
+		message.MissingProperty:   catalog.String("Este campo está ausente."),
+		message.UnknownProperty:   catalog.String("Este campo não era esperado."),
+		message.NotSatisfied:      catalog.String("Este valor deve satisfazer a expressão {{ expression }}."),
+	},
+}
@@ -0,0 +1,189 @@
+package translations
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io/fs"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// ReloadableTranslator is a [Translator] whose catalog can be replaced while validations run concurrently.
+// The catalog is rebuilt from scratch on every reload and swapped atomically, so messages are always
+// translated by a complete catalog: either by the previous one or by the new one. If the new catalog
+// is invalid (e.g. the default language is not loaded), then it is rejected and the previous one is kept.
+//
+// Be aware that violation messages are rendered on the first access, so the messages of violations created
+// before the reload may be rendered by the new catalog.
+type ReloadableTranslator struct {
+	options []TranslatorOption
+	current atomic.Pointer[Translator]
+
+	// mu serializes the reloads (the catalog is built and swapped under the lock) and guards the stats.
+	mu    sync.Mutex
+	stats CatalogStats
+}
+
+// CatalogStats contains the metrics about the catalog loaded into the [ReloadableTranslator].
+type CatalogStats struct {
+	// Version is incremented on every successful load of the catalog. It is 1 for the initial catalog.
+	Version uint64
+
+	// LoadedAt is the time when the current catalog was loaded.
+	LoadedAt time.Time
+
+	// Messages is the number of translated messages by languages of the current catalog.
+	Messages map[language.Tag]int
+
+	// Reloads is the number of successful reloads.
+	Reloads uint64
+
+	// FailedReloads is the number of rejected catalogs.
+	FailedReloads uint64
+
+	// LastError is the error of the last reload. It is nil if the last reload succeeded.
+	LastError error
+}
+
+// NewReloadableTranslator creates a [ReloadableTranslator]. The options are applied on the initial load
+// and on every reload before the options passed into [ReloadableTranslator.Reload].
+// So, you can pass here options that do not change (e.g. [DefaultLanguage] and the built-in translations).
+func NewReloadableTranslator(options ...TranslatorOption) (*ReloadableTranslator, error) {
+	translator, err := NewTranslator(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	reloadable := &ReloadableTranslator{options: options}
+	reloadable.current.Store(translator)
+	reloadable.stats = CatalogStats{
+		Version:  1,
+		LoadedAt: time.Now(),
+		Messages: translator.messageCounts(),
+	}
+
+	return reloadable, nil
+}
+
+// Reload builds a new catalog from the options passed into [NewReloadableTranslator] and the given options
+// (e.g. [LoadFiles]) and replaces the current catalog by it. If the catalog cannot be built, then
+// the error is returned and the current catalog is kept.
+func (t *ReloadableTranslator) Reload(options ...TranslatorOption) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	allOptions := make([]TranslatorOption, 0, len(t.options)+len(options))
+	allOptions = append(allOptions, t.options...)
+	allOptions = append(allOptions, options...)
+	translator, err := NewTranslator(allOptions...)
+	if err != nil {
+		t.stats.FailedReloads++
+		t.stats.LastError = fmt.Errorf("reload translations: %w", err)
+		return t.stats.LastError
+	}
+
+	t.current.Store(translator)
+	t.stats.Version++
+	t.stats.Reloads++
+	t.stats.LoadedAt = time.Now()
+	t.stats.Messages = translator.messageCounts()
+	t.stats.LastError = nil
+
+	return nil
+}
+
+// Watch checks the files of the file system matching the patterns with the given interval and reloads
+// the catalog from them (see [LoadFiles]) when their contents are changed. The files are loaded immediately
+// on the first check. Reload errors are available via [ReloadableTranslator.Stats], the previous catalog
+// is kept in case of error. The rejected files are not reloaded again until their contents are changed.
+// Watch blocks until the context is done.
+func (t *ReloadableTranslator) Watch(ctx context.Context, fsys fs.FS, interval time.Duration, patterns ...string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var checksum [sha256.Size]byte
+	for {
+		current, err := filesChecksum(fsys, patterns)
+		if err != nil {
+			t.mu.Lock()
+			t.stats.FailedReloads++
+			t.stats.LastError = fmt.Errorf("reload translations: %w", err)
+			t.mu.Unlock()
+		} else if current != checksum {
+			// the checksum is updated even if the reload fails, so the rejected files are not parsed on every tick
+			_ = t.Reload(LoadFiles(fsys, patterns...))
+			checksum = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stats returns the metrics about the current catalog.
+func (t *ReloadableTranslator) Stats() CatalogStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.stats
+}
+
+// Translator returns the translator of the current catalog.
+func (t *ReloadableTranslator) Translator() *Translator {
+	return t.current.Load()
+}
+
+// DefaultLanguage returns the language that is used for translation when the language is not specified.
+func (t *ReloadableTranslator) DefaultLanguage() language.Tag {
+	return t.current.Load().DefaultLanguage()
+}
+
+// Translate translates the message by the current catalog. See [Translator.Translate] for details.
+func (t *ReloadableTranslator) Translate(tag language.Tag, message string, pluralCount int) string {
+	return t.current.Load().Translate(tag, message, pluralCount)
+}
+
+// TranslateWithLanguage translates the message by the current catalog and returns the language
+// that was actually used for translation. See [Translator.TranslateWithLanguage] for details.
+func (t *ReloadableTranslator) TranslateWithLanguage(
+	tag language.Tag,
+	message string,
+	pluralCount int,
+) (string, language.Tag) {
+	return t.current.Load().TranslateWithLanguage(tag, message, pluralCount)
+}
+
+func filesChecksum(fsys fs.FS, patterns []string) ([sha256.Size]byte, error) {
+	var names []string
+	for _, pattern := range patterns {
+		matches, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return [sha256.Size]byte{}, fmt.Errorf(`read translation files "%s": %w`, pattern, err)
+		}
+		names = append(names, matches...)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return [sha256.Size]byte{}, fmt.Errorf(`read translation file "%s": %w`, name, err)
+		}
+		fmt.Fprintf(hash, "%s\x00%d\x00", name, len(content))
+		hash.Write(content)
+	}
+
+	var checksum [sha256.Size]byte
+	copy(checksum[:], hash.Sum(nil))
+
+	return checksum, nil
+}
@@ -0,0 +1,120 @@
+package translations_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestReloadableTranslator_Reload_ExpectCatalogReplaced(t *testing.T) {
+	translator, err := translations.NewReloadableTranslator(translations.SetTranslations(russian.Messages))
+	require.NoError(t, err)
+	assert.Equal(t, "Значение не должно быть пустым.", translator.Translate(language.Russian, message.IsBlank, 0))
+
+	err = translator.Reload(translations.SetTranslations(map[language.Tag]map[string]catalog.Message{
+		language.Russian: {message.IsBlank: catalog.String("Заполните это поле.")},
+	}))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Заполните это поле.", translator.Translate(language.Russian, message.IsBlank, 0))
+	stats := translator.Stats()
+	assert.Equal(t, uint64(2), stats.Version)
+	assert.Equal(t, uint64(1), stats.Reloads)
+	assert.Equal(t, len(russian.Messages[language.Russian]), stats.Messages[language.Russian])
+	assert.NoError(t, stats.LastError)
+}
+
+func TestReloadableTranslator_Reload_WhenDefaultLanguageIsNotLoaded_ExpectCatalogRejected(t *testing.T) {
+	translator, err := translations.NewReloadableTranslator()
+	require.NoError(t, err)
+
+	err = translator.Reload(translations.DefaultLanguage(language.Russian))
+
+	assert.EqualError(t, err, `reload translations: default language is not loaded: missing messages for language "ru"`)
+	assert.Equal(t, language.English, translator.DefaultLanguage())
+	assert.Equal(t, message.IsBlank, translator.Translate(language.Und, message.IsBlank, 0))
+	stats := translator.Stats()
+	assert.Equal(t, uint64(1), stats.Version)
+	assert.Equal(t, uint64(1), stats.FailedReloads)
+	assert.Equal(t, err, stats.LastError)
+}
+
+func TestReloadableTranslator_WhenReloadedConcurrently_ExpectCompleteCatalogUsed(t *testing.T) {
+	translator, err := translations.NewReloadableTranslator(translations.SetTranslations(russian.Messages))
+	require.NoError(t, err)
+	wording := translations.SetTranslations(map[language.Tag]map[string]catalog.Message{
+		language.Russian: {message.IsBlank: catalog.String("Заполните это поле.")},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				translated, used := translator.TranslateWithLanguage(language.Russian, message.IsBlank, 0)
+				assert.Contains(t, []string{"Значение не должно быть пустым.", "Заполните это поле."}, translated)
+				assert.Equal(t, language.Russian, used)
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, translator.Reload(wording))
+	}
+	wg.Wait()
+
+	assert.Equal(t, uint64(11), translator.Stats().Version)
+}
+
+func TestReloadableTranslator_Watch_ExpectCatalogReloadedOnChanges(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "validators.ru.yaml")
+	writeTranslationFile(t, file, `"This value should not be blank.": "Заполните это поле."`)
+	translator, err := translations.NewReloadableTranslator()
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		translator.Watch(ctx, os.DirFS(dir), 5*time.Millisecond, "*.yaml")
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	require.Eventually(t, func() bool { return translator.Stats().Version == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, "Заполните это поле.", translator.Translate(language.Russian, message.IsBlank, 0))
+
+	writeTranslationFile(t, file, `"This value should not be blank.": "Поле обязательно."`)
+	require.Eventually(t, func() bool { return translator.Stats().Version == 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, "Поле обязательно.", translator.Translate(language.Russian, message.IsBlank, 0))
+
+	writeTranslationFile(t, file, `"Unknown message.": "Неизвестное сообщение."`)
+	require.Eventually(t, func() bool { return translator.Stats().FailedReloads > 0 }, time.Second, time.Millisecond)
+	assert.ErrorIs(t, translator.Stats().LastError, translations.ErrUnknownTemplate)
+	assert.Equal(t, "Поле обязательно.", translator.Translate(language.Russian, message.IsBlank, 0))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, uint64(1), translator.Stats().FailedReloads, "unchanged rejected files must not be reloaded")
+
+	writeTranslationFile(t, file, `"This value should not be blank.": "Заполните поле."`)
+	require.Eventually(t, func() bool { return translator.Stats().Version == 4 }, time.Second, time.Millisecond)
+	assert.Equal(t, "Заполните поле.", translator.Translate(language.Russian, message.IsBlank, 0))
+	assert.NoError(t, translator.Stats().LastError)
+}
+
+func writeTranslationFile(t *testing.T, name, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+}
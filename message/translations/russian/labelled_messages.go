@@ -0,0 +1,95 @@
+package russian
+
+import (
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+// LabelledMessages contains violation message texts translated into Russian language
+// that are prefixed by the "{{ label }}" template parameter. Load them to show the label of the property
+// in every message, e.g. "E-mail: Значение адреса электронной почты недопустимо.".
+var LabelledMessages = map[language.Tag]map[string]catalog.Message{
+	language.Russian: {
+		message.NotBlank:          catalog.String("{{ label }}: Значение должно быть пустым."),
+		message.NotDivisible:      catalog.String("{{ label }}: Значение должно быть кратно {{ comparedValue }}."),
+		message.NotDivisibleCount: catalog.String("{{ label }}: Количество элементов в этой коллекции должно быть кратным {{ divisibleBy }}."),
+		message.NotExactCount: plurals.New(
+			plural.One, "{{ label }}: Эта коллекция должна содержать ровно {{ limit }} элемент.",
+			plural.Few, "{{ label }}: Эта коллекция должна содержать ровно {{ limit }} элемента.",
+			plural.Many, "{{ label }}: Эта коллекция должна содержать ровно {{ limit }} элементов.",
+			plural.Other, "{{ label }}: Эта коллекция должна содержать ровно {{ limit }} элементов."),
+		message.TooFewElements: plurals.New(
+			plural.One, "{{ label }}: Эта коллекция должна содержать {{ limit }} элемент или больше.",
+			plural.Few, "{{ label }}: Эта коллекция должна содержать {{ limit }} элемента или больше.",
+			plural.Many, "{{ label }}: Эта коллекция должна содержать {{ limit }} элементов или больше.",
+			plural.Other, "{{ label }}: Эта коллекция должна содержать {{ limit }} элементов или больше."),
+		message.TooManyElements: plurals.New(
+			plural.One, "{{ label }}: Эта коллекция должна содержать {{ limit }} элемент или меньше.",
+			plural.Few, "{{ label }}: Эта коллекция должна содержать {{ limit }} элемента или меньше.",
+			plural.Many, "{{ label }}: Эта коллекция должна содержать {{ limit }} элементов или меньше.",
+			plural.Other, "{{ label }}: Эта коллекция должна содержать {{ limit }} элементов или меньше."),
+		message.NotEqual:        catalog.String("{{ label }}: Значение должно быть равно {{ comparedValue }}."),
+		message.NotFalse:        catalog.String("{{ label }}: Значение должно быть ложным."),
+		message.InvalidDate:     catalog.String("{{ label }}: Значение не является правильной датой."),
+		message.InvalidDateTime: catalog.String("{{ label }}: Значение даты и времени недопустимо."),
+		message.InvalidEAN13:    catalog.String("{{ label }}: Значение не является допустимым EAN-13."),
+		message.InvalidEAN8:     catalog.String("{{ label }}: Значение не является допустимым EAN-8."),
+		message.InvalidEmail:    catalog.String("{{ label }}: Значение адреса электронной почты недопустимо."),
+		message.InvalidHostname: catalog.String("{{ label }}: Значение не является корректным именем хоста."),
+		message.InvalidIP:       catalog.String("{{ label }}: Значение не является допустимым IP адресом."),
+		message.InvalidJSON:     catalog.String("{{ label }}: Значение должно быть корректным JSON."),
+		message.InvalidTime:     catalog.String("{{ label }}: Значение времени недопустимо."),
+		message.InvalidULID:     catalog.String("{{ label }}: Значение не соответствует формату ULID."),
+		message.InvalidUPCA:     catalog.String("{{ label }}: Значение не является допустимым UPC-A."),
+		message.InvalidUPCE:     catalog.String("{{ label }}: Значение не является допустимым UPC-E."),
+		message.InvalidURL:      catalog.String("{{ label }}: Значение не является допустимым URL."),
+		message.InvalidUUID:     catalog.String("{{ label }}: Значение не соответствует формату UUID."),
+		message.NotExactLength: plurals.New(
+			plural.One, "{{ label }}: Значение должно быть равно {{ limit }} символу.",
+			plural.Few, "{{ label }}: Значение должно быть равно {{ limit }} символам.",
+			plural.Many, "{{ label }}: Значение должно быть равно {{ limit }} символам.",
+			plural.Other, "{{ label }}: Значение должно быть равно {{ limit }} символам."),
+		message.TooShort: plurals.New(
+			plural.One, "{{ label }}: Значение слишком короткое. Должно быть равно {{ limit }} символу или больше.",
+			plural.Few, "{{ label }}: Значение слишком короткое. Должно быть равно {{ limit }} символам или больше.",
+			plural.Many, "{{ label }}: Значение слишком короткое. Должно быть равно {{ limit }} символам или больше.",
+			plural.Other, "{{ label }}: Значение слишком короткое. Должно быть равно {{ limit }} символам или больше."),
+		message.TooLong: plurals.New(
+			plural.One, "{{ label }}: Значение слишком длинное. Должно быть равно {{ limit }} символу или меньше.",
+			plural.Few, "{{ label }}: Значение слишком длинное. Должно быть равно {{ limit }} символам или меньше.",
+			plural.Many, "{{ label }}: Значение слишком длинное. Должно быть равно {{ limit }} символам или меньше.",
+			plural.Other, "{{ label }}: Значение слишком длинное. Должно быть равно {{ limit }} символам или меньше."),
+		message.NotNil:            catalog.String("{{ label }}: Значение должно быть nil."),
+		message.NoSuchChoice:      catalog.String("{{ label }}: Выбранное Вами значение недопустимо."),
+		message.IsBlank:           catalog.String("{{ label }}: Значение не должно быть пустым."),
+		message.IsEqual:           catalog.String("{{ label }}: Значение не должно быть равно {{ comparedValue }}."),
+		message.NotInRange:        catalog.String("{{ label }}: Значение должно быть между {{ min }} и {{ max }}."),
+		message.NotInteger:        catalog.String("{{ label }}: Это значение не является целым числом."),
+		message.NotNegative:       catalog.String("{{ label }}: Значение должно быть отрицательным."),
+		message.NotNegativeOrZero: catalog.String("{{ label }}: Значение должно быть отрицательным или равным нулю."),
+		message.IsNil:             catalog.String("{{ label }}: Значение не должно быть nil."),
+		message.NotNumeric:        catalog.String("{{ label }}: Это значение не числовое."),
+		message.NotPositive:       catalog.String("{{ label }}: Значение должно быть положительным."),
+		message.NotPositiveOrZero: catalog.String("{{ label }}: Значение должно быть положительным или равным нулю."),
+		message.NotUnique:         catalog.String("{{ label }}: Эта коллекция должна содержать только уникальные элементы."),
+		message.NotValid:          catalog.String("{{ label }}: Значение недопустимо."),
+		message.ProhibitedIP:      catalog.String("{{ label }}: Этот IP-адрес запрещено использовать."),
+		message.ProhibitedURL:     catalog.String("{{ label }}: Этот URL-адрес запрещено использовать."),
+		message.TooEarly:          catalog.String("{{ label }}: Значение должно быть позже чем {{ comparedValue }}."),
+		message.TooEarlyOrEqual:   catalog.String("{{ label }}: Значение должно быть позже или равно {{ comparedValue }}."),
+		message.TooHigh:           catalog.String("{{ label }}: Значение должно быть меньше чем {{ comparedValue }}."),
+		message.TooHighOrEqual:    catalog.String("{{ label }}: Значение должно быть меньше или равно {{ comparedValue }}."),
+		message.TooLate:           catalog.String("{{ label }}: Значение должно быть раньше чем {{ comparedValue }}."),
+		message.TooLateOrEqual:    catalog.String("{{ label }}: Значение должно быть раньше или равно {{ comparedValue }}."),
+		message.TooLow:            catalog.String("{{ label }}: Значение должно быть больше чем {{ comparedValue }}."),
+		message.TooLowOrEqual:     catalog.String("{{ label }}: Значение должно быть больше или равно {{ comparedValue }}."),
+		message.NotTrue:           catalog.String("{{ label }}: Значение должно быть истинным."),
+		message.InvalidType:       catalog.String("{{ label }}: Значение должно быть типа {{ type }}."),
+		message.MissingProperty:   catalog.String("{{ label }}: Это поле отсутствует."),
+		message.UnknownProperty:   catalog.String("{{ label }}: Это поле не ожидалось."),
+		message.NotSatisfied:      catalog.String("{{ label }}: Значение должно удовлетворять выражению {{ expression }}."),
+	},
+}
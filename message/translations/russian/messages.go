@@ -27,6 +27,7 @@ package russian
 
 import (
 	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
 	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message/catalog"
@@ -37,17 +38,20 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.NotBlank:          catalog.String("Значение должно быть пустым."),
 		message.NotDivisible:      catalog.String("Значение должно быть кратно {{ comparedValue }}."),
 		message.NotDivisibleCount: catalog.String("Количество элементов в этой коллекции должно быть кратным {{ divisibleBy }}."),
-		message.NotExactCount: plural.Selectf(1, "",
+		message.NotExactCount: plurals.New(
 			plural.One, "Эта коллекция должна содержать ровно {{ limit }} элемент.",
 			plural.Few, "Эта коллекция должна содержать ровно {{ limit }} элемента.",
+			plural.Many, "Эта коллекция должна содержать ровно {{ limit }} элементов.",
 			plural.Other, "Эта коллекция должна содержать ровно {{ limit }} элементов."),
-		message.TooFewElements: plural.Selectf(1, "",
+		message.TooFewElements: plurals.New(
 			plural.One, "Эта коллекция должна содержать {{ limit }} элемент или больше.",
 			plural.Few, "Эта коллекция должна содержать {{ limit }} элемента или больше.",
+			plural.Many, "Эта коллекция должна содержать {{ limit }} элементов или больше.",
 			plural.Other, "Эта коллекция должна содержать {{ limit }} элементов или больше."),
-		message.TooManyElements: plural.Selectf(1, "",
+		message.TooManyElements: plurals.New(
 			plural.One, "Эта коллекция должна содержать {{ limit }} элемент или меньше.",
 			plural.Few, "Эта коллекция должна содержать {{ limit }} элемента или меньше.",
+			plural.Many, "Эта коллекция должна содержать {{ limit }} элементов или меньше.",
 			plural.Other, "Эта коллекция должна содержать {{ limit }} элементов или меньше."),
 		message.NotEqual:        catalog.String("Значение должно быть равно {{ comparedValue }}."),
 		message.NotFalse:        catalog.String("Значение должно быть ложным."),
@@ -65,17 +69,20 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.InvalidUPCE:     catalog.String("Значение не является допустимым UPC-E."),
 		message.InvalidURL:      catalog.String("Значение не является допустимым URL."),
 		message.InvalidUUID:     catalog.String("Значение не соответствует формату UUID."),
-		message.NotExactLength: plural.Selectf(1, "",
+		message.NotExactLength: plurals.New(
 			plural.One, "Значение должно быть равно {{ limit }} символу.",
 			plural.Few, "Значение должно быть равно {{ limit }} символам.",
+			plural.Many, "Значение должно быть равно {{ limit }} символам.",
 			plural.Other, "Значение должно быть равно {{ limit }} символам."),
-		message.TooShort: plural.Selectf(1, "",
+		message.TooShort: plurals.New(
 			plural.One, "Значение слишком короткое. Должно быть равно {{ limit }} символу или больше.",
 			plural.Few, "Значение слишком короткое. Должно быть равно {{ limit }} символам или больше.",
+			plural.Many, "Значение слишком короткое. Должно быть равно {{ limit }} символам или больше.",
 			plural.Other, "Значение слишком короткое. Должно быть равно {{ limit }} символам или больше."),
-		message.TooLong: plural.Selectf(1, "",
+		message.TooLong: plurals.New(
 			plural.One, "Значение слишком длинное. Должно быть равно {{ limit }} символу или меньше.",
 			plural.Few, "Значение слишком длинное. Должно быть равно {{ limit }} символам или меньше.",
+			plural.Many, "Значение слишком длинное. Должно быть равно {{ limit }} символам или меньше.",
 			plural.Other, "Значение слишком длинное. Должно быть равно {{ limit }} символам или меньше."),
 		message.NotNil:            catalog.String("Значение должно быть nil."),
 		message.NoSuchChoice:      catalog.String("Выбранное Вами значение недопустимо."),
@@ -102,5 +109,9 @@ var Messages = map[language.Tag]map[string]catalog.Message{
 		message.TooLow:            catalog.String("Значение должно быть больше чем {{ comparedValue }}."),
 		message.TooLowOrEqual:     catalog.String("Значение должно быть больше или равно {{ comparedValue }}."),
 		message.NotTrue:           catalog.String("Значение должно быть истинным."),
+		message.InvalidType:       catalog.String("Значение должно быть типа {{ type }}."),
+		message.MissingProperty:   catalog.String("Это поле отсутствует."),
+		message.UnknownProperty:   catalog.String("Это поле не ожидалось."),
+		message.NotSatisfied:      catalog.String("Значение должно удовлетворять выражению {{ expression }}."),
 	},
 }
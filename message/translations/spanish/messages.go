@@ -0,0 +1,105 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.es.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package spanish contains violation message texts translated into Spanish language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package spanish
+
+import (
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.Spanish: {
+		message.NotBlank:          catalog.String("Este valor debería estar vacío."),
+		message.NotDivisible:      catalog.String("Este valor debería ser múltiplo de {{ comparedValue }}."),
+		message.NotDivisibleCount: catalog.String("El número de elementos en esta colección debería ser múltiplo de {{ divisibleBy }}."),
+		message.NotExactCount: plurals.New(
+			plural.One, "Esta colección debe contener exactamente {{ limit }} elemento.",
+			plural.Other, "Esta colección debe contener exactamente {{ limit }} elementos."),
+		message.TooFewElements: plurals.New(
+			plural.One, "Esta colección debe contener {{ limit }} elemento o más.",
+			plural.Other, "Esta colección debe contener {{ limit }} elementos o más."),
+		message.TooManyElements: plurals.New(
+			plural.One, "Esta colección debe contener {{ limit }} elemento o menos.",
+			plural.Other, "Esta colección debe contener {{ limit }} elementos o menos."),
+		message.NotEqual:        catalog.String("Este valor debería ser igual a {{ comparedValue }}."),
+		message.NotFalse:        catalog.String("Este valor debería ser falso."),
+		message.InvalidDate:     catalog.String("Este valor no es una fecha válida."),
+		message.InvalidDateTime: catalog.String("Este valor no es una fecha y hora válidas."),
+		message.InvalidEAN13:    catalog.String("Este valor no es un EAN-13 válido."),
+		message.InvalidEAN8:     catalog.String("Este valor no es un EAN-8 válido."),
+		message.InvalidEmail:    catalog.String("Este valor no es una dirección de email válida."),
+		message.InvalidHostname: catalog.String("Este valor no es un nombre de host válido."),
+		message.InvalidIP:       catalog.String("Esto no es una dirección IP válida."),
+		message.InvalidJSON:     catalog.String("Este valor debería ser un JSON válido."),
+		message.InvalidTime:     catalog.String("Este valor no es una hora válida."),
+		message.InvalidULID:     catalog.String("Esto no es un ULID válido."),
+		message.InvalidUPCA:     catalog.String("Este valor no es un UPC-A válido."),
+		message.InvalidUPCE:     catalog.String("Este valor no es un UPC-E válido."),
+		message.InvalidURL:      catalog.String("Este valor no es una URL válida."),
+		message.InvalidUUID:     catalog.String("Esto no es un UUID válido."),
+		message.NotExactLength: plurals.New(
+			plural.One, "Este valor debería tener exactamente {{ limit }} carácter.",
+			plural.Other, "Este valor debería tener exactamente {{ limit }} caracteres."),
+		message.TooShort: plurals.New(
+			plural.One, "Este valor es demasiado corto. Debería tener {{ limit }} carácter o más.",
+			plural.Other, "Este valor es demasiado corto. Debería tener {{ limit }} caracteres o más."),
+		message.TooLong: plurals.New(
+			plural.One, "Este valor es demasiado largo. Debería tener {{ limit }} carácter o menos.",
+			plural.Other, "Este valor es demasiado largo. Debería tener {{ limit }} caracteres o menos."),
+		message.NotNil:            catalog.String("Este valor debería ser nil."),
+		message.NoSuchChoice:      catalog.String("El valor seleccionado no es una opción válida."),
+		message.IsBlank:           catalog.String("Este valor no debería estar vacío."),
+		message.IsEqual:           catalog.String("Este valor no debería ser igual a {{ comparedValue }}."),
+		message.NotInRange:        catalog.String("Este valor debería estar entre {{ min }} y {{ max }}."),
+		message.NotInteger:        catalog.String("Este valor no es un número entero."),
+		message.NotNegative:       catalog.String("Este valor debería ser negativo."),
+		message.NotNegativeOrZero: catalog.String("Este valor debería ser negativo o igual a cero."),
+		message.IsNil:             catalog.String("Este valor no debería ser nil."),
+		message.NotNumeric:        catalog.String("Este valor no es numérico."),
+		message.NotPositive:       catalog.String("Este valor debería ser positivo."),
+		message.NotPositiveOrZero: catalog.String("Este valor debería ser positivo o igual a cero."),
+		message.NotUnique:         catalog.String("Esta colección debería contener solo elementos únicos."),
+		message.NotValid:          catalog.String("Este valor no es válido."),
+		message.ProhibitedIP:      catalog.String("Está prohibido usar esta dirección IP."),
+		message.ProhibitedURL:     catalog.String("Está prohibido usar esta URL."),
+		message.TooEarly:          catalog.String("Este valor debería ser posterior a {{ comparedValue }}."),
+		message.TooEarlyOrEqual:   catalog.String("Este valor debería ser posterior o igual a {{ comparedValue }}."),
+		message.TooHigh:           catalog.String("Este valor debería ser menor que {{ comparedValue }}."),
+		message.TooHighOrEqual:    catalog.String("Este valor debería ser menor o igual a {{ comparedValue }}."),
+		message.TooLate:           catalog.String("Este valor debería ser anterior a {{ comparedValue }}."),
+		message.TooLateOrEqual:    catalog.String("Este valor debería ser anterior o igual a {{ comparedValue }}."),
+		message.TooLow:            catalog.String("Este valor debería ser mayor que {{ comparedValue }}."),
+		message.TooLowOrEqual:     catalog.String("Este valor debería ser mayor o igual a {{ comparedValue }}."),
+		message.NotTrue:           catalog.String("Este valor debería ser verdadero."),
+		message.InvalidType:       catalog.String("Este valor debería ser de tipo {{ type }}."),
+		message.MissingProperty:   catalog.String("Este campo falta."),
+		message.UnknownProperty:   catalog.String("Este campo no se esperaba."),
+		message.NotSatisfied:      catalog.String("Este valor debería satisfacer la expresión {{ expression }}."),
+	},
+}
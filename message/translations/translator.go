@@ -10,10 +10,24 @@ import (
 )
 
 // Translator is used as a mechanism for message translations. It is based on the [golang.org/x/text] package.
+//
+// The language of the message is resolved in the following order:
+//   - the requested language, if it is loaded;
+//   - the fallback chain of the requested language or of its parent (see [FallbackLanguages]);
+//   - the loaded language that is the best match for the requested one (e.g. "en" for "en-GB");
+//     languages with a different script (e.g. "sr" for "sr-Latn") are not matched;
+//   - the default language.
+//
+// The first language of these that has the message translated is used.
 type Translator struct {
 	defaultLanguage language.Tag
 	messages        *catalog.Builder
+	templates       map[string]struct{}
+	translated      map[language.Tag]map[string]struct{}
+	fallbacks       map[language.Tag][]language.Tag
 	printers        map[language.Tag]*message.Printer
+	languages       []language.Tag
+	matcher         language.Matcher
 }
 
 type TranslatorOption func(translator *Translator) error
@@ -26,6 +40,19 @@ func DefaultLanguage(tag language.Tag) TranslatorOption {
 	}
 }
 
+// FallbackLanguages option sets up the chain of languages that are used to translate messages
+// of the language when they have no translation. For example, the chain "pt-PT", "es", "en" for
+// the "pt-BR" language means that messages that are not translated into Brazilian Portuguese will
+// be translated into European Portuguese, then into Spanish and then into English.
+// The chain is also used for the sub-tags of the language (e.g. the chain of "pt" is used for "pt-BR").
+// All the languages of the chain must be loaded.
+func FallbackLanguages(tag language.Tag, fallbacks ...language.Tag) TranslatorOption {
+	return func(translator *Translator) error {
+		translator.fallbacks[tag] = fallbacks
+		return nil
+	}
+}
+
 // SetTranslations option is used to load translation messages into the translator.
 //
 // By default, all violation messages are generated in the English language with pluralization capabilities.
@@ -43,6 +70,9 @@ func NewTranslator(options ...TranslatorOption) (*Translator, error) {
 	translator := &Translator{
 		defaultLanguage: language.English,
 		messages:        catalog.NewBuilder(),
+		templates:       map[string]struct{}{},
+		translated:      map[language.Tag]map[string]struct{}{},
+		fallbacks:       map[language.Tag][]language.Tag{},
 		printers:        map[language.Tag]*message.Printer{},
 	}
 	err := translator.setMessages(english.Messages)
@@ -61,30 +91,106 @@ func NewTranslator(options ...TranslatorOption) (*Translator, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = translator.checkFallbackLanguagesAreLoaded()
+	if err != nil {
+		return nil, err
+	}
 
+	// the default language goes first, so it is used when there is no match
+	translator.languages = append(translator.languages, translator.defaultLanguage)
 	for _, tag := range translator.messages.Languages() {
 		translator.printers[tag] = message.NewPrinter(tag, message.Catalog(translator.messages))
+		if tag != translator.defaultLanguage {
+			translator.languages = append(translator.languages, tag)
+		}
 	}
+	translator.matcher = language.NewMatcher(translator.languages)
 
 	return translator, nil
 }
 
+// DefaultLanguage returns the language that is used for translation when the language is not specified.
+func (translator *Translator) DefaultLanguage() language.Tag {
+	return translator.defaultLanguage
+}
+
+// Translate translates the message into the language. See [Translator] for details
+// on how the language is resolved.
 func (translator *Translator) Translate(tag language.Tag, message string, pluralCount int) string {
+	translated, _ := translator.TranslateWithLanguage(tag, message, pluralCount)
+
+	return translated
+}
+
+// TranslateWithLanguage translates the message into the language and returns the language
+// that was actually used for translation. It may differ from the requested language if
+// the message has no translation into it. See [Translator] for details on how the language is resolved.
+func (translator *Translator) TranslateWithLanguage(
+	tag language.Tag,
+	message string,
+	pluralCount int,
+) (string, language.Tag) {
+	candidates := translator.candidates(tag)
+	used := candidates[0]
+	for _, candidate := range candidates {
+		if _, exists := translator.translated[candidate][message]; exists {
+			used = candidate
+			break
+		}
+	}
+
+	printer := translator.printers[used]
+	if printer == nil {
+		return message, used
+	}
+
+	return printer.Sprintf(message, pluralCount), used
+}
+
+// candidates returns the loaded languages that can be used for translation into the language
+// in order of priority. The list is never empty.
+func (translator *Translator) candidates(tag language.Tag) []language.Tag {
 	if tag == language.Und {
 		tag = translator.defaultLanguage
 	}
-	printer := translator.printers[tag]
-	if printer == nil {
-		printer = translator.printers[tag.Parent()]
+
+	candidates := make([]language.Tag, 0, 4)
+	add := func(tag language.Tag) {
+		if translator.printers[tag] == nil {
+			return
+		}
+		for _, candidate := range candidates {
+			if candidate == tag {
+				return
+			}
+		}
+		candidates = append(candidates, tag)
 	}
-	if printer == nil {
-		printer = translator.printers[translator.defaultLanguage]
+
+	add(tag)
+	for parent := tag; ; parent = parent.Parent() {
+		if fallbacks, exists := translator.fallbacks[parent]; exists {
+			for _, fallback := range fallbacks {
+				add(fallback)
+			}
+			break
+		}
+		if parent.IsRoot() {
+			break
+		}
 	}
-	if printer == nil {
-		return message
+	if translator.matcher != nil {
+		_, index, confidence := translator.matcher.Match(tag)
+		if confidence >= language.High {
+			add(translator.languages[index])
+		}
+	}
+	add(translator.defaultLanguage)
+	if len(candidates) == 0 {
+		candidates = append(candidates, translator.defaultLanguage)
 	}
 
-	return printer.Sprintf(message, pluralCount)
+	return candidates
 }
 
 func (translator *Translator) setMessages(messages map[language.Tag]map[string]catalog.Message) error {
@@ -103,6 +209,11 @@ func (translator *Translator) setMessagesToCatalog(messages map[language.Tag]map
 			if err != nil {
 				return fmt.Errorf(`set message "%s" for language %s: %w`, key, tag, err)
 			}
+			translator.templates[key] = struct{}{}
+			if translator.translated[tag] == nil {
+				translator.translated[tag] = map[string]struct{}{}
+			}
+			translator.translated[tag][key] = struct{}{}
 		}
 	}
 
@@ -120,3 +231,32 @@ func (translator *Translator) checkDefaultLanguageIsLoaded() error {
 
 	return fmt.Errorf(`%w: missing messages for language "%s"`, errDefaultLanguageNotLoaded, translator.defaultLanguage)
 }
+
+func (translator *Translator) checkFallbackLanguagesAreLoaded() error {
+	languages := make(map[language.Tag]bool)
+	for _, tag := range translator.messages.Languages() {
+		languages[tag] = true
+	}
+
+	for tag, fallbacks := range translator.fallbacks {
+		for _, fallback := range fallbacks {
+			if !languages[fallback] {
+				return fmt.Errorf(
+					`%w: missing messages for language "%s" used as fallback for "%s"`,
+					errFallbackLanguageNotLoaded, fallback, tag,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (translator *Translator) messageCounts() map[language.Tag]int {
+	counts := make(map[language.Tag]int, len(translator.translated))
+	for tag, messages := range translator.translated {
+		counts[tag] = len(messages)
+	}
+
+	return counts
+}
@@ -0,0 +1,107 @@
+package translations_test
+
+import (
+	"testing"
+
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations"
+	"github.com/muonsoft/validation/message/translations/german"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestTranslator_TranslateWithLanguage_WhenFallbackLanguages_ExpectMessageTranslatedByChain(t *testing.T) {
+	brazilian := language.MustParse("pt-BR")
+	european := language.MustParse("pt-PT")
+	translator, err := translations.NewTranslator(
+		translations.SetTranslations(map[language.Tag]map[string]catalog.Message{
+			brazilian: {message.IsBlank: catalog.String("O valor não deve estar em branco.")},
+			european:  {message.NotValid: catalog.String("Este valor não é válido.")},
+			language.Spanish: {
+				message.NotValid: catalog.String("Este valor no es válido."),
+				message.NotTrue:  catalog.String("Este valor debería ser verdadero."),
+			},
+		}),
+		translations.FallbackLanguages(brazilian, european, language.Spanish, language.English),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		message          string
+		expectedMessage  string
+		expectedLanguage language.Tag
+	}{
+		{message.IsBlank, "O valor não deve estar em branco.", brazilian},
+		{message.NotValid, "Este valor não é válido.", european},
+		{message.NotTrue, "Este valor debería ser verdadero.", language.Spanish},
+		{message.NotFalse, message.NotFalse, language.English},
+		{"Custom message.", "Custom message.", brazilian},
+	}
+	for _, test := range tests {
+		t.Run(test.message, func(t *testing.T) {
+			translated, used := translator.TranslateWithLanguage(brazilian, test.message, 0)
+
+			assert.Equal(t, test.expectedMessage, translated)
+			assert.Equal(t, test.expectedLanguage, used)
+		})
+	}
+}
+
+func TestTranslator_TranslateWithLanguage_WhenFallbackLanguagesOfParent_ExpectParentChainUsed(t *testing.T) {
+	translator, err := translations.NewTranslator(
+		translations.SetTranslations(german.Messages),
+		translations.FallbackLanguages(language.Portuguese, language.German),
+	)
+	require.NoError(t, err)
+
+	translated, used := translator.TranslateWithLanguage(language.MustParse("pt-AO"), message.NotValid, 0)
+
+	assert.Equal(t, "Dieser Wert ist ungültig.", translated)
+	assert.Equal(t, language.German, used)
+}
+
+func TestTranslator_TranslateWithLanguage_WhenNoExactLanguage_ExpectBestMatchUsed(t *testing.T) {
+	serbian := language.Serbian
+	translator, err := translations.NewTranslator(
+		translations.SetTranslations(russian.Messages),
+		translations.SetTranslations(german.Messages),
+		translations.SetTranslations(map[language.Tag]map[string]catalog.Message{
+			serbian: {message.NotValid: catalog.String("Ова вредност није валидна.")},
+		}),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		tag              string
+		expectedMessage  string
+		expectedLanguage language.Tag
+	}{
+		{"und", "This value is not valid.", language.English},
+		{"ru-RU", "Значение недопустимо.", language.Russian},
+		{"de-AT", "Dieser Wert ist ungültig.", language.German},
+		{"en-GB", "This value is not valid.", language.English},
+		{"sr-Cyrl", "Ова вредност није валидна.", serbian},
+		{"sr-Latn", "This value is not valid.", language.English},
+		{"fr", "This value is not valid.", language.English},
+	}
+	for _, test := range tests {
+		t.Run(test.tag, func(t *testing.T) {
+			translated, used := translator.TranslateWithLanguage(language.MustParse(test.tag), message.NotValid, 0)
+
+			assert.Equal(t, test.expectedMessage, translated)
+			assert.Equal(t, test.expectedLanguage, used)
+		})
+	}
+}
+
+func TestNewTranslator_WhenFallbackLanguageIsNotLoaded_ExpectError(t *testing.T) {
+	translator, err := translations.NewTranslator(
+		translations.FallbackLanguages(language.MustParse("pt-BR"), language.Spanish),
+	)
+
+	assert.Nil(t, translator)
+	assert.EqualError(t, err, `fallback language is not loaded: missing messages for language "es" used as fallback for "pt-BR"`)
+}
@@ -0,0 +1,91 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.tr.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package turkish contains violation message texts translated into Turkish language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package turkish
+
+import (
+	"github.com/muonsoft/validation/message"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.Turkish: {
+		message.NotBlank:          catalog.String("Bu değer boş olmalıdır."),
+		message.NotDivisible:      catalog.String("Bu değer {{ comparedValue }} değerinin katı olmalıdır."),
+		message.NotDivisibleCount: catalog.String("Bu koleksiyondaki öğe sayısı {{ divisibleBy }} değerinin katı olmalıdır."),
+		message.NotExactCount:     catalog.String("Bu koleksiyon tam olarak {{ limit }} öğe içermelidir."),
+		message.TooFewElements:    catalog.String("Bu koleksiyon {{ limit }} veya daha fazla öğe içermelidir."),
+		message.TooManyElements:   catalog.String("Bu koleksiyon {{ limit }} veya daha az öğe içermelidir."),
+		message.NotEqual:          catalog.String("Bu değer {{ comparedValue }} değerine eşit olmalıdır."),
+		message.NotFalse:          catalog.String("Bu değer yanlış olmalıdır."),
+		message.InvalidDate:       catalog.String("Bu değer geçerli bir tarih değildir."),
+		message.InvalidDateTime:   catalog.String("Bu değer geçerli bir tarih ve saat değildir."),
+		message.InvalidEAN13:      catalog.String("Bu değer geçerli bir EAN-13 değildir."),
+		message.InvalidEAN8:       catalog.String("Bu değer geçerli bir EAN-8 değildir."),
+		message.InvalidEmail:      catalog.String("Bu değer geçerli bir e-posta adresi değildir."),
+		message.InvalidHostname:   catalog.String("Bu değer geçerli bir ana bilgisayar adı değildir."),
+		message.InvalidIP:         catalog.String("Bu geçerli bir IP adresi değildir."),
+		message.InvalidJSON:       catalog.String("Bu değer geçerli bir JSON olmalıdır."),
+		message.InvalidTime:       catalog.String("Bu değer geçerli bir saat değildir."),
+		message.InvalidULID:       catalog.String("Bu geçerli bir ULID değildir."),
+		message.InvalidUPCA:       catalog.String("Bu değer geçerli bir UPC-A değildir."),
+		message.InvalidUPCE:       catalog.String("Bu değer geçerli bir UPC-E değildir."),
+		message.InvalidURL:        catalog.String("Bu değer geçerli bir URL değildir."),
+		message.InvalidUUID:       catalog.String("Bu geçerli bir UUID değildir."),
+		message.NotExactLength:    catalog.String("Bu değer tam olarak {{ limit }} karakter olmalıdır."),
+		message.TooShort:          catalog.String("Bu değer çok kısa. En az {{ limit }} karakter olmalıdır."),
+		message.TooLong:           catalog.String("Bu değer çok uzun. En fazla {{ limit }} karakter olmalıdır."),
+		message.NotNil:            catalog.String("Bu değer nil olmalıdır."),
+		message.NoSuchChoice:      catalog.String("Seçtiğiniz değer geçerli bir seçenek değildir."),
+		message.IsBlank:           catalog.String("Bu değer boş olmamalıdır."),
+		message.IsEqual:           catalog.String("Bu değer {{ comparedValue }} değerine eşit olmamalıdır."),
+		message.NotInRange:        catalog.String("Bu değer {{ min }} ile {{ max }} arasında olmalıdır."),
+		message.NotInteger:        catalog.String("Bu değer bir tam sayı değildir."),
+		message.NotNegative:       catalog.String("Bu değer negatif olmalıdır."),
+		message.NotNegativeOrZero: catalog.String("Bu değer negatif veya sıfır olmalıdır."),
+		message.IsNil:             catalog.String("Bu değer nil olmamalıdır."),
+		message.NotNumeric:        catalog.String("Bu değer sayısal değildir."),
+		message.NotPositive:       catalog.String("Bu değer pozitif olmalıdır."),
+		message.NotPositiveOrZero: catalog.String("Bu değer pozitif veya sıfır olmalıdır."),
+		message.NotUnique:         catalog.String("Bu koleksiyon yalnızca benzersiz öğeler içermelidir."),
+		message.NotValid:          catalog.String("Bu değer geçerli değildir."),
+		message.ProhibitedIP:      catalog.String("Bu IP adresinin kullanılması yasaktır."),
+		message.ProhibitedURL:     catalog.String("Bu URL'nin kullanılması yasaktır."),
+		message.TooEarly:          catalog.String("Bu değer {{ comparedValue }} değerinden sonra olmalıdır."),
+		message.TooEarlyOrEqual:   catalog.String("Bu değer {{ comparedValue }} değerinden sonra veya ona eşit olmalıdır."),
+		message.TooHigh:           catalog.String("Bu değer {{ comparedValue }} değerinden küçük olmalıdır."),
+		message.TooHighOrEqual:    catalog.String("Bu değer {{ comparedValue }} değerinden küçük veya ona eşit olmalıdır."),
+		message.TooLate:           catalog.String("Bu değer {{ comparedValue }} değerinden önce olmalıdır."),
+		message.TooLateOrEqual:    catalog.String("Bu değer {{ comparedValue }} değerinden önce veya ona eşit olmalıdır."),
+		message.TooLow:            catalog.String("Bu değer {{ comparedValue }} değerinden büyük olmalıdır."),
+		message.TooLowOrEqual:     catalog.String("Bu değer {{ comparedValue }} değerinden büyük veya ona eşit olmalıdır."),
+		message.NotTrue:           catalog.String("Bu değer doğru olmalıdır."),
+		message.InvalidType:       catalog.String("Bu değerin tipi {{ type }} olmalıdır."),
+		message.MissingProperty:   catalog.String("Bu alan eksik."),
+		message.UnknownProperty:   catalog.String("Bu alan beklenmiyordu."),
+		message.NotSatisfied:      catalog.String("Bu değer {{ expression }} ifadesini sağlamalıdır."),
+	},
+}
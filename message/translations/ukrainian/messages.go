@@ -0,0 +1,117 @@
+// The source code of the messages is taken from the Symfony Validator component
+// See https://github.com/symfony/validator/blob/5.x/Resources/translations/validators.uk.xlf
+//
+// Copyright (c) 2004-2021 Fabien Potencier
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is furnished
+// to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package ukrainian contains violation message texts translated into Ukrainian language.
+// Values are not protected by backward compatibility rules and can be changed at any time, even in patch versions.
+package ukrainian
+
+import (
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations/internal/plurals"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+var Messages = map[language.Tag]map[string]catalog.Message{
+	language.Ukrainian: {
+		message.NotBlank:          catalog.String("Значення повинно бути порожнім."),
+		message.NotDivisible:      catalog.String("Значення повинно бути кратним {{ comparedValue }}."),
+		message.NotDivisibleCount: catalog.String("Кількість елементів у цій колекції повинна бути кратною {{ divisibleBy }}."),
+		message.NotExactCount: plurals.New(
+			plural.One, "Ця колекція повинна містити рівно {{ limit }} елемент.",
+			plural.Few, "Ця колекція повинна містити рівно {{ limit }} елементи.",
+			plural.Many, "Ця колекція повинна містити рівно {{ limit }} елементів.",
+			plural.Other, "Ця колекція повинна містити рівно {{ limit }} елемента."),
+		message.TooFewElements: plurals.New(
+			plural.One, "Ця колекція повинна містити {{ limit }} елемент або більше.",
+			plural.Few, "Ця колекція повинна містити {{ limit }} елементи або більше.",
+			plural.Many, "Ця колекція повинна містити {{ limit }} елементів або більше.",
+			plural.Other, "Ця колекція повинна містити {{ limit }} елемента або більше."),
+		message.TooManyElements: plurals.New(
+			plural.One, "Ця колекція повинна містити {{ limit }} елемент або менше.",
+			plural.Few, "Ця колекція повинна містити {{ limit }} елементи або менше.",
+			plural.Many, "Ця колекція повинна містити {{ limit }} елементів або менше.",
+			plural.Other, "Ця колекція повинна містити {{ limit }} елемента або менше."),
+		message.NotEqual:        catalog.String("Значення повинно дорівнювати {{ comparedValue }}."),
+		message.NotFalse:        catalog.String("Значення повинно бути хибним."),
+		message.InvalidDate:     catalog.String("Значення не є коректною датою."),
+		message.InvalidDateTime: catalog.String("Значення дати та часу недопустиме."),
+		message.InvalidEAN13:    catalog.String("Значення не є допустимим EAN-13."),
+		message.InvalidEAN8:     catalog.String("Значення не є допустимим EAN-8."),
+		message.InvalidEmail:    catalog.String("Значення адреси електронної пошти недопустиме."),
+		message.InvalidHostname: catalog.String("Значення не є коректним іменем хоста."),
+		message.InvalidIP:       catalog.String("Значення не є допустимою IP-адресою."),
+		message.InvalidJSON:     catalog.String("Значення повинно бути коректним JSON."),
+		message.InvalidTime:     catalog.String("Значення часу недопустиме."),
+		message.InvalidULID:     catalog.String("Значення не відповідає формату ULID."),
+		message.InvalidUPCA:     catalog.String("Значення не є допустимим UPC-A."),
+		message.InvalidUPCE:     catalog.String("Значення не є допустимим UPC-E."),
+		message.InvalidURL:      catalog.String("Значення не є допустимим URL."),
+		message.InvalidUUID:     catalog.String("Значення не відповідає формату UUID."),
+		message.NotExactLength: plurals.New(
+			plural.One, "Значення повинно містити рівно {{ limit }} символ.",
+			plural.Few, "Значення повинно містити рівно {{ limit }} символи.",
+			plural.Many, "Значення повинно містити рівно {{ limit }} символів.",
+			plural.Other, "Значення повинно містити рівно {{ limit }} символу."),
+		message.TooShort: plurals.New(
+			plural.One, "Значення занадто коротке. Повинно містити {{ limit }} символ або більше.",
+			plural.Few, "Значення занадто коротке. Повинно містити {{ limit }} символи або більше.",
+			plural.Many, "Значення занадто коротке. Повинно містити {{ limit }} символів або більше.",
+			plural.Other, "Значення занадто коротке. Повинно містити {{ limit }} символу або більше."),
+		message.TooLong: plurals.New(
+			plural.One, "Значення занадто довге. Повинно містити {{ limit }} символ або менше.",
+			plural.Few, "Значення занадто довге. Повинно містити {{ limit }} символи або менше.",
+			plural.Many, "Значення занадто довге. Повинно містити {{ limit }} символів або менше.",
+			plural.Other, "Значення занадто довге. Повинно містити {{ limit }} символу або менше."),
+		message.NotNil:            catalog.String("Значення повинно бути nil."),
+		message.NoSuchChoice:      catalog.String("Обране вами значення недопустиме."),
+		message.IsBlank:           catalog.String("Значення не повинно бути порожнім."),
+		message.IsEqual:           catalog.String("Значення не повинно дорівнювати {{ comparedValue }}."),
+		message.NotInRange:        catalog.String("Значення повинно бути між {{ min }} та {{ max }}."),
+		message.NotInteger:        catalog.String("Значення не є цілим числом."),
+		message.NotNegative:       catalog.String("Значення повинно бути від'ємним."),
+		message.NotNegativeOrZero: catalog.String("Значення повинно бути від'ємним або дорівнювати нулю."),
+		message.IsNil:             catalog.String("Значення не повинно бути nil."),
+		message.NotNumeric:        catalog.String("Значення не є числовим."),
+		message.NotPositive:       catalog.String("Значення повинно бути додатним."),
+		message.NotPositiveOrZero: catalog.String("Значення повинно бути додатним або дорівнювати нулю."),
+		message.NotUnique:         catalog.String("Ця колекція повинна містити лише унікальні елементи."),
+		message.NotValid:          catalog.String("Значення недопустиме."),
+		message.ProhibitedIP:      catalog.String("Цю IP-адресу заборонено використовувати."),
+		message.ProhibitedURL:     catalog.String("Цю URL-адресу заборонено використовувати."),
+		message.TooEarly:          catalog.String("Значення повинно бути пізніше ніж {{ comparedValue }}."),
+		message.TooEarlyOrEqual:   catalog.String("Значення повинно бути пізніше або дорівнювати {{ comparedValue }}."),
+		message.TooHigh:           catalog.String("Значення повинно бути менше ніж {{ comparedValue }}."),
+		message.TooHighOrEqual:    catalog.String("Значення повинно бути менше або дорівнювати {{ comparedValue }}."),
+		message.TooLate:           catalog.String("Значення повинно бути раніше ніж {{ comparedValue }}."),
+		message.TooLateOrEqual:    catalog.String("Значення повинно бути раніше або дорівнювати {{ comparedValue }}."),
+		message.TooLow:            catalog.String("Значення повинно бути більше ніж {{ comparedValue }}."),
+		message.TooLowOrEqual:     catalog.String("Значення повинно бути більше або дорівнювати {{ comparedValue }}."),
+		message.NotTrue:           catalog.String("Значення повинно бути істинним."),
+		message.InvalidType:       catalog.String("Тип значення повинен бути {{ type }}."),
+		message.MissingProperty:   catalog.String("Це поле відсутнє."),
+		message.UnknownProperty:   catalog.String("Це поле не очікувалось."),
+		message.NotSatisfied:      catalog.String("Значення повинно задовольняти вираз {{ expression }}."),
+	},
+}
@@ -0,0 +1,90 @@
+package translations
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/message/catalog"
+)
+
+type xliffDocument struct {
+	Version string      `xml:"version,attr"`
+	Files   []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	TargetLanguage string       `xml:"target-language,attr"`
+	Units          []xliffUnit  `xml:"body>trans-unit"`
+	Groups         []xliffGroup `xml:"body>group"`
+}
+
+type xliffGroup struct {
+	ResourceType string      `xml:"restype,attr"`
+	Units        []xliffUnit `xml:"trans-unit"`
+}
+
+type xliffUnit struct {
+	ID           string `xml:"id,attr"`
+	ResourceName string `xml:"resname,attr"`
+	Source       string `xml:"source"`
+	Target       string `xml:"target"`
+}
+
+// key returns the message template of the unit. The resource name has priority over the source text.
+func (unit xliffUnit) key() string {
+	if unit.ResourceName != "" {
+		return unit.ResourceName
+	}
+
+	return unit.Source
+}
+
+func readXLIFF(data []byte) (*translationFile, error) {
+	var document xliffDocument
+	err := xml.Unmarshal(data, &document)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid XML: %w", ErrMalformedTranslation, err)
+	}
+	if document.Version != "1.2" {
+		return nil, fmt.Errorf(`%w: unsupported XLIFF version "%s"`, ErrMalformedTranslation, document.Version)
+	}
+
+	file := &translationFile{
+		messages:       map[string]catalog.Message{},
+		indexedPlurals: map[string][]string{},
+	}
+	for _, f := range document.Files {
+		lang := strings.ReplaceAll(f.TargetLanguage, "_", "-")
+		if file.language != "" && lang != file.language {
+			return nil, fmt.Errorf("%w: files with different target languages", ErrMalformedTranslation)
+		}
+		file.language = lang
+
+		for _, unit := range f.Units {
+			if unit.key() == "" {
+				return nil, fmt.Errorf(`%w: unit "%s": empty source`, ErrMalformedTranslation, unit.ID)
+			}
+			if unit.Target != "" {
+				file.messages[unit.key()] = catalog.String(unit.Target)
+			}
+		}
+		for _, group := range f.Groups {
+			if group.ResourceType != "x-gettext-plurals" {
+				return nil, fmt.Errorf(`%w: unsupported group type "%s"`, ErrMalformedTranslation, group.ResourceType)
+			}
+			if len(group.Units) == 0 || group.Units[0].key() == "" {
+				return nil, fmt.Errorf("%w: plural group without source", ErrMalformedTranslation)
+			}
+			translations := make([]string, len(group.Units))
+			for i, unit := range group.Units {
+				translations[i] = unit.Target
+			}
+			if strings.Join(translations, "") != "" {
+				file.indexedPlurals[group.Units[0].key()] = translations
+			}
+		}
+	}
+
+	return file, nil
+}
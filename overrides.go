@@ -0,0 +1,123 @@
+package validation
+
+import "errors"
+
+// OverrideMessage option overrides the default message template of the built-in or registered error.
+// The template is used for every violation created with the error and its default message
+// (see [Error.Message]). Messages set by the constraint methods (e.g. "WithMessage") take precedence
+// over the overridden template, even if they are equal to the default message. The violations created
+// by the custom constraints are overridden if they have the default message and are not marked
+// by the [ViolationBuilder.WithExplicitMessage].
+//
+//	validator, err := validation.NewValidator(
+//	    validation.OverrideMessage(validation.ErrIsBlank, "This field is required."),
+//	)
+func OverrideMessage(err *Error, template string) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		if err == nil {
+			return errOverrideNilError
+		}
+		if options.messageOverrides == nil {
+			options.messageOverrides = make(map[*Error]string)
+		}
+		options.messageOverrides[err] = template
+
+		return nil
+	}
+}
+
+// MapError option replaces the underlying error of every violation created with the error from
+// by the error to. It can be used to globally remap built-in errors to domain errors.
+// If the violation has the default message of the error from and the error to is an [Error],
+// then the message of the error to is used instead. Messages set by the constraint
+// methods (e.g. "WithMessage") are kept as is.
+//
+//	validator, err := validation.NewValidator(
+//	    validation.MapError(validation.ErrIsBlank, ErrRequired),
+//	)
+func MapError(from *Error, to error) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		if from == nil || to == nil {
+			return errMapNilError
+		}
+		if options.errorMappings == nil {
+			options.errorMappings = make(map[*Error]error)
+		}
+		options.errorMappings[from] = to
+
+		return nil
+	}
+}
+
+var (
+	errOverrideNilError = errors.New("override message: error must not be nil")
+	errMapNilError      = errors.New("map error: errors must not be nil")
+)
+
+// overrides are validator-level replacements of the errors and message templates
+// that are applied to the created violations.
+type overrides struct {
+	messages map[*Error]string
+	errors   map[*Error]error
+}
+
+func newOverrides(messages map[*Error]string, errs map[*Error]error) *overrides {
+	if len(messages) == 0 && len(errs) == 0 {
+		return nil
+	}
+
+	return &overrides{messages: messages, errors: errs}
+}
+
+// apply maps the error and overrides the default message template. The explicit message template
+// (see [ViolationBuilder.WithExplicitMessage]) is kept as is.
+func (o *overrides) apply(err error, template string, isExplicitMessage bool) (error, string) {
+	if o == nil {
+		return err, template
+	}
+	if isExplicitMessage {
+		return o.mapError(err), template
+	}
+
+	template, isOverridden := o.overrideMessage(err, template)
+
+	from, ok := err.(*Error)
+	if !ok {
+		return err, template
+	}
+	to, isMapped := o.errors[from]
+	if !isMapped {
+		return err, template
+	}
+	if !isOverridden && template == from.message {
+		if e, ok := to.(*Error); ok {
+			template = e.message
+		}
+	}
+	template, _ = o.overrideMessage(to, template)
+
+	return to, template
+}
+
+func (o *overrides) mapError(err error) error {
+	if from, ok := err.(*Error); ok {
+		if to, isMapped := o.errors[from]; isMapped {
+			return to
+		}
+	}
+
+	return err
+}
+
+func (o *overrides) overrideMessage(err error, template string) (string, bool) {
+	e, ok := err.(*Error)
+	if !ok || template != e.message {
+		return template, false
+	}
+	override, exists := o.messages[e]
+	if !exists {
+		return template, false
+	}
+
+	return override, true
+}
@@ -121,6 +121,74 @@ func (path *PropertyPath) Len() int {
 	return length
 }
 
+// Parent returns the property path without the last element. It returns nil
+// if the path is empty or consists of a single element.
+func (path *PropertyPath) Parent() *PropertyPath {
+	if path == nil {
+		return nil
+	}
+
+	return path.parent
+}
+
+// Last returns the last element of the property path. It returns nil if the path is empty.
+func (path *PropertyPath) Last() PropertyPathElement {
+	if path == nil {
+		return nil
+	}
+
+	return path.value
+}
+
+// Equal reports whether two property paths consist of the same elements.
+// Nil and empty paths are considered equal.
+func (path *PropertyPath) Equal(other *PropertyPath) bool {
+	if path.Len() != other.Len() {
+		return false
+	}
+
+	for a, b := path, other; a != nil; a, b = a.parent, b.parent {
+		if !isEqualElement(a.value, b.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasPrefix tests whether the property path begins with the elements of prefix.
+// Any path has a nil (empty) prefix.
+func (path *PropertyPath) HasPrefix(prefix *PropertyPath) bool {
+	diff := path.Len() - prefix.Len()
+	if diff < 0 {
+		return false
+	}
+
+	base := path
+	for i := 0; i < diff; i++ {
+		base = base.parent
+	}
+
+	return base.Equal(prefix)
+}
+
+// TrimPrefix returns the property path without the provided leading prefix.
+// If the path does not start with prefix, it is returned unchanged.
+func (path *PropertyPath) TrimPrefix(prefix *PropertyPath) *PropertyPath {
+	if prefix.Len() == 0 || !path.HasPrefix(prefix) {
+		return path
+	}
+
+	return NewPropertyPath(path.Elements()[prefix.Len():]...)
+}
+
+// Rebase returns a new [PropertyPath] with all the elements of the current path appended
+// to the end of the base path. It can be used together with [PropertyPath.TrimPrefix]
+// to move violations from one property to another.
+func (path *PropertyPath) Rebase(base *PropertyPath) *PropertyPath {
+	return base.With(path.Elements()...)
+}
+
 // String is used to format property path to a string.
 func (path *PropertyPath) String() string {
 	elements := path.Elements()
@@ -172,6 +240,49 @@ func (path *PropertyPath) UnmarshalText(text []byte) error {
 	return nil
 }
 
+func isEqualElement(a, b PropertyPathElement) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.IsIndex() == b.IsIndex() && a.String() == b.String()
+}
+
+func comparePropertyPaths(a, b *PropertyPath) int {
+	x, y := a.Elements(), b.Elements()
+	for i := 0; i < len(x) && i < len(y); i++ {
+		if c := comparePropertyPathElements(x[i], y[i]); c != 0 {
+			return c
+		}
+	}
+
+	return len(x) - len(y)
+}
+
+func comparePropertyPathElements(a, b PropertyPathElement) int {
+	if a.IsIndex() != b.IsIndex() {
+		if a.IsIndex() {
+			return -1
+		}
+		return 1
+	}
+	if a.IsIndex() {
+		i, errI := strconv.Atoi(a.String())
+		j, errJ := strconv.Atoi(b.String())
+		if errI == nil && errJ == nil {
+			switch {
+			case i < j:
+				return -1
+			case i > j:
+				return 1
+			}
+			return 0
+		}
+	}
+
+	return strings.Compare(a.String(), b.String())
+}
+
 func isIdentifier(s string) bool {
 	if len(s) == 0 {
 		return false
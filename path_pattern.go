@@ -0,0 +1,284 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// PropertyPathPattern is a compiled pattern that can be used to test whether a [PropertyPath]
+// matches it. Use [CompilePropertyPathPattern] or [MustCompilePropertyPathPattern] to create a pattern.
+//
+// Pattern syntax is the same as the [PropertyPath] string representation with additional wildcards:
+//
+//   - "*" as a property name (e.g. "items.*") matches any single element (property name or array index);
+//   - "[*]" matches any single array index;
+//   - "**" as a property name (e.g. "request.**.id") matches any number of elements, including zero.
+//
+// For example, "items[*].price" matches "items[0].price" and "items[15].price",
+// "**.price" matches "price", "product.price" and "items[0].price".
+type PropertyPathPattern struct {
+	pattern  string
+	elements []pathPatternElement
+}
+
+// CompilePropertyPathPattern parses a pattern and, if successful, returns a [PropertyPathPattern]
+// that can be used to match against property paths.
+func CompilePropertyPathPattern(pattern string) (*PropertyPathPattern, error) {
+	parser := pathPatternParser{pattern: pattern}
+	elements, err := parser.Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PropertyPathPattern{pattern: pattern, elements: elements}, nil
+}
+
+// MustCompilePropertyPathPattern is like [CompilePropertyPathPattern] but panics if the pattern
+// cannot be parsed. It simplifies safe initialization of global variables holding compiled patterns.
+func MustCompilePropertyPathPattern(pattern string) *PropertyPathPattern {
+	p, err := CompilePropertyPathPattern(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("compile property path pattern %q: %s", pattern, err))
+	}
+
+	return p
+}
+
+// Match reports whether the property path matches the pattern.
+// Empty pattern matches only the empty (nil) property path.
+func (p *PropertyPathPattern) Match(path *PropertyPath) bool {
+	if p == nil {
+		return path.Len() == 0
+	}
+
+	return matchPathPattern(p.elements, path.Elements())
+}
+
+// String returns the source text used to compile the pattern.
+func (p *PropertyPathPattern) String() string {
+	if p == nil {
+		return ""
+	}
+
+	return p.pattern
+}
+
+type pathPatternElementKind byte
+
+const (
+	matchPropertyName pathPatternElementKind = iota
+	matchArrayIndex
+	matchAnyElement
+	matchAnyIndex
+	matchAnyDepth
+)
+
+type pathPatternElement struct {
+	kind  pathPatternElementKind
+	name  string
+	index int
+}
+
+func (e pathPatternElement) match(element PropertyPathElement) bool {
+	switch e.kind {
+	case matchPropertyName:
+		return !element.IsIndex() && element.String() == e.name
+	case matchArrayIndex:
+		return element.IsIndex() && element.String() == strconv.Itoa(e.index)
+	case matchAnyElement:
+		return true
+	case matchAnyIndex:
+		return element.IsIndex()
+	}
+
+	return false
+}
+
+func matchPathPattern(patterns []pathPatternElement, elements []PropertyPathElement) bool {
+	for len(patterns) > 0 {
+		if patterns[0].kind == matchAnyDepth {
+			for i := 0; i <= len(elements); i++ {
+				if matchPathPattern(patterns[1:], elements[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(elements) == 0 || !patterns[0].match(elements[0]) {
+			return false
+		}
+		patterns = patterns[1:]
+		elements = elements[1:]
+	}
+
+	return len(elements) == 0
+}
+
+type pathPatternParser struct {
+	pattern  string
+	position int
+	elements []pathPatternElement
+}
+
+func (parser *pathPatternParser) Parse() ([]pathPatternElement, error) {
+	if parser.pattern == "" {
+		return nil, nil
+	}
+	if parser.peek() != '[' {
+		if err := parser.parseName(); err != nil {
+			return nil, err
+		}
+	}
+
+	for parser.position < len(parser.pattern) {
+		var err error
+		switch c := parser.peek(); c {
+		case '.':
+			parser.position++
+			err = parser.parseName()
+		case '[':
+			parser.position++
+			err = parser.parseBracket()
+		default:
+			err = parser.newError(c, "unexpected char")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return parser.elements, nil
+}
+
+func (parser *pathPatternParser) parseName() error {
+	start := parser.position
+	for parser.position < len(parser.pattern) {
+		c, size := utf8.DecodeRuneInString(parser.pattern[parser.position:])
+		if c == '.' || c == '[' {
+			break
+		}
+		parser.position += size
+	}
+	name := parser.pattern[start:parser.position]
+
+	switch {
+	case name == "*":
+		parser.add(pathPatternElement{kind: matchAnyElement})
+	case name == "**":
+		parser.add(pathPatternElement{kind: matchAnyDepth})
+	case isIdentifier(name):
+		parser.add(pathPatternElement{kind: matchPropertyName, name: name})
+	case name == "":
+		return parser.newError(parser.peek(), "empty property name")
+	default:
+		return &pathPatternParsingError{pattern: parser.pattern, index: start, message: "invalid property name"}
+	}
+
+	return nil
+}
+
+func (parser *pathPatternParser) parseBracket() error {
+	if parser.peek() == '\'' {
+		return parser.parseQuotedName()
+	}
+
+	end := strings.IndexByte(parser.pattern[parser.position:], ']')
+	if end < 0 {
+		return parser.newError(parser.peek(), "incomplete array index")
+	}
+	value := parser.pattern[parser.position : parser.position+end]
+
+	if value == "*" {
+		parser.add(pathPatternElement{kind: matchAnyIndex})
+	} else {
+		index, err := strconv.ParseUint(value, 10, 0)
+		if err != nil || index > math.MaxInt {
+			return &pathPatternParsingError{
+				pattern: parser.pattern,
+				index:   parser.position,
+				message: "invalid array index: " + value,
+			}
+		}
+		parser.add(pathPatternElement{kind: matchArrayIndex, index: int(index)})
+	}
+	parser.position += end + 1
+
+	return nil
+}
+
+func (parser *pathPatternParser) parseQuotedName() error {
+	parser.position++
+
+	name := strings.Builder{}
+	isEscape := false
+	for parser.position < len(parser.pattern) {
+		c, size := utf8.DecodeRuneInString(parser.pattern[parser.position:])
+		parser.position += size
+		if isEscape {
+			name.WriteRune(c)
+			isEscape = false
+			continue
+		}
+		switch c {
+		case '\\':
+			isEscape = true
+		case '\'':
+			if parser.peek() != ']' {
+				return parser.newError(parser.peek(), "unexpected char")
+			}
+			parser.position++
+			parser.add(pathPatternElement{kind: matchPropertyName, name: name.String()})
+			return nil
+		default:
+			name.WriteRune(c)
+		}
+	}
+
+	return parser.newError(0, "incomplete bracketed property name")
+}
+
+func (parser *pathPatternParser) add(element pathPatternElement) {
+	parser.elements = append(parser.elements, element)
+}
+
+func (parser *pathPatternParser) peek() rune {
+	if parser.position >= len(parser.pattern) {
+		return 0
+	}
+	c, _ := utf8.DecodeRuneInString(parser.pattern[parser.position:])
+
+	return c
+}
+
+func (parser *pathPatternParser) newError(char rune, message string) *pathPatternParsingError {
+	return &pathPatternParsingError{
+		pattern: parser.pattern,
+		index:   parser.position,
+		char:    char,
+		message: message,
+	}
+}
+
+type pathPatternParsingError struct {
+	pattern string
+	index   int
+	char    rune
+	message string
+}
+
+func (err *pathPatternParsingError) Error() string {
+	if err.char == 0 {
+		return fmt.Sprintf("parsing path pattern %q at char #%d: %s", err.pattern, err.index, err.message)
+	}
+
+	return fmt.Sprintf(
+		"parsing path pattern %q at char #%d %q: %s",
+		err.pattern,
+		err.index,
+		err.char,
+		err.message,
+	)
+}
@@ -0,0 +1,92 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertyPathPattern_Match(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{pattern: "", path: "", want: true},
+		{pattern: "", path: "a", want: false},
+		{pattern: "a.b", path: "a.b", want: true},
+		{pattern: "a.b", path: "a.c", want: false},
+		{pattern: "a.b", path: "a.b.c", want: false},
+		{pattern: "a[0]", path: "a[0]", want: true},
+		{pattern: "a[0]", path: "a[1]", want: false},
+		{pattern: "a[0]", path: "a['0']", want: false},
+		{pattern: "['@foo'].bar", path: "['@foo'].bar", want: true},
+		{pattern: `['it\'s']`, path: `['it\'s']`, want: true},
+		{pattern: "items[*].price", path: "items[0].price", want: true},
+		{pattern: "items[*].price", path: "items[15].price", want: true},
+		{pattern: "items[*].price", path: "items.first.price", want: false},
+		{pattern: "items[*].price", path: "items[0].amount", want: false},
+		{pattern: "items.*", path: "items.first", want: true},
+		{pattern: "items.*", path: "items[0]", want: true},
+		{pattern: "items.*", path: "items", want: false},
+		{pattern: "[*]", path: "[3]", want: true},
+		{pattern: "**", path: "", want: true},
+		{pattern: "**", path: "a[0].b", want: true},
+		{pattern: "**.price", path: "price", want: true},
+		{pattern: "**.price", path: "items[0].price", want: true},
+		{pattern: "**.price", path: "items[0].price.currency", want: false},
+		{pattern: "request.**", path: "request", want: true},
+		{pattern: "request.**", path: "request.body.items[0]", want: true},
+		{pattern: "request.**", path: "response.body", want: false},
+		{pattern: "a.**.id", path: "a.id", want: true},
+		{pattern: "a.**.id", path: "a.b[1].c.id", want: true},
+		{pattern: "a.**.id", path: "a.b[1].c.name", want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.pattern+" ~ "+test.path, func(t *testing.T) {
+			pattern, err := validation.CompilePropertyPathPattern(test.pattern)
+			require.NoError(t, err)
+			var path *validation.PropertyPath
+			if test.path != "" {
+				path = parsePath(t, test.path)
+			}
+
+			assert.Equal(t, test.want, pattern.Match(path))
+			assert.Equal(t, test.pattern, pattern.String())
+		})
+	}
+}
+
+func TestCompilePropertyPathPattern_WhenInvalidPattern_ExpectError(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		wantError string
+	}{
+		{pattern: ".a", wantError: `parsing path pattern ".a" at char #0 '.': empty property name`},
+		{pattern: "a.", wantError: `parsing path pattern "a." at char #2: empty property name`},
+		{pattern: "a..b", wantError: `parsing path pattern "a..b" at char #2 '.': empty property name`},
+		{pattern: "a[0", wantError: `parsing path pattern "a[0" at char #2 '0': incomplete array index`},
+		{pattern: "a[x]", wantError: `parsing path pattern "a[x]" at char #2: invalid array index: x`},
+		{pattern: "a[**]", wantError: `parsing path pattern "a[**]" at char #2: invalid array index: **`},
+		{pattern: "a['b", wantError: `parsing path pattern "a['b" at char #4: incomplete bracketed property name`},
+		{pattern: "a['b'c", wantError: `parsing path pattern "a['b'c" at char #5 'c': unexpected char`},
+		{pattern: "a[0]b", wantError: `parsing path pattern "a[0]b" at char #4 'b': unexpected char`},
+		{pattern: "a.@b", wantError: `parsing path pattern "a.@b" at char #2: invalid property name`},
+	}
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			pattern, err := validation.CompilePropertyPathPattern(test.pattern)
+
+			assert.Nil(t, pattern)
+			assert.EqualError(t, err, test.wantError)
+		})
+	}
+}
+
+func TestMustCompilePropertyPathPattern_WhenInvalidPattern_ExpectPanic(t *testing.T) {
+	assert.Panics(t, func() {
+		validation.MustCompilePropertyPathPattern("a..b")
+	})
+}
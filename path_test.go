@@ -322,6 +322,91 @@ func TestPropertyPath_UnmarshalJSON(t *testing.T) {
 	)
 }
 
+func TestPropertyPath_Parent(t *testing.T) {
+	path := validation.NewPropertyPath(validation.PropertyName("top"), validation.ArrayIndex(0))
+
+	assert.Equal(t, "top", path.Parent().String())
+	assert.Nil(t, path.Parent().Parent())
+	assert.Nil(t, (*validation.PropertyPath)(nil).Parent())
+}
+
+func TestPropertyPath_Last(t *testing.T) {
+	path := validation.NewPropertyPath(validation.PropertyName("top"), validation.ArrayIndex(0))
+
+	assert.Equal(t, validation.ArrayIndex(0), path.Last())
+	assert.Nil(t, (*validation.PropertyPath)(nil).Last())
+}
+
+func TestPropertyPath_Equal(t *testing.T) {
+	tests := []struct {
+		a, b *validation.PropertyPath
+		want bool
+	}{
+		{a: nil, b: nil, want: true},
+		{a: nil, b: validation.NewPropertyPath(), want: true},
+		{a: parsePath(t, "a[0].b"), b: parsePath(t, "a[0].b"), want: true},
+		{a: parsePath(t, "a[0].b"), b: parsePath(t, "a[0]"), want: false},
+		{a: parsePath(t, "a[0]"), b: parsePath(t, "a['0']"), want: false},
+		{a: parsePath(t, "a.b"), b: parsePath(t, "a.c"), want: false},
+		{a: parsePath(t, "a"), b: nil, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.a.String()+" == "+test.b.String(), func(t *testing.T) {
+			assert.Equal(t, test.want, test.a.Equal(test.b))
+			assert.Equal(t, test.want, test.b.Equal(test.a))
+		})
+	}
+}
+
+func TestPropertyPath_HasPrefix(t *testing.T) {
+	tests := []struct {
+		path, prefix *validation.PropertyPath
+		want         bool
+	}{
+		{path: nil, prefix: nil, want: true},
+		{path: parsePath(t, "a[0].b"), prefix: nil, want: true},
+		{path: parsePath(t, "a[0].b"), prefix: parsePath(t, "a"), want: true},
+		{path: parsePath(t, "a[0].b"), prefix: parsePath(t, "a[0]"), want: true},
+		{path: parsePath(t, "a[0].b"), prefix: parsePath(t, "a[0].b"), want: true},
+		{path: parsePath(t, "a[0].b"), prefix: parsePath(t, "a[1]"), want: false},
+		{path: parsePath(t, "a[0].b"), prefix: parsePath(t, "a[0].b.c"), want: false},
+		{path: nil, prefix: parsePath(t, "a"), want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.path.String()+" ^ "+test.prefix.String(), func(t *testing.T) {
+			assert.Equal(t, test.want, test.path.HasPrefix(test.prefix))
+		})
+	}
+}
+
+func TestPropertyPath_TrimPrefix(t *testing.T) {
+	tests := []struct {
+		path, prefix *validation.PropertyPath
+		want         string
+	}{
+		{path: nil, prefix: nil, want: ""},
+		{path: parsePath(t, "request.body.items[0]"), prefix: parsePath(t, "request.body"), want: "items[0]"},
+		{path: parsePath(t, "request.body.items[0]"), prefix: parsePath(t, "request.body.items[0]"), want: ""},
+		{path: parsePath(t, "request.body.items[0]"), prefix: parsePath(t, "request.query"), want: "request.body.items[0]"},
+		{path: parsePath(t, "request.body.items[0]"), prefix: nil, want: "request.body.items[0]"},
+	}
+	for _, test := range tests {
+		t.Run(test.path.String()+" - "+test.prefix.String(), func(t *testing.T) {
+			assert.Equal(t, test.want, test.path.TrimPrefix(test.prefix).String())
+		})
+	}
+}
+
+func TestPropertyPath_Rebase(t *testing.T) {
+	path := parsePath(t, "items[0].price")
+
+	rebased := path.Rebase(parsePath(t, "request.body"))
+
+	assert.Equal(t, "request.body.items[0].price", rebased.String())
+	assert.Equal(t, "items[0].price", path.String())
+	assert.Equal(t, "items[0].price", path.Rebase(nil).String())
+}
+
 func BenchmarkPropertyPath_String(b *testing.B) {
 	// cpu: Intel(R) Core(TM) i9-9900K CPU @ 3.60GHz
 	// BenchmarkPropertyPath_String
@@ -438,3 +523,10 @@ func (a *PropertyPathAssertion) bracketPath(path validation.PropertyPath) string
 
 	return s.String()
 }
+
+func parsePath(t *testing.T, s string) *validation.PropertyPath {
+	t.Helper()
+	path := &validation.PropertyPath{}
+	require.NoError(t, path.UnmarshalText([]byte(s)))
+	return path
+}
@@ -0,0 +1,66 @@
+package validation
+
+// RedactedValue is used instead of values of sensitive properties. It replaces the invalid value
+// of the violation and the "{{ value }}" and "{{ comparedValue }}" template parameters, so sensitive
+// values never end up in messages, logs, or serialized violations. Custom template parameters
+// (passed to the "WithMessage" methods of the constraints) are not redacted, so they should not
+// contain the validated values.
+const RedactedValue = "[REDACTED]"
+
+// redactedParameters are the keys of the template parameters that may contain the validated values.
+// The compared value is redacted as well, because it is often a sensitive value of another property
+// (e.g. a password and its confirmation).
+var redactedParameters = map[string]bool{
+	"{{ value }}":         true,
+	"{{ comparedValue }}": true,
+}
+
+// redaction is a policy of hiding values of sensitive properties.
+type redaction struct {
+	isSensitive bool
+	paths       []*PropertyPathPattern
+}
+
+func (r redaction) isRedacted(path *PropertyPath) bool {
+	if r.isSensitive {
+		return true
+	}
+	for _, pattern := range r.paths {
+		if pattern.Match(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r redaction) apply(
+	path *PropertyPath,
+	parameters []TemplateParameter,
+	invalidValue any,
+) ([]TemplateParameter, any) {
+	if !r.isRedacted(path) {
+		return parameters, invalidValue
+	}
+
+	redacted := make([]TemplateParameter, len(parameters))
+	for i, parameter := range parameters {
+		if redactedParameters[parameter.Key] {
+			parameter.Value = RedactedValue
+			parameter.NeedsTranslation = false
+			parameter.RawValue = nil
+		}
+		redacted[i] = parameter
+	}
+	if invalidValue != nil {
+		invalidValue = RedactedValue
+	}
+
+	return redacted, invalidValue
+}
+
+func (r redaction) sensitive() redaction {
+	r.isSensitive = true
+
+	return r
+}
@@ -0,0 +1,27 @@
+// Package rules contains a loader of declarative validation rules from YAML or JSON documents.
+// It allows changing rules like maximum lengths or allowed choices per deployment without code changes.
+//
+// The document is a list of property paths mapped to the constraints, each constraint is defined
+// by its name in the [Registry] and by its parameters:
+//
+//	# rules.yaml
+//	- path: title
+//	  rules:
+//	    - notBlank: {}
+//	    - length: {max: 255}
+//	- path: tags[*]
+//	  rules:
+//	    - choice: {values: [news, sport]}
+//
+// The paths have the same syntax as the string representation of [validation.PropertyPath] with
+// an additional "[*]" wildcard that matches every element of an array. Rules are applied to structs
+// (properties are found by the names from the "json" tags or by the names of the fields) and to dynamic
+// values decoded from JSON (map[string]any, []any and scalar values).
+//
+//	set, err := rules.Parse(data)
+//	// ...
+//	err = validator.Validate(ctx, rules.Value(article, set))
+//
+// The document itself is checked while parsing: unknown constraints are reported by
+// [validation.ConstraintNotFoundError], invalid parameters and paths are reported as well.
+package rules
@@ -0,0 +1,51 @@
+package rules_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/rules"
+	"github.com/muonsoft/validation/validator"
+)
+
+func ExampleParse() {
+	set, err := rules.Parse([]byte(`
+- path: title
+  rules:
+    - notBlank: {}
+    - length: {max: 20}
+- path: tags[*]
+  rules:
+    - choice: {values: [news, sport]}
+`))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	type Post struct {
+		Title string   `json:"title"`
+		Tags  []string `json:"tags"`
+	}
+	post := Post{Title: "", Tags: []string{"news", "music"}}
+
+	err = validator.Validate(context.Background(), rules.Value(post, set))
+
+	if violations, ok := validation.UnwrapViolationList(err); ok {
+		for violation := range violations.Values() {
+			fmt.Println(violation)
+		}
+	}
+	// Output:
+	// violation at "title": "This value should not be blank."
+	// violation at "tags[1]": "The value you selected is not a valid choice."
+}
+
+func ExampleParse_unknownConstraint() {
+	_, err := rules.Parse([]byte(`[{path: title, rules: [notBlank, {length: {max: 20}}, unique]}]`))
+
+	fmt.Println(err)
+	// Output:
+	// rules[0] "title": constraint by key "unique" is not found
+}
@@ -0,0 +1,329 @@
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/expression"
+	"github.com/muonsoft/validation/it"
+)
+
+// Factory creates a constraint from the parameters defined in the rules document. The constraint
+// must implement at least one of the typed constraint interfaces: [validation.NilConstraint],
+// [validation.BoolConstraint], [validation.NumberConstraint] of float64, [validation.StringConstraint],
+// [validation.ComparableConstraint] of string or float64, [validation.CountableConstraint],
+// [validation.TimeConstraint] or [validation.Constraint] of any. The constraint is used for the values
+// of the matching types, the [validation.Constraint] of any is used for all not nil values.
+type Factory func(params Params) (any, error)
+
+// Params are the parameters of the constraint defined in the rules document.
+type Params map[string]any
+
+// Decode decodes the parameters into the target using the rules of the [json.Unmarshal] function.
+// Unknown parameters are reported as an error.
+func (params Params) Decode(target any) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("encode parameters: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("decode parameters: %w", err)
+	}
+
+	return nil
+}
+
+// Registry is a registry of named constraints used by the rules documents. It is safe for concurrent use.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// NewRegistry creates a registry with the built-in constraints:
+//
+//   - notBlank {allowNil: bool} - [it.IsNotBlank];
+//   - blank - [it.IsBlank];
+//   - notNil - [it.IsNotNil];
+//   - length {min: int, max: int} - [it.HasMinLength], [it.HasMaxLength] or [it.HasLengthBetween];
+//   - count {min: int, max: int} - [it.HasMinCount], [it.HasMaxCount] or [it.HasCountBetween];
+//   - range {min: number, max: number} - [it.IsGreaterThanOrEqual], [it.IsLessThanOrEqual] or [it.IsBetween];
+//   - choice {values: [string or number, ...]} - [it.IsOneOf];
+//   - regex {pattern: string, match: bool} - [it.Matches] or [it.DoesNotMatch] if match is false;
+//   - email - [it.IsEmail];
+//   - url {schemas: [string, ...], relative: bool} - [it.IsURL];
+//   - uuid - [it.IsUUID];
+//   - ip {version: 4 or 6} - [it.IsIP], [it.IsIPv4] or [it.IsIPv6];
+//   - hostname - [it.IsHostname];
+//   - dateTime {layout: string} - [it.IsDateTime];
+//   - date - [it.IsDate];
+//   - satisfies {expression: string} - [it.Satisfies], the properties of the value are the variables
+//     of the expression (see [expression.Compile] for the syntax).
+func NewRegistry() *Registry {
+	return &Registry{factories: map[string]Factory{
+		"notBlank":  newNotBlank,
+		"blank":     withoutParams(it.IsBlankNumber[float64]()),
+		"notNil":    withoutParams(it.IsNotNilNumber[float64]()),
+		"length":    newLength,
+		"count":     newCount,
+		"range":     newRange,
+		"choice":    newChoice,
+		"regex":     newRegex,
+		"email":     withoutParams(it.IsEmail()),
+		"url":       newURL,
+		"uuid":      withoutParams(it.IsUUID()),
+		"ip":        newIP,
+		"hostname":  withoutParams(it.IsHostname()),
+		"dateTime":  newDateTime,
+		"date":      withoutParams(it.IsDate()),
+		"satisfies": newSatisfies,
+	}}
+}
+
+// Register registers the constraint factory by the name. The factory registered earlier
+// with the same name (including the built-in one) is replaced.
+func (registry *Registry) Register(name string, factory Factory) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.factories[name] = factory
+}
+
+// Create creates the constraint by the name and the parameters. If the constraint is not registered,
+// then the [validation.ConstraintNotFoundError] is returned.
+func (registry *Registry) Create(name string, params Params) (any, error) {
+	registry.mu.RLock()
+	factory, exists := registry.factories[name]
+	registry.mu.RUnlock()
+
+	if !exists {
+		return nil, &validation.ConstraintNotFoundError{Key: name}
+	}
+	constraint, err := factory(params)
+	if err != nil {
+		return nil, fmt.Errorf(`constraint "%s": %w`, name, err)
+	}
+	if !isSupportedConstraint(constraint) {
+		return nil, fmt.Errorf(`constraint "%s": %w: %T`, name, errUnsupportedConstraint, constraint)
+	}
+
+	return constraint, nil
+}
+
+var defaultRegistry = NewRegistry()
+
+// Register registers the constraint factory in the default registry that is used by [Parse].
+func Register(name string, factory Factory) {
+	defaultRegistry.Register(name, factory)
+}
+
+var (
+	errNoLimits              = errors.New("at least one of the parameters min or max must be set")
+	errNoValues              = errors.New("values must contain strings or numbers")
+	errUnsupportedConstraint = errors.New("constraint does not implement any of the supported interfaces")
+)
+
+func withoutParams(constraint any) Factory {
+	return func(params Params) (any, error) {
+		if err := params.Decode(&struct{}{}); err != nil {
+			return nil, err
+		}
+		return constraint, nil
+	}
+}
+
+func newNotBlank(params Params) (any, error) {
+	var p struct {
+		AllowNil bool `json:"allowNil"`
+	}
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	if p.AllowNil {
+		return it.IsNotBlankNumber[float64]().WithAllowedNil(), nil
+	}
+
+	return it.IsNotBlankNumber[float64](), nil
+}
+
+type limits[T any] struct {
+	Min *T `json:"min"`
+	Max *T `json:"max"`
+}
+
+func newLength(params Params) (any, error) {
+	var p limits[int]
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.Min != nil && p.Max != nil:
+		return it.HasLengthBetween(*p.Min, *p.Max), nil
+	case p.Min != nil:
+		return it.HasMinLength(*p.Min), nil
+	case p.Max != nil:
+		return it.HasMaxLength(*p.Max), nil
+	}
+
+	return nil, errNoLimits
+}
+
+func newCount(params Params) (any, error) {
+	var p limits[int]
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.Min != nil && p.Max != nil:
+		return it.HasCountBetween(*p.Min, *p.Max), nil
+	case p.Min != nil:
+		return it.HasMinCount(*p.Min), nil
+	case p.Max != nil:
+		return it.HasMaxCount(*p.Max), nil
+	}
+
+	return nil, errNoLimits
+}
+
+func newRange(params Params) (any, error) {
+	var p limits[float64]
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.Min != nil && p.Max != nil:
+		return it.IsBetween(*p.Min, *p.Max), nil
+	case p.Min != nil:
+		return it.IsGreaterThanOrEqual(*p.Min), nil
+	case p.Max != nil:
+		return it.IsLessThanOrEqual(*p.Max), nil
+	}
+
+	return nil, errNoLimits
+}
+
+func newChoice(params Params) (any, error) {
+	var p struct {
+		Values []any `json:"values"`
+	}
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	if len(p.Values) == 0 {
+		return nil, errNoValues
+	}
+
+	strs := make([]string, 0, len(p.Values))
+	numbers := make([]float64, 0, len(p.Values))
+	for _, value := range p.Values {
+		switch v := value.(type) {
+		case string:
+			strs = append(strs, v)
+		case float64:
+			numbers = append(numbers, v)
+		default:
+			return nil, errNoValues
+		}
+	}
+	if len(strs) > 0 && len(numbers) > 0 {
+		return nil, errNoValues
+	}
+	if len(numbers) > 0 {
+		return it.IsOneOf(numbers...), nil
+	}
+
+	return it.IsOneOf(strs...), nil
+}
+
+func newRegex(params Params) (any, error) {
+	var p struct {
+		Pattern string `json:"pattern"`
+		Match   *bool  `json:"match"`
+	}
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	regex, err := regexp.Compile(p.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("compile pattern: %w", err)
+	}
+	if p.Match != nil && !*p.Match {
+		return it.DoesNotMatch(regex), nil
+	}
+
+	return it.Matches(regex), nil
+}
+
+func newURL(params Params) (any, error) {
+	var p struct {
+		Schemas  []string `json:"schemas"`
+		Relative bool     `json:"relative"`
+	}
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	constraint := it.IsURL()
+	if len(p.Schemas) > 0 {
+		constraint = constraint.WithSchemas(p.Schemas...)
+	}
+	if p.Relative {
+		constraint = constraint.WithRelativeSchema()
+	}
+
+	return constraint, nil
+}
+
+func newIP(params Params) (any, error) {
+	var p struct {
+		Version int `json:"version"`
+	}
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	switch p.Version {
+	case 0:
+		return it.IsIP(), nil
+	case 4:
+		return it.IsIPv4(), nil
+	case 6:
+		return it.IsIPv6(), nil
+	}
+
+	return nil, fmt.Errorf("unknown IP version %d", p.Version)
+}
+
+func newDateTime(params Params) (any, error) {
+	var p struct {
+		Layout string `json:"layout"`
+	}
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	if p.Layout == "" {
+		p.Layout = time.RFC3339
+	}
+
+	return it.IsDateTime().WithLayout(p.Layout), nil
+}
+
+func newSatisfies(params Params) (any, error) {
+	var p struct {
+		Expression string `json:"expression"`
+	}
+	if err := params.Decode(&p); err != nil {
+		return nil, err
+	}
+	e, err := expression.Compile(p.Expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return it.Satisfies(e), nil
+}
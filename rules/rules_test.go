@@ -0,0 +1,287 @@
+package rules_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/expression"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/rules"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const articleRules = `
+- path: title
+  rules:
+    - notBlank: {}
+    - length: {max: 10}
+- path: status
+  rules:
+    - choice: {values: [draft, published]}
+- path: rating
+  rules:
+    - range: {min: 1, max: 5}
+- path: tags
+  rules:
+    - count: {max: 2}
+- path: tags[*]
+  rules:
+    - notBlank
+- path: author.email
+  rules:
+    - email
+- path: comments[*].text
+  rules:
+    - length: {min: 3}
+`
+
+type Status string
+
+type Author struct {
+	Email string `json:"email"`
+}
+
+type Comment struct {
+	Text string
+}
+
+type Article struct {
+	Title     string    `json:"title"`
+	Status    Status    `json:"status"`
+	Rating    *int      `json:"rating,omitempty"`
+	Tags      []string  `json:"tags"`
+	Author    *Author   `json:"author"`
+	Comments  []Comment `json:"comments"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func TestSet_WhenStructIsValid_ExpectNoViolations(t *testing.T) {
+	set, err := rules.Parse([]byte(articleRules))
+	require.NoError(t, err)
+	rating := 3
+	article := Article{
+		Title:    "Go",
+		Status:   "draft",
+		Rating:   &rating,
+		Tags:     []string{"go"},
+		Author:   &Author{Email: "author@example.com"},
+		Comments: []Comment{{Text: "Nice!"}},
+	}
+
+	err = validator.Validate(context.Background(), rules.Value(article, set))
+
+	assert.NoError(t, err)
+}
+
+func TestSet_WhenStructIsInvalid_ExpectViolationsAtPaths(t *testing.T) {
+	set, err := rules.Parse([]byte(articleRules))
+	require.NoError(t, err)
+	rating := 10
+	article := &Article{
+		Title:    "Long article title",
+		Status:   "archived",
+		Rating:   &rating,
+		Tags:     []string{"go", "", "news"},
+		Author:   &Author{Email: "invalid"},
+		Comments: []Comment{{Text: "Nice!"}, {Text: "no"}},
+	}
+
+	err = validator.Validate(context.Background(), rules.Value(article, set))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrTooLong, PropertyPath: "title"},
+		validationtest.ViolationAttributes{Error: validation.ErrNoSuchChoice, PropertyPath: "status"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotInRange, PropertyPath: "rating"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooManyElements, PropertyPath: "tags"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "tags[1]"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidEmail, PropertyPath: "author.email"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort, PropertyPath: "comments[1].text"},
+	)
+}
+
+func TestSet_WhenValuesAreMissing_ExpectNilValidated(t *testing.T) {
+	set, err := rules.Parse([]byte(articleRules))
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), rules.Value(Article{Status: "draft"}, set))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrIsBlank).
+		WithPropertyPath("title")
+}
+
+func TestSet_WhenDynamicDocument_ExpectViolationsAtPaths(t *testing.T) {
+	set, err := rules.Parse([]byte(articleRules))
+	require.NoError(t, err)
+	var document any
+	err = json.Unmarshal([]byte(`{
+		"title": "",
+		"status": "draft",
+		"rating": 0,
+		"tags": ["go", ""],
+		"author": {"email": "author@example.com"},
+		"comments": [{"text": "ok"}]
+	}`), &document)
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), rules.Value(document, set))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "title"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotInRange, PropertyPath: "rating"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "tags[1]"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooShort, PropertyPath: "comments[0].text"},
+	)
+}
+
+func TestSet_WhenJSONDocument_ExpectRulesParsed(t *testing.T) {
+	set, err := rules.Parse([]byte(`[
+		{"path": "items[0].price", "rules": [{"range": {"min": 0}}]},
+		{"path": "website", "rules": [{"url": {"schemas": ["https"]}}]}
+	]`))
+	require.NoError(t, err)
+	document := map[string]any{
+		"items":   []any{map[string]any{"price": -1.5}},
+		"website": "http://example.com",
+	}
+
+	err = validator.Validate(context.Background(), rules.Value(document, set))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrTooLowOrEqual, PropertyPath: "items[0].price"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidURL, PropertyPath: "website"},
+	)
+}
+
+func TestSet_WhenConstraintDoesNotSupportValueType_ExpectInvalidTypeViolation(t *testing.T) {
+	set, err := rules.Parse([]byte(`[{path: title, rules: [length: {max: 5}]}]`))
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), rules.Value(map[string]any{"title": 123}, set))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrInvalidType).
+		WithMessage("This value should be of type string.").
+		WithPropertyPath("title")
+}
+
+func TestSet_WhenSatisfiesRule_ExpectExpressionEvaluatedForValue(t *testing.T) {
+	set, err := rules.Parse([]byte(`
+- path: items[*]
+  rules:
+    - satisfies: {expression: "discount <= price * 0.5"}
+`))
+	require.NoError(t, err)
+	var document any
+	err = json.Unmarshal([]byte(`{"items": [
+		{"price": 100, "discount": 50},
+		{"price": 100, "discount": 60}
+	]}`), &document)
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), rules.Value(document, set))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotSatisfied).
+		WithMessage(`This value should satisfy the expression discount <= price * 0.5.`).
+		WithPropertyPath("items[1]").
+		Assert(func(tb testing.TB, violation validation.Violation) {
+			tb.Helper()
+			assert.Equal(tb, map[string]any{"price": 100.0, "discount": 60.0}, violation.InvalidValue())
+		})
+}
+
+func TestRegistry_Parse_WhenSatisfiesRuleHasInvalidExpression_ExpectError(t *testing.T) {
+	_, err := rules.Parse([]byte(`[{path: items, rules: [{satisfies: {expression: "price >"}}]}]`))
+
+	var syntaxErr *expression.SyntaxError
+	assert.ErrorAs(t, err, &syntaxErr)
+	assert.ErrorContains(t, err, `rules[0] "items": constraint "satisfies": `)
+}
+
+func TestSet_WhenStructHasNoField_ExpectError(t *testing.T) {
+	set, err := rules.Parse([]byte(`[{path: author.name, rules: [notBlank]}]`))
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), rules.Value(Article{Author: &Author{}}, set))
+
+	assert.ErrorIs(t, err, rules.ErrFieldNotFound)
+	assert.EqualError(t, err, `rule "author.name": field not found: "name" in rules_test.Author`)
+}
+
+func TestRegistry_WhenCustomConstraintRegistered_ExpectItUsed(t *testing.T) {
+	registry := rules.NewRegistry()
+	registry.Register("prefix", func(params rules.Params) (any, error) {
+		var p struct {
+			Value string `json:"value"`
+		}
+		if err := params.Decode(&p); err != nil {
+			return nil, err
+		}
+		return validation.OfStringBy(func(s string) bool {
+			return len(s) >= len(p.Value) && s[:len(p.Value)] == p.Value
+		}), nil
+	})
+	set, err := registry.Parse([]byte(`[{path: sku, rules: [{prefix: {value: "SKU-"}}]}]`))
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), rules.Value(map[string]any{"sku": "123"}, set))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotValid).
+		WithPropertyPath("sku")
+}
+
+func TestRegistry_Parse_WhenDocumentIsInvalid_ExpectAllErrors(t *testing.T) {
+	_, err := rules.Parse([]byte(`
+- path: title
+  rules:
+    - lenght: {max: 10}
+    - length: {maximum: 10}
+- path: ""
+  rules: [notBlank]
+- path: tags
+  rules:
+    - choice: {values: []}
+    - {notBlank: {}, email: {}}
+- path: "items[0"
+  rules: [notBlank]
+- path: status
+  rules: []
+`))
+
+	var notFound *validation.ConstraintNotFoundError
+	require.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "lenght", notFound.Key)
+	assert.EqualError(t, err, `rules[0] "title": constraint by key "lenght" is not found
+rules[0] "title": constraint "length": decode parameters: json: unknown field "maximum"
+rules[1] "": path must not be empty
+rules[2] "tags": constraint "choice": values must contain strings or numbers
+rules[2] "tags": constraint must be defined by a name or by an object like {name: {parameters}}
+rules[3] "items[0": parse path: parsing path element #1: incomplete array index
+rules[4] "status": rules must not be empty`)
+}
+
+func TestRegistry_Parse_WhenUnknownField_ExpectError(t *testing.T) {
+	_, err := rules.Parse([]byte(`[{path: title, constraints: [notBlank]}]`))
+
+	assert.ErrorContains(t, err, "field constraints not found")
+}
+
+func TestRegistry_Create_WhenFactoryReturnsUnsupportedConstraint_ExpectError(t *testing.T) {
+	registry := rules.NewRegistry()
+	registry.Register("unsupported", func(params rules.Params) (any, error) {
+		return it.IsEqualTo(struct{}{}), nil
+	})
+
+	_, err := registry.Create("unsupported", nil)
+
+	assert.ErrorContains(t, err, `constraint "unsupported": constraint does not implement any of the supported interfaces`)
+}
@@ -0,0 +1,395 @@
+package rules
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/internal/dynamic"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrFieldNotFound is returned by [Set.Validate] when the struct has no field for the property
+// from the rules document.
+var ErrFieldNotFound = errors.New("field not found")
+
+// Set is a set of rules parsed from the rules document. It implements the [validation.Constraint]
+// interface for the type any, so it can be used with the [validation.This] argument or via the [Value] argument.
+type Set struct {
+	rules []rule
+}
+
+type rule struct {
+	path        string
+	segments    [][]validation.PropertyPathElement
+	constraints []any
+}
+
+type document []struct {
+	Path  string `yaml:"path"`
+	Rules []any  `yaml:"rules"`
+}
+
+// Parse parses the rules document in YAML or JSON format using the default registry.
+// See [Registry.Parse] for details.
+func Parse(data []byte) (*Set, error) {
+	return defaultRegistry.Parse(data)
+}
+
+// Parse parses the rules document in YAML or JSON format. Constraints are created by the factories
+// from the registry. A constraint is defined by its name with the parameters (e.g. {length: {max: 255}})
+// or by its name only (e.g. "email"). All the problems in the document are returned as a single error
+// joined by [errors.Join], each problem is prefixed by the position of the rule. Unknown constraints
+// are reported by [validation.ConstraintNotFoundError].
+func (registry *Registry) Parse(data []byte) (*Set, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var doc document
+	if err := decoder.Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decode rules: %w", err)
+	}
+
+	set := &Set{rules: make([]rule, 0, len(doc))}
+	errs := make([]error, 0)
+	for i, entry := range doc {
+		r, ruleErrs := registry.parseRule(entry.Path, entry.Rules)
+		for _, err := range ruleErrs {
+			errs = append(errs, fmt.Errorf(`rules[%d] "%s": %w`, i, entry.Path, err))
+		}
+		if len(ruleErrs) == 0 {
+			set.rules = append(set.rules, r)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return set, nil
+}
+
+func (registry *Registry) parseRule(path string, definitions []any) (rule, []error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return rule{}, []error{err}
+	}
+	if len(definitions) == 0 {
+		return rule{}, []error{errNoConstraints}
+	}
+
+	r := rule{path: path, segments: segments, constraints: make([]any, 0, len(definitions))}
+	errs := make([]error, 0)
+	for _, definition := range definitions {
+		name, params, err := parseDefinition(definition)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		constraint, err := registry.Create(name, params)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		r.constraints = append(r.constraints, constraint)
+	}
+
+	return r, errs
+}
+
+func parseDefinition(definition any) (string, Params, error) {
+	if name, ok := definition.(string); ok {
+		return name, nil, nil
+	}
+	m, ok := definition.(map[string]any)
+	if !ok || len(m) != 1 {
+		return "", nil, errInvalidDefinition
+	}
+	for name, value := range m {
+		if value == nil {
+			return name, nil, nil
+		}
+		params, ok := value.(map[string]any)
+		if !ok {
+			return "", nil, fmt.Errorf(`constraint "%s": %w`, name, errInvalidParams)
+		}
+		return name, params, nil
+	}
+
+	return "", nil, errInvalidDefinition
+}
+
+// parsePath splits the path into the segments separated by the "[*]" wildcards.
+func parsePath(path string) ([][]validation.PropertyPathElement, error) {
+	if path == "" {
+		return nil, errEmptyPath
+	}
+
+	parts := strings.Split(path, "[*]")
+	segments := make([][]validation.PropertyPathElement, len(parts))
+	for i, part := range parts {
+		part = strings.TrimPrefix(part, ".")
+		if part == "" {
+			continue
+		}
+		var p validation.PropertyPath
+		if err := p.UnmarshalText([]byte(part)); err != nil {
+			return nil, fmt.Errorf("parse path: %w", err)
+		}
+		segments[i] = p.Elements()
+	}
+
+	return segments, nil
+}
+
+var (
+	errEmptyPath         = errors.New("path must not be empty")
+	errNoConstraints     = errors.New("rules must not be empty")
+	errInvalidDefinition = errors.New(`constraint must be defined by a name or by an object like {name: {parameters}}`)
+	errInvalidParams     = errors.New("parameters must be an object")
+)
+
+// Value argument is used to validate the struct or the dynamic value by the set of rules.
+func Value(value any, set *Set) validation.ValidatorArgument {
+	return validation.This[any](value, set)
+}
+
+// Validate applies the rules to the value. Values are found by the paths of the rules,
+// violations are created at these paths. Missing values (nil pointers, absent keys of the maps
+// or indexes out of range) are validated as nil. If the struct has no field for the property,
+// then the error wrapping [ErrFieldNotFound] is returned.
+func (set *Set) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	violations := validation.NewViolationList()
+
+	for _, r := range set.rules {
+		err := violations.AppendFromError(r.apply(ctx, validator, value, r.segments))
+		if err != nil {
+			return fmt.Errorf(`rule "%s": %w`, r.path, err)
+		}
+	}
+
+	return violations.AsError()
+}
+
+func (r rule) apply(
+	ctx context.Context,
+	validator *validation.Validator,
+	value any,
+	segments [][]validation.PropertyPathElement,
+) error {
+	value, validator, err := resolve(validator, value, segments[0])
+	if err != nil {
+		return err
+	}
+	if len(segments) == 1 {
+		return r.validate(ctx, validator, value)
+	}
+
+	elements, _ := dynamic.ToArray(value)
+	violations := validation.NewViolationList()
+	for i, element := range elements {
+		err := violations.AppendFromError(r.apply(ctx, validator.AtIndex(i), element, segments[1:]))
+		if err != nil {
+			return err
+		}
+	}
+
+	return violations.AsError()
+}
+
+func (r rule) validate(ctx context.Context, validator *validation.Validator, value any) error {
+	violations := validation.NewViolationList()
+
+	for _, constraint := range r.constraints {
+		if err := violations.AppendFromError(validate(ctx, validator, value, constraint)); err != nil {
+			return err
+		}
+	}
+
+	return violations.AsError()
+}
+
+// resolve finds the value by the path elements and returns the validator scoped to the path.
+func resolve(
+	validator *validation.Validator,
+	value any,
+	elements []validation.PropertyPathElement,
+) (any, *validation.Validator, error) {
+	for _, element := range elements {
+		value = dynamic.Indirect(value)
+		if index, isIndex := element.(validation.ArrayIndex); isIndex {
+			validator = validator.AtIndex(int(index))
+			value = elementAt(value, int(index))
+			continue
+		}
+
+		name := element.String()
+		validator = validator.AtProperty(name)
+		if value == nil {
+			continue
+		}
+		if object, ok := dynamic.ToObject(value); ok {
+			value = object[name]
+			continue
+		}
+		if reflect.ValueOf(value).Kind() != reflect.Struct {
+			value = nil
+			continue
+		}
+		field, found := dynamic.Field(value, name)
+		if !found {
+			return nil, nil, fmt.Errorf(`%w: "%s" in %T`, ErrFieldNotFound, name, value)
+		}
+		value = field
+	}
+
+	return dynamic.Indirect(value), validator, nil
+}
+
+func elementAt(value any, index int) any {
+	elements, ok := dynamic.ToArray(value)
+	if !ok || index < 0 || index >= len(elements) {
+		return nil
+	}
+
+	return elements[index]
+}
+
+// validate passes the value to the constraint by the interface matching the type of the value.
+//
+//nolint:gocyclo,cyclop
+func validate(ctx context.Context, validator *validation.Validator, value any, constraint any) error {
+	if value == nil {
+		return validateNil(ctx, validator, constraint)
+	}
+	if c, ok := constraint.(validation.Constraint[any]); ok {
+		return c.Validate(ctx, validator, value)
+	}
+	// values of the named types (e.g. type Status string) are passed as the values of the underlying types
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.String:
+		if _, isNumber := value.(json.Number); !isNumber {
+			value = v.String()
+		}
+	case reflect.Bool:
+		value = v.Bool()
+	}
+
+	switch v := value.(type) {
+	case bool:
+		if c, ok := constraint.(validation.BoolConstraint); ok {
+			return c.ValidateBool(ctx, validator, &v)
+		}
+	case string:
+		if c, ok := constraint.(validation.StringConstraint); ok {
+			return c.ValidateString(ctx, validator, &v)
+		}
+		if c, ok := constraint.(validation.ComparableConstraint[string]); ok {
+			return c.ValidateComparable(ctx, validator, &v)
+		}
+	case time.Time:
+		if c, ok := constraint.(validation.TimeConstraint); ok {
+			return c.ValidateTime(ctx, validator, &v)
+		}
+	default:
+		if number, ok := dynamic.ToFloat(value); ok {
+			if c, ok := constraint.(validation.NumberConstraint[float64]); ok {
+				return c.ValidateNumber(ctx, validator, &number)
+			}
+			if c, ok := constraint.(validation.ComparableConstraint[float64]); ok {
+				return c.ValidateComparable(ctx, validator, &number)
+			}
+		} else if count, ok := countOf(value); ok {
+			if c, ok := constraint.(validation.CountableConstraint); ok {
+				return c.ValidateCountable(ctx, validator, count)
+			}
+		}
+	}
+
+	return validator.BuildViolation(ctx, validation.ErrInvalidType, validation.ErrInvalidType.Message()).
+		WithParameter("{{ type }}", strings.Join(supportedTypes(constraint), " or ")).
+		WithInvalidValue(value).
+		Create()
+}
+
+func validateNil(ctx context.Context, validator *validation.Validator, constraint any) error {
+	switch c := constraint.(type) {
+	case validation.NilConstraint:
+		return c.ValidateNil(ctx, validator, true)
+	case validation.StringConstraint:
+		return c.ValidateString(ctx, validator, nil)
+	case validation.NumberConstraint[float64]:
+		return c.ValidateNumber(ctx, validator, nil)
+	case validation.ComparableConstraint[string]:
+		return c.ValidateComparable(ctx, validator, nil)
+	case validation.ComparableConstraint[float64]:
+		return c.ValidateComparable(ctx, validator, nil)
+	case validation.BoolConstraint:
+		return c.ValidateBool(ctx, validator, nil)
+	case validation.TimeConstraint:
+		return c.ValidateTime(ctx, validator, nil)
+	}
+
+	return nil
+}
+
+func countOf(value any) (int, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len(), true
+	}
+
+	return 0, false
+}
+
+func supportedTypes(constraint any) []string {
+	types := make([]string, 0)
+	if isStringConstraint(constraint) {
+		types = append(types, "string")
+	}
+	if isNumberConstraint(constraint) {
+		types = append(types, "number")
+	}
+	if _, ok := constraint.(validation.BoolConstraint); ok {
+		types = append(types, "boolean")
+	}
+	if _, ok := constraint.(validation.CountableConstraint); ok {
+		types = append(types, "array", "object")
+	}
+	if _, ok := constraint.(validation.TimeConstraint); ok {
+		types = append(types, "time")
+	}
+
+	return types
+}
+
+func isSupportedConstraint(constraint any) bool {
+	if _, ok := constraint.(validation.NilConstraint); ok {
+		return true
+	}
+	if _, ok := constraint.(validation.Constraint[any]); ok {
+		return true
+	}
+
+	return len(supportedTypes(constraint)) > 0
+}
+
+func isStringConstraint(constraint any) bool {
+	_, isString := constraint.(validation.StringConstraint)
+	_, isComparable := constraint.(validation.ComparableConstraint[string])
+
+	return isString || isComparable
+}
+
+func isNumberConstraint(constraint any) bool {
+	_, isNumber := constraint.(validation.NumberConstraint[float64])
+	_, isComparable := constraint.(validation.ComparableConstraint[float64])
+
+	return isNumber || isComparable
+}
@@ -0,0 +1,151 @@
+package schema
+
+import (
+	"context"
+	"strings"
+
+	"github.com/muonsoft/validation"
+)
+
+// UnionNode is a schema of the value of one of the JSON types.
+type UnionNode struct {
+	nodes []TypedNode
+}
+
+// Union creates a schema of the value of one of the types of the nodes. The value is validated by the first
+// node of its type (an integer matches the "integer" and the "number" nodes). If there is no node
+// for the type of the value, a violation with the [validation.ErrInvalidType] error is created.
+//
+//	schema.Union(schema.String(it.IsNotBlank()), schema.Null())
+func Union(nodes ...TypedNode) UnionNode {
+	return UnionNode{nodes: nodes}
+}
+
+// Validate validates the value by the node of its type.
+func (node UnionNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	types := make([]string, len(node.nodes))
+	for i, n := range node.nodes {
+		if isType(value, n.Type()) {
+			return n.Validate(ctx, validator, value)
+		}
+		types[i] = n.Type()
+	}
+
+	return newTypeViolation(ctx, validator, value, strings.Join(types, " or "))
+}
+
+// AllNode is a schema of the value matching all the schemas.
+type AllNode struct {
+	nodes []Node
+}
+
+// All creates a schema of the value matching all the nodes. The violations of all the nodes are reported.
+func All(nodes ...Node) AllNode {
+	return AllNode{nodes: nodes}
+}
+
+// Validate validates the value by all the nodes.
+func (node AllNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	violations := validation.NewViolationList()
+	for _, n := range node.nodes {
+		if err := violations.AppendFromError(n.Validate(ctx, validator, value)); err != nil {
+			return err
+		}
+	}
+
+	return violations.AsError()
+}
+
+// AnyOfNode is a schema of the value matching at least one of the schemas.
+type AnyOfNode struct {
+	nodes []Node
+}
+
+// AnyOf creates a schema of the value matching at least one of the nodes. The violations of the nodes
+// are not reported, a single violation with the [validation.ErrNotValid] error is created instead.
+func AnyOf(nodes ...Node) AnyOfNode {
+	return AnyOfNode{nodes: nodes}
+}
+
+// Validate checks that the value matches at least one of the nodes.
+func (node AnyOfNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	count, err := countMatches(ctx, validator, value, node.nodes)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return newNotValidViolation(ctx, validator, value)
+	}
+
+	return nil
+}
+
+// OneOfNode is a schema of the value matching exactly one of the schemas.
+type OneOfNode struct {
+	nodes []Node
+}
+
+// OneOf creates a schema of the value matching exactly one of the nodes. The violations of the nodes
+// are not reported, a single violation with the [validation.ErrNotValid] error is created instead.
+func OneOf(nodes ...Node) OneOfNode {
+	return OneOfNode{nodes: nodes}
+}
+
+// Validate checks that the value matches exactly one of the nodes.
+func (node OneOfNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	count, err := countMatches(ctx, validator, value, node.nodes)
+	if err != nil {
+		return err
+	}
+	if count != 1 {
+		return newNotValidViolation(ctx, validator, value)
+	}
+
+	return nil
+}
+
+// NotNode is a schema of the value not matching the schema.
+type NotNode struct {
+	node Node
+}
+
+// Not creates a schema of the value not matching the node. If the value matches the node,
+// a violation with the [validation.ErrNotValid] error is created. Not(Any()) denies any value.
+func Not(node Node) NotNode {
+	return NotNode{node: node}
+}
+
+// Validate checks that the value does not match the node.
+func (node NotNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	count, err := countMatches(ctx, validator, value, []Node{node.node})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return newNotValidViolation(ctx, validator, value)
+	}
+
+	return nil
+}
+
+// countMatches returns the number of the nodes validating the value without violations.
+func countMatches(ctx context.Context, validator *validation.Validator, value any, nodes []Node) (int, error) {
+	count := 0
+	for _, n := range nodes {
+		violations := validation.NewViolationList()
+		if err := violations.AppendFromError(n.Validate(ctx, validator, value)); err != nil {
+			return 0, err
+		}
+		if violations.Len() == 0 {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func newNotValidViolation(ctx context.Context, validator *validation.Validator, value any) error {
+	return validator.BuildViolation(ctx, validation.ErrNotValid, validation.ErrNotValid.Message()).
+		WithInvalidValue(value).
+		Create()
+}
@@ -0,0 +1,83 @@
+package schema_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/schema"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnion_WhenValueMatchesType_ExpectValidatedByNodeOfType(t *testing.T) {
+	node := schema.Union(schema.String(it.IsNotBlank()), schema.Number(it.IsPositive[float64]()), schema.Null())
+
+	assert.NoError(t, validator.Validate(context.Background(), schema.Value(nil, node)))
+	assert.NoError(t, validator.Validate(context.Background(), schema.Value(decode(t, `1`), node)))
+	validationtest.Assert(t, validator.Validate(context.Background(), schema.Value("", node))).
+		IsViolationList().WithOneViolation().
+		WithError(validation.ErrIsBlank)
+	validationtest.Assert(t, validator.Validate(context.Background(), schema.Value(decode(t, `-1`), node))).
+		IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotPositive)
+}
+
+func TestUnion_WhenValueDoesNotMatchTypes_ExpectInvalidTypeViolation(t *testing.T) {
+	node := schema.Union(schema.Number().OnlyIntegers(), schema.Object())
+
+	err := validator.Validate(context.Background(), schema.Value(decode(t, `1.5`), node))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrInvalidType).
+		WithMessage("This value should be of type integer or object.")
+}
+
+func TestAll_ExpectViolationsOfAllNodes(t *testing.T) {
+	node := schema.All(
+		schema.String(it.HasMinLength(5)),
+		schema.String(it.Matches(regexp.MustCompile(`^\d+$`))),
+	)
+
+	err := validator.Validate(context.Background(), schema.Value("abc", node))
+
+	validationtest.Assert(t, err).IsViolationList().WithErrors(
+		validation.ErrTooShort,
+		validation.ErrNotValid,
+	)
+}
+
+func TestAnyOf_ExpectValueMatchingAtLeastOneNode(t *testing.T) {
+	node := schema.AnyOf(schema.String(), schema.Number(it.IsPositive[float64]()))
+
+	assert.NoError(t, validator.Validate(context.Background(), schema.Value("abc", node)))
+	assert.NoError(t, validator.Validate(context.Background(), schema.Value(decode(t, `1`), node)))
+	validationtest.Assert(t, validator.Validate(context.Background(), schema.Value(decode(t, `-1`), node))).
+		IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotValid)
+}
+
+func TestOneOf_ExpectValueMatchingExactlyOneNode(t *testing.T) {
+	node := schema.OneOf(schema.Number(it.IsPositive[float64]()), schema.Number().OnlyIntegers())
+
+	assert.NoError(t, validator.Validate(context.Background(), schema.Value(decode(t, `1.5`), node)))
+	assert.NoError(t, validator.Validate(context.Background(), schema.Value(decode(t, `-1`), node)))
+	validationtest.Assert(t, validator.Validate(context.Background(), schema.Value(decode(t, `1`), node))).
+		IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotValid)
+	validationtest.Assert(t, validator.Validate(context.Background(), schema.Value(decode(t, `-1.5`), node))).
+		IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotValid)
+}
+
+func TestNot_ExpectValueNotMatchingNode(t *testing.T) {
+	node := schema.Not(schema.Null())
+
+	assert.NoError(t, validator.Validate(context.Background(), schema.Value("abc", node)))
+	validationtest.Assert(t, validator.Validate(context.Background(), schema.Value(nil, node))).
+		IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotValid)
+}
@@ -0,0 +1,22 @@
+// Package schema contains a declarative schema for validation of dynamic JSON documents
+// that are not unmarshalled into Go structs (map[string]any, []any, json.Number and scalar values).
+//
+// The schema checks JSON types of the values, required and additional properties of the objects
+// and delegates checks of the values to the typed constraints (e.g. [validation.StringConstraint],
+// [validation.NumberConstraint] or [validation.CountableConstraint]). Violations are created with the property
+// paths of the values in the document.
+//
+//	document := map[string]any{}
+//	_ = json.Unmarshal(data, &document)
+//	err := validator.Validate(ctx, schema.Value(document, schema.Object(
+//		schema.Prop("name", schema.String(it.IsNotBlank(), it.HasMaxLength(50))).Required(),
+//		schema.Prop("tags", schema.Array(schema.String(it.IsNotBlank()), it.HasMaxCount(10))),
+//	)))
+//
+// The values of several types are described by [Union] (e.g. a nullable string is
+// schema.Union(schema.String(), schema.Null())). The schemas are combined by [All], [AnyOf], [OneOf] and [Not].
+//
+// A null value is passed to the constraints as nil (or as an empty collection), so it passes
+// most of the constraints. Use [github.com/muonsoft/validation/it.IsNotBlank] or
+// [github.com/muonsoft/validation/it.IsNotNil] to deny null values.
+package schema
@@ -0,0 +1,27 @@
+package schema_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/schema"
+	"github.com/muonsoft/validation/validator"
+)
+
+func ExampleObject() {
+	var document any
+	_ = json.Unmarshal([]byte(`{"name": "", "port": "8080", "tags": ["a", ""], "debug": true}`), &document)
+
+	err := validator.Validate(context.Background(), schema.Value(document, schema.Object(
+		schema.Prop("name", schema.String(it.IsNotBlank())).Required(),
+		schema.Prop("port", schema.Integer(it.IsBetween(1, 65535))).Required(),
+		schema.Prop("tags", schema.Array(schema.String(it.IsNotBlank()), it.HasMaxCount(5))),
+		schema.Prop("host", schema.String(it.IsHostname())).Required(),
+	).DenyAdditionalProperties()))
+
+	fmt.Println(err)
+	// Output:
+	// violations: #0 at "name": "This value should not be blank."; #1 at "port": "This value should be of type integer."; #2 at "tags[1]": "This value should not be blank."; #3 at "host": "This field is missing."; #4 at "debug": "This field was not expected."
+}
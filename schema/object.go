@@ -0,0 +1,221 @@
+package schema
+
+import (
+	"context"
+	"sort"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/internal/dynamic"
+	"github.com/muonsoft/validation/it"
+)
+
+// Property is a schema of the property of the object.
+type Property struct {
+	name       string
+	node       Node
+	isRequired bool
+}
+
+// Prop creates a schema of the property of the object. The node can be nil to skip validation of the value.
+func Prop(name string, node Node) Property {
+	return Property{name: name, node: node}
+}
+
+// Required marks the property as required. If the object has no such property, then the violation
+// with the [validation.ErrMissingProperty] error is created. A property with the null value is treated as present.
+func (p Property) Required() Property {
+	p.isRequired = true
+	return p
+}
+
+// ObjectNode is a schema of the object (map[string]any).
+type ObjectNode struct {
+	properties       []Property
+	additional       Node
+	denyAdditional   bool
+	countConstraints []validation.CountableConstraint
+}
+
+// Object creates a schema of the object with the properties. By default, additional properties
+// (that are not defined by the schema) are allowed and not validated.
+// Use [ObjectNode.DenyAdditionalProperties] or [ObjectNode.WithAdditionalProperties] to change this behavior.
+func Object(properties ...Property) ObjectNode {
+	return ObjectNode{properties: properties}
+}
+
+// Map creates a schema of the object with arbitrary properties. The values of the properties
+// are validated by the schema and the count of the properties is validated by the constraints.
+func Map(values Node, constraints ...validation.CountableConstraint) ObjectNode {
+	return ObjectNode{additional: values, countConstraints: constraints}
+}
+
+// DenyAdditionalProperties denies the properties that are not defined by the schema. A violation
+// with the [validation.ErrUnknownProperty] error is created for each of them.
+func (node ObjectNode) DenyAdditionalProperties() ObjectNode {
+	node.denyAdditional = true
+	return node
+}
+
+// WithAdditionalProperties sets the schema that is used to validate the properties
+// that are not defined by the schema.
+func (node ObjectNode) WithAdditionalProperties(values Node) ObjectNode {
+	node.additional = values
+	node.denyAdditional = false
+	return node
+}
+
+// Type returns the "object" type.
+func (node ObjectNode) Type() string {
+	return "object"
+}
+
+// Validate checks that the value is an object and validates its properties.
+func (node ObjectNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if value == nil {
+		return validator.Validate(ctx, validation.Countable(0, node.countConstraints...))
+	}
+	object, ok := dynamic.ToObject(value)
+	if !ok {
+		return newTypeViolation(ctx, validator, value, "object")
+	}
+
+	violations := validation.NewViolationList()
+	err := violations.AppendFromError(validator.Validate(ctx, validation.Countable(len(object), node.countConstraints...)))
+	if err != nil {
+		return err
+	}
+
+	defined := make(map[string]bool, len(node.properties))
+	for _, property := range node.properties {
+		defined[property.name] = true
+		propertyValue, exists := object[property.name]
+		if !exists {
+			if property.isRequired {
+				violations.Append(
+					validator.BuildViolation(ctx, validation.ErrMissingProperty, validation.ErrMissingProperty.Message()).
+						AtProperty(property.name).
+						Create(),
+				)
+			}
+			continue
+		}
+		if property.node == nil {
+			continue
+		}
+
+		err := violations.AppendFromError(property.node.Validate(ctx, validator.AtProperty(property.name), propertyValue))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = node.validateAdditionalProperties(ctx, validator, object, defined, violations)
+	if err != nil {
+		return err
+	}
+
+	return violations.AsError()
+}
+
+func (node ObjectNode) validateAdditionalProperties(
+	ctx context.Context,
+	validator *validation.Validator,
+	object map[string]any,
+	defined map[string]bool,
+	violations *validation.ViolationList,
+) error {
+	if !node.denyAdditional && node.additional == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		if !defined[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if node.denyAdditional {
+			violations.Append(
+				validator.BuildViolation(ctx, validation.ErrUnknownProperty, validation.ErrUnknownProperty.Message()).
+					AtProperty(name).
+					WithInvalidValue(object[name]).
+					Create(),
+			)
+			continue
+		}
+
+		err := violations.AppendFromError(node.additional.Validate(ctx, validator.AtProperty(name), object[name]))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ArrayNode is a schema of the array ([]any).
+type ArrayNode struct {
+	items            Node
+	countConstraints []validation.CountableConstraint
+	uniqueItems      bool
+}
+
+// Array creates a schema of the array. The elements of the array are validated by the items schema
+// (it can be nil to skip validation of elements) and the count of the elements is validated by the constraints.
+func Array(items Node, constraints ...validation.CountableConstraint) ArrayNode {
+	return ArrayNode{items: items, countConstraints: constraints}
+}
+
+// UniqueItems checks that the elements of the array are unique. The elements are compared
+// by their JSON representation, so the numbers 1 and 1.0 are equal.
+func (node ArrayNode) UniqueItems() ArrayNode {
+	node.uniqueItems = true
+	return node
+}
+
+// Type returns the "array" type.
+func (node ArrayNode) Type() string {
+	return "array"
+}
+
+// Validate checks that the value is an array and validates its elements.
+func (node ArrayNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if value == nil {
+		return validator.Validate(ctx, validation.Countable(0, node.countConstraints...))
+	}
+	elements, ok := dynamic.ToArray(value)
+	if !ok {
+		return newTypeViolation(ctx, validator, value, "array")
+	}
+
+	violations := validation.NewViolationList()
+	err := violations.AppendFromError(validator.Validate(ctx, validation.Countable(len(elements), node.countConstraints...)))
+	if err != nil {
+		return err
+	}
+	if node.uniqueItems {
+		values := make([]string, len(elements))
+		for i, element := range elements {
+			values[i] = dynamic.Canonical(element)
+		}
+		err := violations.AppendFromError(validator.Validate(ctx, validation.Comparables(values, it.HasUniqueValues[string]())))
+		if err != nil {
+			return err
+		}
+	}
+	if node.items == nil {
+		return violations.AsError()
+	}
+
+	for i, element := range elements {
+		err := violations.AppendFromError(node.items.Validate(ctx, validator.AtIndex(i), element))
+		if err != nil {
+			return err
+		}
+	}
+
+	return violations.AsError()
+}
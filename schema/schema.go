@@ -0,0 +1,229 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/internal/dynamic"
+)
+
+// Node is a schema of a dynamic value. It implements the [validation.Constraint] interface,
+// so it can be used with the [validation.This] argument or via the [Value] argument.
+type Node interface {
+	Validate(ctx context.Context, validator *validation.Validator, value any) error
+}
+
+// TypedNode is a schema of the value of the JSON type. The type is one of "null", "boolean", "string",
+// "number", "integer", "object" or "array". Typed nodes are used by the [Union] to choose the node
+// by the type of the value.
+type TypedNode interface {
+	Node
+	Type() string
+}
+
+// Value argument is used to validate the dynamic value by the schema.
+func Value(value any, node Node) validation.ValidatorArgument {
+	return validation.This[any](value, node)
+}
+
+// ValueProperty argument is an alias for [Value] that automatically adds property name to the current validation context.
+func ValueProperty(name string, value any, node Node) validation.ValidatorArgument {
+	return Value(value, node).At(validation.PropertyName(name))
+}
+
+// StringNode is a schema of the string value.
+type StringNode struct {
+	constraints []validation.StringConstraint
+}
+
+// String creates a schema of the string value checked by the constraints.
+func String(constraints ...validation.StringConstraint) StringNode {
+	return StringNode{constraints: constraints}
+}
+
+// Type returns the "string" type.
+func (node StringNode) Type() string {
+	return "string"
+}
+
+// Validate checks that the value is a string and validates it by the constraints.
+func (node StringNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if value == nil {
+		return validator.Validate(ctx, validation.NilString(nil, node.constraints...))
+	}
+	s, ok := value.(string)
+	if !ok {
+		return newTypeViolation(ctx, validator, value, "string")
+	}
+
+	return validator.Validate(ctx, validation.String(s, node.constraints...))
+}
+
+// NumberNode is a schema of the numeric value.
+type NumberNode struct {
+	constraints  []validation.NumberConstraint[float64]
+	onlyIntegers bool
+}
+
+// Number creates a schema of the numeric value checked by the constraints. The value can be
+// a [json.Number] or a value of any Go numeric type, it is converted into float64.
+func Number(constraints ...validation.NumberConstraint[float64]) NumberNode {
+	return NumberNode{constraints: constraints}
+}
+
+// OnlyIntegers denies the numbers with a fractional part. Unlike [Integer], the value is validated
+// by the constraints of float64 numbers, so the limits are not rounded.
+func (node NumberNode) OnlyIntegers() NumberNode {
+	node.onlyIntegers = true
+	return node
+}
+
+// Type returns the "number" type or the "integer" type if the node accepts only integers.
+func (node NumberNode) Type() string {
+	if node.onlyIntegers {
+		return "integer"
+	}
+
+	return "number"
+}
+
+// Validate checks that the value is a number and validates it by the constraints.
+func (node NumberNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if value == nil {
+		return validator.Validate(ctx, validation.NilNumber[float64](nil, node.constraints...))
+	}
+	number, ok := dynamic.ToFloat(value)
+	if !ok || node.onlyIntegers && !dynamic.IsInteger(value) {
+		return newTypeViolation(ctx, validator, value, node.Type())
+	}
+
+	return validator.Validate(ctx, validation.Number(number, node.constraints...))
+}
+
+// IntegerNode is a schema of the integer value.
+type IntegerNode struct {
+	constraints []validation.NumberConstraint[int]
+}
+
+// Integer creates a schema of the integer value checked by the constraints. The value can be
+// a [json.Number] or a value of any Go numeric type without a fractional part, it is converted into int.
+func Integer(constraints ...validation.NumberConstraint[int]) IntegerNode {
+	return IntegerNode{constraints: constraints}
+}
+
+// Type returns the "integer" type.
+func (node IntegerNode) Type() string {
+	return "integer"
+}
+
+// Validate checks that the value is an integer and validates it by the constraints.
+func (node IntegerNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if value == nil {
+		return validator.Validate(ctx, validation.NilNumber[int](nil, node.constraints...))
+	}
+	number, ok := dynamic.ToInt(value)
+	if !ok {
+		return newTypeViolation(ctx, validator, value, "integer")
+	}
+
+	return validator.Validate(ctx, validation.Number(number, node.constraints...))
+}
+
+// BoolNode is a schema of the boolean value.
+type BoolNode struct {
+	constraints []validation.BoolConstraint
+}
+
+// Bool creates a schema of the boolean value checked by the constraints.
+func Bool(constraints ...validation.BoolConstraint) BoolNode {
+	return BoolNode{constraints: constraints}
+}
+
+// Type returns the "boolean" type.
+func (node BoolNode) Type() string {
+	return "boolean"
+}
+
+// Validate checks that the value is a boolean and validates it by the constraints.
+func (node BoolNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if value == nil {
+		return validator.Validate(ctx, validation.NilBool(nil, node.constraints...))
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return newTypeViolation(ctx, validator, value, "boolean")
+	}
+
+	return validator.Validate(ctx, validation.Bool(b, node.constraints...))
+}
+
+// NullNode is a schema of the null value.
+type NullNode struct{}
+
+// Null creates a schema of the null value.
+func Null() NullNode {
+	return NullNode{}
+}
+
+// Type returns the "null" type.
+func (node NullNode) Type() string {
+	return "null"
+}
+
+// Validate checks that the value is null.
+func (node NullNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if value != nil {
+		return newTypeViolation(ctx, validator, value, "null")
+	}
+
+	return nil
+}
+
+// AnyNode is a schema of the value of any type.
+type AnyNode struct {
+	constraints []validation.Constraint[any]
+}
+
+// Any creates a schema of the value of any type checked by the constraints.
+func Any(constraints ...validation.Constraint[any]) AnyNode {
+	return AnyNode{constraints: constraints}
+}
+
+// Validate validates the value by the constraints.
+func (node AnyNode) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	return validator.Validate(ctx, validation.This(value, node.constraints...))
+}
+
+// isType checks that the value is of the JSON type.
+func isType(value any, t string) bool {
+	switch t {
+	case "null":
+		return value == nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := dynamic.ToFloat(value)
+		return ok
+	case "integer":
+		return dynamic.IsInteger(value)
+	case "object":
+		_, ok := dynamic.ToObject(value)
+		return ok
+	case "array":
+		_, ok := dynamic.ToArray(value)
+		return ok
+	}
+
+	return false
+}
+
+func newTypeViolation(ctx context.Context, validator *validation.Validator, value any, expectedType string) error {
+	return validator.BuildViolation(ctx, validation.ErrInvalidType, validation.ErrInvalidType.Message()).
+		WithParameter("{{ type }}", expectedType).
+		WithInvalidValue(value).
+		Create()
+}
@@ -0,0 +1,237 @@
+package schema_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/schema"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValue_WhenDocumentIsValid_ExpectNoViolations(t *testing.T) {
+	document := decode(t, `{
+		"name": "server",
+		"port": 8080,
+		"ratio": 0.5,
+		"enabled": true,
+		"tags": ["a", "b"],
+		"labels": {"env": "prod"},
+		"extra": null
+	}`)
+
+	err := validator.Validate(context.Background(), schema.Value(document, configSchema()))
+
+	assert.NoError(t, err)
+}
+
+func TestValue_WhenDocumentIsInvalid_ExpectViolationsWithPropertyPaths(t *testing.T) {
+	document := decode(t, `{
+		"name": "",
+		"port": 80.5,
+		"ratio": 2,
+		"enabled": "yes",
+		"tags": ["a", "", 1],
+		"labels": {"env": "", "region": "eu"}
+	}`)
+
+	err := validator.Validate(context.Background(), schema.Value(document, configSchema()))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "name"},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidType,
+			PropertyPath: "port",
+			Message:      "This value should be of type integer.",
+		},
+		validationtest.ViolationAttributes{Error: validation.ErrTooHigh, PropertyPath: "ratio"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidType, PropertyPath: "enabled"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "tags[1]"},
+		validationtest.ViolationAttributes{Error: validation.ErrInvalidType, PropertyPath: "tags[2]"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "labels.env"},
+	)
+}
+
+func TestValue_WhenRequiredPropertyIsMissing_ExpectViolation(t *testing.T) {
+	document := decode(t, `{"port": 8080}`)
+
+	err := validator.Validate(context.Background(), schema.Value(document, configSchema()))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrMissingProperty).
+		WithMessage("This field is missing.").
+		WithPropertyPath("name")
+}
+
+func TestValue_WhenAdditionalPropertiesAreDenied_ExpectViolations(t *testing.T) {
+	document := decode(t, `{"name": "foo", "zeta": 1, "alpha": {"nested": true}}`)
+
+	err := validator.Validate(context.Background(), schema.Value(document, schema.Object(
+		schema.Prop("name", schema.String()),
+	).DenyAdditionalProperties()))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrUnknownProperty,
+			PropertyPath: "alpha",
+			Message:      "This field was not expected.",
+		},
+		validationtest.ViolationAttributes{Error: validation.ErrUnknownProperty, PropertyPath: "zeta"},
+	)
+}
+
+func TestValue_WhenValueIsNotObject_ExpectInvalidTypeViolation(t *testing.T) {
+	err := validator.Validate(context.Background(), schema.ValueProperty("config", []any{}, configSchema()))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrInvalidType).
+		WithMessage("This value should be of type object.").
+		WithPropertyPath("config")
+}
+
+func TestValue_WhenNullValues_ExpectNullPassedToConstraints(t *testing.T) {
+	document := decode(t, `{"name": null, "tags": null, "port": null}`)
+
+	err := validator.Validate(context.Background(), schema.Value(document, schema.Object(
+		schema.Prop("name", schema.String(it.IsNotBlank())),
+		schema.Prop("tags", schema.Array(schema.String(), it.IsNotBlank())),
+		schema.Prop("port", schema.Integer(it.IsPositive[int]())),
+	)))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "name"},
+		validationtest.ViolationAttributes{Error: validation.ErrIsBlank, PropertyPath: "tags"},
+	)
+}
+
+func TestValue_WhenNativeGoValues_ExpectValuesConverted(t *testing.T) {
+	document := map[string]any{
+		"port":  uint16(8080),
+		"ratio": float32(0.5),
+		"tags":  []string{"a", "b", "c"},
+		"meta":  map[string]int{"a": 1},
+	}
+
+	err := validator.Validate(context.Background(), schema.Value(document, schema.Object(
+		schema.Prop("port", schema.Integer(it.IsBetween(1, 1024))),
+		schema.Prop("ratio", schema.Number(it.IsLessThan(1.0))),
+		schema.Prop("tags", schema.Array(schema.String(), it.HasMaxCount(2))),
+		schema.Prop("meta", schema.Map(schema.Integer(it.IsNegative[int]()))),
+	)))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: validation.ErrNotInRange, PropertyPath: "port"},
+		validationtest.ViolationAttributes{Error: validation.ErrTooManyElements, PropertyPath: "tags"},
+		validationtest.ViolationAttributes{Error: validation.ErrNotNegative, PropertyPath: "meta.a"},
+	)
+}
+
+func TestInteger_WhenNumberHasNoFractionalPart_ExpectInteger(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		valid bool
+	}{
+		{"json integer", json.Number("10"), true},
+		{"json float without fraction", json.Number("10.0"), true},
+		{"json exponent", json.Number("1e3"), true},
+		{"json float", json.Number("10.5"), false},
+		{"float64 without fraction", float64(10), true},
+		{"float64", 10.5, false},
+		{"int", 10, true},
+		{"string", "10", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validator.Validate(context.Background(), schema.Value(test.value, schema.Integer()))
+
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithError(validation.ErrInvalidType)
+			}
+		})
+	}
+}
+
+func TestAny_ExpectValueValidatedByConstraints(t *testing.T) {
+	document := map[string]any{"value": 1}
+
+	err := validator.Validate(context.Background(), schema.Value(document, schema.Object(
+		schema.Prop("value", schema.Any(isStringConstraint{})),
+	)))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotValid).
+		WithPropertyPath("value")
+}
+
+func TestNumber_WhenOnlyIntegers_ExpectFractionalNumbersDenied(t *testing.T) {
+	node := schema.Number(it.IsLessThan(10.5)).OnlyIntegers()
+
+	assert.NoError(t, validator.Validate(context.Background(), schema.Value(decode(t, `10.0`), node)))
+	validationtest.Assert(t, validator.Validate(context.Background(), schema.Value(decode(t, `1.5`), node))).
+		IsViolationList().WithOneViolation().
+		WithError(validation.ErrInvalidType).
+		WithMessage("This value should be of type integer.")
+	validationtest.Assert(t, validator.Validate(context.Background(), schema.Value(decode(t, `11`), node))).
+		IsViolationList().WithOneViolation().
+		WithError(validation.ErrTooHigh)
+}
+
+func TestArray_WhenUniqueItems_ExpectDuplicatesDenied(t *testing.T) {
+	node := schema.Array(nil).UniqueItems()
+
+	err := validator.Validate(context.Background(), schema.Value(decode(t, `[1, "1", 1.0]`), node))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotUnique)
+}
+
+func TestNull_WhenValueIsNotNull_ExpectInvalidTypeViolation(t *testing.T) {
+	assert.NoError(t, validator.Validate(context.Background(), schema.Value(nil, schema.Null())))
+
+	err := validator.Validate(context.Background(), schema.Value("", schema.Null()))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrInvalidType).
+		WithMessage("This value should be of type null.")
+}
+
+func configSchema() schema.Node {
+	return schema.Object(
+		schema.Prop("name", schema.String(it.IsNotBlank(), it.HasMaxLength(50))).Required(),
+		schema.Prop("port", schema.Integer(it.IsBetween(1, 65535))),
+		schema.Prop("ratio", schema.Number(it.IsLessThan(1.0))),
+		schema.Prop("enabled", schema.Bool()),
+		schema.Prop("tags", schema.Array(schema.String(it.IsNotBlank()), it.HasMaxCount(5))),
+		schema.Prop("labels", schema.Map(schema.String(it.IsNotBlank()))),
+		schema.Prop("extra", nil),
+	)
+}
+
+type isStringConstraint struct{}
+
+func (isStringConstraint) Validate(ctx context.Context, validator *validation.Validator, value any) error {
+	if _, ok := value.(string); ok {
+		return nil
+	}
+
+	return validator.CreateViolation(ctx, validation.ErrNotValid, validation.ErrNotValid.Message())
+}
+
+func decode(t *testing.T, data string) any {
+	t.Helper()
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	var document any
+	require.NoError(t, decoder.Decode(&document))
+
+	return document
+}
@@ -14,6 +14,12 @@ type TemplateParameter struct {
 
 	// NeedsTranslation marks that the template value needs to be translated.
 	NeedsTranslation bool
+
+	// RawValue is an optional typed value of the parameter (number, [time.Time] or [time.Duration]).
+	// If it is set and the formatting is enabled by the [EnableParameterFormatting] or [SetDateTimeStyle] options,
+	// the [BuiltinViolationFactory] replaces the Value by the RawValue formatted according to the language
+	// of the violation.
+	RawValue any
 }
 
 // TemplateParameterList is a list of template parameters that can be injection into violation message.
@@ -33,3 +39,13 @@ func renderMessage(template string, parameters []TemplateParameter) string {
 
 	return message
 }
+
+// parameterName returns the parameter key without curly braces, e.g. "limit" for "{{ limit }}".
+func parameterName(key string) string {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "{{") && strings.HasSuffix(key, "}}") {
+		key = strings.TrimSpace(key[2 : len(key)-2])
+	}
+
+	return key
+}
@@ -0,0 +1,200 @@
+package test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/expression"
+	"github.com/muonsoft/validation/it"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribe_WhenBuiltinConstraint_ExpectKindAndParameters(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		constraint validation.Describable
+		expected   validation.ConstraintDescription
+	}{
+		{"NotBlank", it.IsNotBlank(), description("notBlank", "allowNil", false)},
+		{"NotBlankAllowedNil", it.IsNotBlank().WithAllowedNil(), description("notBlank", "allowNil", true)},
+		{"Blank", it.IsBlank(), description("blank")},
+		{"NotNil", it.IsNotNil(), description("notNil")},
+		{"Nil", it.IsNil(), description("nil")},
+		{"True", it.IsTrue(), description("bool", "expected", true)},
+		{"False", it.IsFalse(), description("bool", "expected", false)},
+		{"OneOf", it.IsOneOf("b", "a"), description("choice", "choices", []any{"b", "a"}, "allowBlank", true)},
+		{"EqualTo", it.IsEqualTo(1), description("equalTo", "value", 1)},
+		{"NotEqualTo", it.IsNotEqualTo("foo"), description("notEqualTo", "value", "foo")},
+		{"LessThan", it.IsLessThan(1), description("lessThan", "value", 1)},
+		{"LessThanOrEqual", it.IsLessThanOrEqual(1), description("lessThanOrEqual", "value", 1)},
+		{"GreaterThan", it.IsGreaterThan(1), description("greaterThan", "value", 1)},
+		{"GreaterThanOrEqual", it.IsGreaterThanOrEqual(1), description("greaterThanOrEqual", "value", 1)},
+		{"Positive", it.IsPositive[int](), description("greaterThan", "value", 0)},
+		{"PositiveOrZero", it.IsPositiveOrZero[int](), description("greaterThanOrEqual", "value", 0)},
+		{"Negative", it.IsNegative[int](), description("lessThan", "value", 0)},
+		{"NegativeOrZero", it.IsNegativeOrZero[int](), description("lessThanOrEqual", "value", 0)},
+		{"DivisibleBy", it.IsDivisibleBy(3), description("divisibleBy", "value", 3)},
+		{"DivisibleByFloat", it.IsDivisibleByFloat(0.5), description("divisibleBy", "value", 0.5)},
+		{"Between", it.IsBetween(1, 2), description("range", "min", 1, "max", 2)},
+		{"EarlierThan", it.IsEarlierThan(now), description("earlierThan", "value", now)},
+		{"EarlierThanOrEqual", it.IsEarlierThanOrEqual(now), description("earlierThanOrEqual", "value", now)},
+		{"LaterThan", it.IsLaterThan(now), description("laterThan", "value", now)},
+		{"LaterThanOrEqual", it.IsLaterThanOrEqual(now), description("laterThanOrEqual", "value", now)},
+		{"BetweenTime", it.IsBetweenTime(now, now), description("timeRange", "min", now, "max", now)},
+		{"UniqueValues", it.HasUniqueValues[string](), description("unique")},
+		{"DateTime", it.IsDateTime(), description("dateTime", "layout", time.RFC3339)},
+		{"Date", it.IsDate(), description("dateTime", "layout", "2006-01-02")},
+		{"UUID", it.IsUUID(), description("uuid")},
+		{"ULID", it.IsULID(), description("ulid")},
+		{"MinCount", it.HasMinCount(1), description("count", "min", 1)},
+		{"CountDivisibleBy", it.HasCountDivisibleBy(2), description("count", "divisibleBy", 2)},
+		{"LengthBetween", it.HasLengthBetween(1, 2), description("length", "min", 1, "max", 2)},
+		{"MaxLength", it.HasMaxLength(2), description("length", "max", 2)},
+		{"Matches", it.Matches(regexp.MustCompile(`^\d+$`)), description("regex", "pattern", `^\d+$`, "match", true)},
+		{"DoesNotMatch", it.DoesNotMatch(regexp.MustCompile(`a`)), description("regex", "pattern", `a`, "match", false)},
+		{"JSON", it.IsJSON(), description("json")},
+		{"Email", it.IsEmail(), description("email")},
+		{"HTML5Email", it.IsHTML5Email(), description("email", "html5", true)},
+		{"LooseHostname", it.IsLooseHostname(), description("hostname", "loose", true)},
+		{"EAN13", it.IsEAN13(), description("ean13")},
+		{"Satisfies", it.Satisfies(expression.MustCompile("a < b")), description("expression", "expression", "a < b")},
+		{
+			"URL",
+			it.IsURL().WithHosts("example.com"),
+			description(
+				"url",
+				"schemas", []string{"http", "https"},
+				"relativeSchema", false,
+				"hosts", []string{"example.com"},
+				"hostPattern", "",
+				"restricted", false,
+			),
+		},
+		{"IP", it.IsIP(), description("ip", "version", 0, "restricted", false)},
+		{"IPv4", it.IsIPv4().DenyPrivateIP(), description("ip", "version", 4, "restricted", true)},
+		{"IPv6", it.IsIPv6(), description("ip", "version", 6, "restricted", false)},
+		{"CustomString", validation.OfStringBy(isNotEmptyString), validation.ConstraintDescription{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.constraint.Describe()
+			assert.Equal(t, test.expected, validation.ConstraintDescription{Kind: got.Kind, Parameters: got.Parameters})
+		})
+	}
+}
+
+func TestDescribe_WhenStringFuncConstraintWithDescription_ExpectDescription(t *testing.T) {
+	constraint := validation.OfStringBy(isNotEmptyString).WithDescription("even", map[string]any{"strict": true})
+
+	assert.Equal(t,
+		validation.ConstraintDescription{
+			Kind:       "even",
+			Parameters: map[string]any{"strict": true},
+			Violations: []validation.ViolationDescription{
+				{Err: validation.ErrNotValid, MessageTemplate: validation.ErrNotValid.Message()},
+			},
+		},
+		constraint.Describe(),
+	)
+}
+
+func TestDescribe_WhenConstraintWithGroupsAndCustomError_ExpectDescribedConfiguration(t *testing.T) {
+	constraint := it.IsNotBlank().WhenGroups("create").WithError(ErrCustom).WithMessage("Custom message.")
+
+	got := constraint.Describe()
+
+	assert.Equal(t, []string{"create"}, got.Groups)
+	assert.False(t, got.Ignored)
+	assert.Equal(t, []validation.ViolationDescription{{Err: ErrCustom, MessageTemplate: "Custom message."}}, got.Violations)
+}
+
+func TestDescribe_WhenConstraintIsDisabled_ExpectIgnored(t *testing.T) {
+	got := it.HasMinLength(1).When(false).Describe()
+
+	assert.True(t, got.Ignored)
+}
+
+func TestDescribe_WhenConstraintWithSeveralViolations_ExpectViolationsOfCheckedLimits(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint validation.Describable
+		expected   []validation.ViolationDescription
+	}{
+		{
+			name:       "MinLength",
+			constraint: it.HasMinLength(1),
+			expected:   []validation.ViolationDescription{violationDescription(validation.ErrTooShort)},
+		},
+		{
+			name:       "LengthBetween",
+			constraint: it.HasLengthBetween(1, 2),
+			expected: []validation.ViolationDescription{
+				violationDescription(validation.ErrTooShort),
+				violationDescription(validation.ErrTooLong),
+			},
+		},
+		{
+			name:       "ExactLength",
+			constraint: it.HasExactLength(2),
+			expected:   []validation.ViolationDescription{violationDescription(validation.ErrNotExactLength)},
+		},
+		{
+			name:       "CountBetween",
+			constraint: it.HasCountBetween(1, 10),
+			expected: []validation.ViolationDescription{
+				violationDescription(validation.ErrTooFewElements),
+				violationDescription(validation.ErrTooManyElements),
+			},
+		},
+		{
+			name:       "CountDivisibleBy",
+			constraint: it.HasCountDivisibleBy(2),
+			expected:   []validation.ViolationDescription{violationDescription(validation.ErrNotDivisibleCount)},
+		},
+		{
+			name:       "URL",
+			constraint: it.IsURL(),
+			expected: []validation.ViolationDescription{
+				violationDescription(validation.ErrInvalidURL),
+				violationDescription(validation.ErrProhibitedURL),
+			},
+		},
+		{
+			name:       "IP",
+			constraint: it.IsIP(),
+			expected: []validation.ViolationDescription{
+				violationDescription(validation.ErrInvalidIP),
+				violationDescription(validation.ErrProhibitedIP),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.constraint.Describe().Violations)
+		})
+	}
+}
+
+func violationDescription(err *validation.Error) validation.ViolationDescription {
+	return validation.ViolationDescription{Err: err, MessageTemplate: err.Message()}
+}
+
+func description(name string, parameters ...any) validation.ConstraintDescription {
+	d := validation.ConstraintDescription{Kind: name}
+	if len(parameters) == 0 {
+		return d
+	}
+	d.Parameters = make(map[string]any, len(parameters)/2)
+	for i := 0; i < len(parameters); i += 2 {
+		d.Parameters[parameters[i].(string)] = parameters[i+1]
+	}
+
+	return d
+}
+
+func isNotEmptyString(s string) bool {
+	return s != ""
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/expression"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+type Order struct {
+	Price    float64 `json:"price"`
+	Discount float64 `json:"discount"`
+	Role     string  `json:"role"`
+}
+
+var discountRule = expression.MustCompile(`discount <= price * 0.5 || role == "admin"`)
+
+func TestSatisfies_WhenExpressionIsTrue_ExpectNoViolation(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{"struct", Order{Price: 100, Discount: 50}},
+		{"pointer to struct", &Order{Price: 100, Discount: 90, Role: "admin"}},
+		{"map", map[string]any{"price": 100, "discount": 10}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validator.Validate(context.Background(), validation.This[any](test.value, it.Satisfies(discountRule)))
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSatisfies_WhenExpressionIsFalse_ExpectViolationWithExpression(t *testing.T) {
+	order := Order{Price: 100, Discount: 60, Role: "user"}
+
+	err := validator.Validate(
+		context.Background(),
+		validation.This[any](order, it.Satisfies(discountRule)).At(validation.PropertyName("discount")),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(validation.ErrNotSatisfied).
+		WithMessage(`This value should satisfy the expression discount <= price * 0.5 || role == "admin".`).
+		WithPropertyPath("discount")
+}
+
+func TestSatisfies_WhenCustomErrorAndMessage_ExpectThemInViolation(t *testing.T) {
+	err := validator.Validate(
+		context.Background(),
+		validation.This[any](
+			Order{Price: 100, Discount: 60},
+			it.Satisfies(discountRule).WithError(ErrCustom).WithMessage(
+				`Rule "{{ expression }}" is broken for {{ custom }}.`,
+				validation.TemplateParameter{Key: "{{ custom }}", Value: "order"},
+			),
+		),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithError(ErrCustom).
+		WithMessage(`Rule "discount <= price * 0.5 || role == "admin"" is broken for order.`)
+}
+
+func TestSatisfies_WhenConditionIsFalse_ExpectNoViolation(t *testing.T) {
+	err := validator.Validate(
+		context.Background(),
+		validation.This[any](Order{Price: 100, Discount: 60}, it.Satisfies(discountRule).When(false)),
+	)
+
+	assert.NoError(t, err)
+}
+
+func TestSatisfies_WhenExpressionCannotBeEvaluated_ExpectConstraintError(t *testing.T) {
+	tests := []struct {
+		name          string
+		expression    *expression.Expression
+		expectedError string
+	}{
+		{
+			name:       "unknown property",
+			expression: expression.MustCompile("amount > 0"),
+			expectedError: `validate by ExpressionConstraint: expression "amount > 0": ` +
+				`evaluation error: unknown property "amount" of object`,
+		},
+		{
+			name:       "not boolean result",
+			expression: expression.MustCompile("price * 2"),
+			expectedError: `validate by ExpressionConstraint: expression "price * 2": ` +
+				`evaluation error: result is number, expected boolean`,
+		},
+		{
+			name:          "nil expression",
+			expression:    nil,
+			expectedError: "validate by ExpressionConstraint: nil expression",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validator.Validate(
+				context.Background(),
+				validation.This[any](Order{Price: 100}, it.Satisfies(test.expression)),
+			)
+
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+}
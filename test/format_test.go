@@ -0,0 +1,254 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestValidate_WhenNumericParameters_ExpectFormattedByLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		language language.Tag
+		argument validation.Argument
+		expected string
+	}{
+		{
+			name:     "english integer",
+			language: language.English,
+			argument: validation.Number[int](1500000, it.IsLessThan(1000000).WithMessage("{{ value }} < {{ comparedValue }}")),
+			expected: "1,500,000 < 1,000,000",
+		},
+		{
+			name:     "russian float",
+			language: language.Russian,
+			argument: validation.Number[float64](1000000.5, it.IsLessThan(1000.125).WithMessage("{{ value }} < {{ comparedValue }}")),
+			expected: "1\u00a0000\u00a0000,5 < 1\u00a0000,125",
+		},
+		{
+			name:     "russian range",
+			language: language.Russian,
+			argument: validation.Number[int](0, it.IsBetween(1000, 2000).WithMessage("{{ min }}..{{ max }}")),
+			expected: "1\u00a0000..2\u00a0000",
+		},
+		{
+			name:     "russian duration",
+			language: language.Russian,
+			argument: validation.Number[time.Duration](time.Second, it.IsGreaterThan(90*time.Minute).WithMessage("{{ comparedValue }}")),
+			expected: "1 ч 30 мин",
+		},
+		{
+			name:     "english duration",
+			language: language.English,
+			argument: validation.Number[time.Duration](0, it.IsGreaterThan(1500*time.Millisecond).WithMessage("{{ comparedValue }}")),
+			expected: "1 s 500 ms",
+		},
+		{
+			name:     "german length",
+			language: language.German,
+			argument: validation.String("abc", it.HasMinLength(1000).WithMinMessage("{{ limit }}")),
+			expected: "1.000",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := newValidator(t, validation.EnableParameterFormatting())
+
+			err := v.WithLanguage(test.language).Validate(context.Background(), test.argument)
+
+			validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage(test.expected)
+		})
+	}
+}
+
+func TestValidate_WhenDefaultLanguageIsRussian_ExpectNumbersFormattedByDefaultLanguage(t *testing.T) {
+	v := newValidator(
+		t,
+		validation.Translations(russian.Messages),
+		validation.DefaultLanguage(language.Russian),
+		validation.EnableParameterFormatting(),
+	)
+
+	err := v.Validate(context.Background(), validation.Number[int](1, it.IsGreaterThan(1000)))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithMessage("Значение должно быть больше чем 1\u00a0000.")
+}
+
+func TestValidate_WhenDateTimeStyle_ExpectTimeFormattedByLanguage(t *testing.T) {
+	compared := time.Date(2023, time.March, 5, 14, 30, 15, 0, time.UTC)
+	tests := []struct {
+		name     string
+		style    validation.DateTimeStyle
+		language language.Tag
+		expected string
+	}{
+		{"layout", validation.DateTimeStyleLayout, language.Russian, "2023-03-05T14:30:15Z"},
+		{"short english", validation.DateTimeStyleShort, language.English, "3/5/23, 2:30 PM"},
+		{"medium english", validation.DateTimeStyleMedium, language.English, "Mar 5, 2023, 2:30:15 PM"},
+		{"short russian", validation.DateTimeStyleShort, language.Russian, "05.03.23 14:30"},
+		{"medium russian", validation.DateTimeStyleMedium, language.Russian, "05.03.2023 14:30:15"},
+		{"short german", validation.DateTimeStyleShort, language.German, "05.03.23, 14:30"},
+		{"medium chinese", validation.DateTimeStyleMedium, language.Chinese, "2023年3月5日 14:30:15"},
+		{"medium japanese", validation.DateTimeStyleMedium, language.Japanese, "2023/03/05 14:30:15"},
+		{"short spanish", validation.DateTimeStyleShort, language.Spanish, "5/3/23, 14:30"},
+		{"medium unknown", validation.DateTimeStyleMedium, language.Korean, "2023-03-05 14:30:15"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := newValidator(t, validation.SetDateTimeStyle(test.style))
+
+			err := v.WithLanguage(test.language).Validate(
+				context.Background(),
+				validation.Time(time.Time{}, it.IsLaterThan(compared).WithMessage("{{ comparedValue }}")),
+			)
+
+			validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage(test.expected)
+		})
+	}
+}
+
+func TestValidate_WhenFormattingIsNotEnabled_ExpectValuesNotFormatted(t *testing.T) {
+	tests := []struct {
+		name     string
+		argument validation.Argument
+		expected string
+	}{
+		{
+			name:     "number",
+			argument: validation.Number[int](1500000, it.IsLessThan(2024).WithMessage("{{ value }} < {{ comparedValue }}")),
+			expected: "1500000 < 2024",
+		},
+		{
+			name:     "equal number",
+			argument: validation.Comparable[int](1500, it.IsEqualTo(2024).WithMessage("{{ value }} = {{ comparedValue }}")),
+			expected: "1500 = 2024",
+		},
+		{
+			name:     "duration",
+			argument: validation.Number[time.Duration](0, it.IsGreaterThan(90*time.Minute).WithMessage("{{ comparedValue }}")),
+			expected: "1h30m0s",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := newValidator(t).WithLanguage(language.English).Validate(context.Background(), test.argument)
+
+			validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage(test.expected)
+		})
+	}
+}
+
+func TestValidate_WhenComparableEqualityWithFormatting_ExpectFormattedValues(t *testing.T) {
+	v := newValidator(t, validation.EnableParameterFormatting())
+
+	err := v.WithLanguage(language.Russian).Validate(
+		context.Background(),
+		validation.Comparable[int](1500, it.IsEqualTo(2024).WithMessage("{{ value }} = {{ comparedValue }}")),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage("1\u00a0500 = 2\u00a0024")
+}
+
+func TestValidate_WhenSensitiveNumber_ExpectRawValueNotFormatted(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.NumberProperty[int]("pin", 12345, it.IsLessThan(1000).WithMessage("{{ value }}")).Sensitive(),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage(validation.RedactedValue)
+}
+
+func TestNewValidator_WhenDateTimeStyleWithCustomFactory_ExpectError(t *testing.T) {
+	_, err := validation.NewValidator(
+		validation.SetViolationFactory(mockNewViolationFunc()),
+		validation.SetDateTimeStyle(validation.DateTimeStyleShort),
+	)
+
+	assert.EqualError(t, err, "violation factory options denied when using custom violation factory")
+}
+
+func TestValidate_WhenMessageFormatEnabled_ExpectPluralArgumentsRendered(t *testing.T) {
+	tests := []struct {
+		name     string
+		language language.Tag
+		value    []string
+		expected string
+	}{
+		{
+			name:     "english",
+			language: language.English,
+			value:    []string{"a", "b", "c"},
+			expected: "You selected 3 items but at most 1 is allowed (limit 1).",
+		},
+		{
+			name:     "russian",
+			language: language.Russian,
+			value:    []string{"a", "b", "c", "d", "e"},
+			expected: "Выбрано 5 элементов, но разрешено не более 1 (1).",
+		},
+	}
+	messages := map[language.Tag]string{
+		language.English: "You selected {count, plural, one {# item} other {# items}} " +
+			"but at most {limit, plural, one {# is} other {# are}} allowed (limit {{ limit }}).",
+		language.Russian: "Выбрано {count, plural, one {# элемент} few {# элемента} other {# элементов}}, " +
+			"но разрешено не более {limit, number} ({{ limit }}).",
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := newValidator(t, validation.EnableMessageFormat())
+
+			err := v.WithLanguage(test.language).Validate(
+				context.Background(),
+				validation.Countable(len(test.value), it.HasMaxCount(1).WithMaxMessage(messages[test.language])),
+			)
+
+			validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage(test.expected)
+		})
+	}
+}
+
+func TestValidate_WhenMessageFormatEnabledAndSelectArgument_ExpectSelectedCase(t *testing.T) {
+	v := newValidator(t, validation.EnableMessageFormat())
+
+	err := v.Validate(
+		context.Background(),
+		validation.String(
+			"",
+			it.IsNotBlank().WithMessage(
+				"{kind, select, user {User name} other {Name}} is required.",
+				validation.TemplateParameter{Key: "{{ kind }}", Value: "user"},
+			),
+		),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage("User name is required.")
+}
+
+func TestValidate_WhenMessageFormatEnabledAndInvalidTemplate_ExpectPlainTemplateRendered(t *testing.T) {
+	v := newValidator(t, validation.EnableMessageFormat())
+
+	err := v.Validate(
+		context.Background(),
+		validation.Countable(2, it.HasMaxCount(1).WithMaxMessage("Broken {template, with {{ limit }}.")),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage("Broken {template, with 1.")
+}
+
+func TestValidate_WhenMessageFormatDisabled_ExpectArgumentsNotRendered(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.Countable(2, it.HasMaxCount(1).WithMaxMessage("{count, plural, one {# item} other {# items}}")),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithMessage("{count, plural, one {# item} other {# items}}")
+}
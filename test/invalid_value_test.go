@@ -0,0 +1,132 @@
+package test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/expression"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/muonsoft/validation/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate_WhenBuiltinConstraintFails_ExpectInvalidValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		argument validation.Argument
+		expected any
+	}{
+		{"NotBlankString", validation.String("", it.IsNotBlank()), ""},
+		{"NotBlankNilString", validation.NilString(nil, it.IsNotBlank()), nil},
+		{"NotBlankCountable", validation.Countable(0, it.IsNotBlank()), 0},
+		{"BlankString", validation.String("foo", it.IsBlank()), "foo"},
+		{"NilString", validation.NilString(stringValue("foo"), it.IsNil()), "foo"},
+		{"NotNil", validation.Nil(true, it.IsNotNil()), nil},
+		{"True", validation.Bool(false, it.IsTrue()), false},
+		{"Length", validation.String("foo", it.HasMinLength(5)), "foo"},
+		{"Regexp", validation.String("foo", it.Matches(regexp.MustCompile(`^\d+$`))), "foo"},
+		{"OneOf", validation.Comparable[string]("foo", it.IsOneOf("bar")), "foo"},
+		{"Number", validation.Number[int](5, it.IsLessThan(3)), 5},
+		{"Time", validation.Time(time.Time{}, it.IsNotBlank()), time.Time{}},
+		{"Email", validation.String("invalid", it.IsEmail()), "invalid"},
+		{
+			"Satisfies",
+			validation.This[any](map[string]any{"a": 1}, it.Satisfies(expression.MustCompile("a > 1"))),
+			map[string]any{"a": 1},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validator.Validate(context.Background(), test.argument)
+
+			validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+				Assert(func(tb testing.TB, violation validation.Violation) {
+					tb.Helper()
+					assert.Equal(tb, test.expected, violation.InvalidValue())
+				})
+		})
+	}
+}
+
+func TestViolationBuilder_WithInvalidValue_ExpectInvalidValueInViolation(t *testing.T) {
+	violation := newValidator(t).BuildViolation(context.Background(), validation.ErrNotValid, "message").
+		WithInvalidValue(123).
+		Create()
+
+	assert.Equal(t, 123, violation.InvalidValue())
+}
+
+func TestValidate_WhenArgumentIsSensitive_ExpectValueRedacted(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.StringProperty("password", "secret", it.HasMinLength(10).
+			WithMinMessage(`Value {{ value }} is too short.`)).
+			Sensitive(),
+		validation.StringProperty("login", "foo", it.HasMinLength(10).
+			WithMinMessage(`Value {{ value }} is too short.`)),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrTooShort,
+			Message:      `Value [REDACTED] is too short.`,
+			PropertyPath: "password",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrTooShort,
+			Message:      `Value "foo" is too short.`,
+			PropertyPath: "login",
+		},
+	).Assert(func(tb testing.TB, violations []validation.Violation) {
+		tb.Helper()
+		assert.Equal(tb, validation.RedactedValue, violations[0].InvalidValue())
+		assert.Equal(tb, "foo", violations[1].InvalidValue())
+	})
+}
+
+func TestValidate_WhenArgumentIsSensitive_ExpectComparedValueRedacted(t *testing.T) {
+	err := newValidator(t).Validate(
+		context.Background(),
+		validation.ComparableProperty[string]("confirmation", "secret", it.IsEqualTo("password").
+			WithMessage(`{{ value }} is not {{ comparedValue }}, custom {{ custom }}.`, validation.TemplateParameter{
+				Key:   "{{ custom }}",
+				Value: "visible",
+			})).
+			Sensitive(),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithMessage(`[REDACTED] is not [REDACTED], custom visible.`)
+}
+
+func TestValidate_WhenRedactPathsOption_ExpectMatchingValuesRedacted(t *testing.T) {
+	v := newValidator(t, validation.RedactPaths(validation.MustCompilePropertyPathPattern("**.token")))
+
+	err := v.Validate(
+		context.Background(),
+		validation.StringProperty("name", "bar", it.IsOneOf("foo").WithMessage("{{ value }}")),
+		validation.String("secret", it.IsOneOf("foo").WithMessage("{{ value }}")).
+			At(validation.PropertyName("credentials"), validation.PropertyName("token")),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrNoSuchChoice,
+			Message:      "bar",
+			PropertyPath: "name",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrNoSuchChoice,
+			Message:      validation.RedactedValue,
+			PropertyPath: "credentials.token",
+		},
+	).Assert(func(tb testing.TB, violations []validation.Violation) {
+		tb.Helper()
+		assert.Equal(tb, "bar", violations[0].InvalidValue())
+		assert.Equal(tb, validation.RedactedValue, violations[1].InvalidValue())
+	})
+}
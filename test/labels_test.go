@@ -0,0 +1,145 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/message/translations/english"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestValidate_WhenArgumentWithLabel_ExpectLabelInMessage(t *testing.T) {
+	v := newValidator(t)
+
+	err := v.Validate(
+		context.Background(),
+		validation.StringProperty("email", "", it.IsNotBlank().WithMessage("{{ label }} ({{ property }}) is required.")).
+			WithLabel("E-mail"),
+		validation.StringProperty("name", "", it.IsNotBlank().WithMessage("{{ label }} ({{ property }}) is required.")),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "E-mail (email) is required.",
+			PropertyPath: "email",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "name (name) is required.",
+			PropertyPath: "name",
+		},
+	)
+}
+
+func TestValidate_WhenArgumentWithLabel_ExpectLabelNotAppliedToNestedProperties(t *testing.T) {
+	v := newValidator(t)
+
+	err := v.Validate(
+		context.Background(),
+		validation.ValidProperty("nested", mockValidatableString{value: ""}).WithLabel("Nested"),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		Assert(func(tb testing.TB, violation validation.Violation) {
+			tb.Helper()
+			for _, parameter := range violation.Parameters() {
+				if parameter.Key == "{{ label }}" {
+					assert.Equal(tb, violation.PropertyPath().String(), parameter.Value)
+				}
+			}
+		})
+}
+
+func TestValidate_WhenPropertyLabelOption_ExpectLabelForMatchingPaths(t *testing.T) {
+	v := newValidator(
+		t,
+		validation.PropertyLabel("**.email", "E-mail"),
+		validation.Translations(english.LabelledMessages),
+	)
+
+	err := v.Validate(
+		context.Background(),
+		validation.String("", it.IsNotBlank()).At(validation.PropertyName("user"), validation.PropertyName("email")),
+		validation.StringProperty("login", "", it.IsNotBlank()).WithLabel("Login"),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "E-mail: This value should not be blank.",
+			PropertyPath: "user.email",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "Login: This value should not be blank.",
+			PropertyPath: "login",
+		},
+	)
+}
+
+func TestValidate_WhenLabelledMessagesAndNoLabel_ExpectPropertyPathAsLabel(t *testing.T) {
+	v := newValidator(t, validation.Translations(english.LabelledMessages))
+
+	err := v.Validate(
+		context.Background(),
+		validation.StringProperty("login", "", it.IsNotBlank()),
+		validation.String("", it.IsNotBlank()),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "login: This value should not be blank.",
+			PropertyPath: "login",
+		},
+		validationtest.ViolationAttributes{
+			Error:   validation.ErrIsBlank,
+			Message: "This value should not be blank.",
+		},
+	)
+}
+
+func TestValidate_WhenLabelledMessagesAndEmptyPathInRussian_ExpectUnlabelledMessage(t *testing.T) {
+	v := newValidator(t, validation.Translations(russian.LabelledMessages))
+
+	err := v.WithLanguage(language.Russian).Validate(context.Background(), validation.String("", it.IsNotBlank()))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithMessage("Значение не должно быть пустым.")
+}
+
+func TestValidate_WhenLabelIsTranslatable_ExpectTranslatedLabel(t *testing.T) {
+	v := newValidator(
+		t,
+		validation.Translations(russian.LabelledMessages),
+		validation.Translations(map[language.Tag]map[string]catalog.Message{
+			language.Russian: {"E-mail": catalog.String("Эл. почта")},
+		}),
+	)
+
+	err := v.WithLanguage(language.Russian).Validate(
+		context.Background(),
+		validation.StringProperty("email", "", it.IsNotBlank()).WithLabel("E-mail"),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "Эл. почта: Значение не должно быть пустым.",
+			PropertyPath: "email",
+		},
+	)
+}
+
+func TestNewValidator_WhenPropertyLabelWithInvalidPattern_ExpectError(t *testing.T) {
+	_, err := validation.NewValidator(validation.PropertyLabel("items[", "Items"))
+
+	assert.ErrorContains(t, err, "set property label: parsing path pattern")
+}
@@ -41,16 +41,21 @@ type mockViolation struct {
 	message         string
 	messageTemplate string
 	parameters      []validation.TemplateParameter
+	invalidValue    any
 	propertyPath    *validation.PropertyPath
+	language        language.Tag
 }
 
 func (mock *mockViolation) Is(target error) bool                       { return mock.err == target }
 func (mock *mockViolation) Unwrap() error                              { return mock.err }
 func (mock *mockViolation) Error() string                              { return mock.err.Error() }
 func (mock *mockViolation) Message() string                            { return mock.message }
+func (mock *mockViolation) MessageIn(language.Tag) string              { return mock.message }
 func (mock *mockViolation) MessageTemplate() string                    { return mock.messageTemplate }
 func (mock *mockViolation) Parameters() []validation.TemplateParameter { return mock.parameters }
+func (mock *mockViolation) InvalidValue() any                          { return mock.invalidValue }
 func (mock *mockViolation) PropertyPath() *validation.PropertyPath     { return mock.propertyPath }
+func (mock *mockViolation) Language() language.Tag                     { return mock.language }
 
 func mockNewViolationFunc() validation.ViolationFactory {
 	return validation.NewViolationFunc(func(
@@ -58,6 +63,7 @@ func mockNewViolationFunc() validation.ViolationFactory {
 		messageTemplate string,
 		pluralCount int,
 		parameters []validation.TemplateParameter,
+		invalidValue any,
 		propertyPath *validation.PropertyPath,
 		lang language.Tag,
 	) validation.Violation {
@@ -65,7 +71,9 @@ func mockNewViolationFunc() validation.ViolationFactory {
 			err:             err,
 			messageTemplate: messageTemplate,
 			parameters:      parameters,
+			invalidValue:    invalidValue,
 			propertyPath:    propertyPath,
+			language:        lang,
 		}
 	})
 }
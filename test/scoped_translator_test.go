@@ -0,0 +1,86 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muonsoft/language"
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/require"
+	textlanguage "golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestValidator_Validate_WhenOverlayInContext_ExpectMessagesTranslatedByOverlayKeyByKey(t *testing.T) {
+	v := newValidator(t, validation.Translations(russian.Messages))
+	overlay := newTenantOverlay(t)
+	ctx := validation.ContextWithTranslator(language.WithContext(context.Background(), language.Russian), overlay)
+
+	err := v.Validate(
+		ctx,
+		validation.StringProperty("name", "", it.IsNotBlank()),
+		validation.StringProperty("email", "email", it.IsEmail()),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "Заполните это поле.",
+			PropertyPath: "name",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrInvalidEmail,
+			Message:      "Значение адреса электронной почты недопустимо.",
+			PropertyPath: "email",
+		},
+	)
+}
+
+func TestValidator_Validate_WhenNoTranslatorInContext_ExpectBaseTranslatorUsed(t *testing.T) {
+	v := newValidator(t, validation.Translations(russian.Messages))
+
+	err := v.WithLanguage(language.Russian).Validate(context.Background(), validation.String("", it.IsNotBlank()))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage("Значение не должно быть пустым.")
+}
+
+func TestValidator_WithTranslator_ExpectPriorityOverContextTranslator(t *testing.T) {
+	v := newValidator(t)
+	ctx := validation.ContextWithTranslator(context.Background(), newTenantOverlay(t))
+	translator := mockTranslator{translate: func(tag textlanguage.Tag, message string, pluralCount int) string {
+		return "translated: " + message
+	}}
+
+	err := v.WithTranslator(translator).Validate(ctx, validation.String("", it.IsNotBlank()))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithMessage("translated: This value should not be blank.")
+}
+
+func TestValidator_Validate_WhenOverlayAndDefaultLanguage_ExpectOverlayInDefaultLanguageUsed(t *testing.T) {
+	v := newValidator(t)
+	overlay, err := translations.NewOverlay(map[textlanguage.Tag]map[string]catalog.Message{
+		language.English: {message.IsBlank: catalog.String("Please fill in this field.")},
+	})
+	require.NoError(t, err)
+
+	err = v.WithTranslator(overlay).Validate(context.Background(), validation.String("", it.IsNotBlank()))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithMessage("Please fill in this field.").WithLanguage(language.English)
+}
+
+func newTenantOverlay(t *testing.T) *translations.Overlay {
+	t.Helper()
+	overlay, err := translations.NewOverlay(map[textlanguage.Tag]map[string]catalog.Message{
+		language.Russian: {message.IsBlank: catalog.String("Заполните это поле.")},
+	})
+	require.NoError(t, err)
+
+	return overlay
+}
@@ -5,8 +5,18 @@ import (
 	"testing"
 
 	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/message/translations/chinese"
 	"github.com/muonsoft/validation/message/translations/english"
+	"github.com/muonsoft/validation/message/translations/french"
+	"github.com/muonsoft/validation/message/translations/german"
+	"github.com/muonsoft/validation/message/translations/italian"
+	"github.com/muonsoft/validation/message/translations/japanese"
+	"github.com/muonsoft/validation/message/translations/polish"
+	"github.com/muonsoft/validation/message/translations/portuguese"
 	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/muonsoft/validation/message/translations/spanish"
+	"github.com/muonsoft/validation/message/translations/turkish"
+	"github.com/muonsoft/validation/message/translations/ukrainian"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message/catalog"
@@ -23,6 +33,7 @@ func TestAllMessagesTranslated(t *testing.T) {
 		validation.ErrInvalidIP,
 		validation.ErrInvalidJSON,
 		validation.ErrInvalidTime,
+		validation.ErrInvalidType,
 		validation.ErrInvalidULID,
 		validation.ErrInvalidUPCA,
 		validation.ErrInvalidUPCE,
@@ -31,6 +42,7 @@ func TestAllMessagesTranslated(t *testing.T) {
 		validation.ErrIsBlank,
 		validation.ErrIsEqual,
 		validation.ErrIsNil,
+		validation.ErrMissingProperty,
 		validation.ErrNoSuchChoice,
 		validation.ErrNotBlank,
 		validation.ErrNotDivisible,
@@ -47,6 +59,7 @@ func TestAllMessagesTranslated(t *testing.T) {
 		validation.ErrNotNumeric,
 		validation.ErrNotPositive,
 		validation.ErrNotPositiveOrZero,
+		validation.ErrNotSatisfied,
 		validation.ErrNotTrue,
 		validation.ErrNotUnique,
 		validation.ErrNotValid,
@@ -64,10 +77,21 @@ func TestAllMessagesTranslated(t *testing.T) {
 		validation.ErrTooLowOrEqual,
 		validation.ErrTooManyElements,
 		validation.ErrTooShort,
+		validation.ErrUnknownProperty,
 	}
 	allDictionaries := []map[language.Tag]map[string]catalog.Message{
 		english.Messages,
 		russian.Messages,
+		german.Messages,
+		french.Messages,
+		spanish.Messages,
+		portuguese.Messages,
+		italian.Messages,
+		polish.Messages,
+		ukrainian.Messages,
+		turkish.Messages,
+		chinese.Messages,
+		japanese.Messages,
 	}
 
 	for _, dictionary := range allDictionaries {
@@ -82,6 +106,7 @@ func TestAllMessagesTranslated(t *testing.T) {
 					))
 				}
 			}
+			assert.Len(t, messages, len(allErrors), "unexpected messages for language %s", languageTag)
 		}
 	}
 }
@@ -3,13 +3,26 @@ package test
 import (
 	"context"
 	"strconv"
+	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/muonsoft/language"
 	"github.com/muonsoft/validation"
 	"github.com/muonsoft/validation/it"
 	"github.com/muonsoft/validation/message"
+	"github.com/muonsoft/validation/message/translations"
+	"github.com/muonsoft/validation/message/translations/chinese"
+	"github.com/muonsoft/validation/message/translations/french"
+	"github.com/muonsoft/validation/message/translations/german"
+	"github.com/muonsoft/validation/message/translations/italian"
+	"github.com/muonsoft/validation/message/translations/japanese"
+	"github.com/muonsoft/validation/message/translations/polish"
+	"github.com/muonsoft/validation/message/translations/portuguese"
 	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/muonsoft/validation/message/translations/spanish"
+	"github.com/muonsoft/validation/message/translations/turkish"
+	"github.com/muonsoft/validation/message/translations/ukrainian"
 	"github.com/muonsoft/validation/validationtest"
 	"github.com/muonsoft/validation/validator"
 	"github.com/stretchr/testify/assert"
@@ -213,3 +226,189 @@ func TestValidate_WhenTranslatorIsOverriddenAndTranslationsPasses_ExpectError(t
 	assert.Nil(t, validator)
 	assert.EqualError(t, err, "translation options denied when using custom translator")
 }
+
+func TestValidator_Validate_WhenTranslationFilesLoaded_ExpectViolationTranslated(t *testing.T) {
+	fsys := fstest.MapFS{
+		"translations/validators.ru.yaml": {Data: []byte(
+			"\"This collection should contain {{ limit }} element(s) or less.\":\n" +
+				"  one: \"Не больше {{ limit }} элемента.\"\n" +
+				"  other: \"Не больше {{ limit }} элементов.\"\n",
+		)},
+	}
+	v := newValidator(t, validation.TranslationFiles(fsys, "translations/*.yaml"))
+
+	err := v.WithLanguage(language.Russian).Validate(context.Background(), validation.Countable(10, it.HasMaxCount(5)))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage("Не больше 5 элементов.")
+}
+
+func TestNewValidator_WhenTranslationFileHasUnknownTemplate_ExpectError(t *testing.T) {
+	fsys := fstest.MapFS{"ru.json": {Data: []byte(`{"Unknown message.": "Сообщение."}`)}}
+
+	_, err := validation.NewValidator(validation.TranslationFiles(fsys, "*.json"))
+
+	assert.ErrorIs(t, err, translations.ErrUnknownTemplate)
+}
+
+func TestValidator_Validate_WhenTranslationPackLoaded_ExpectPluralFormsRendered(t *testing.T) {
+	tests := []struct {
+		language language.Tag
+		messages map[textlanguage.Tag]map[string]catalog.Message
+		limits   map[int]string
+	}{
+		{
+			language: language.German,
+			messages: german.Messages,
+			limits: map[int]string{
+				1: "Diese Sammlung sollte {{ limit }} oder weniger Element enthalten.",
+				2: "Diese Sammlung sollte {{ limit }} oder weniger Elemente enthalten.",
+			},
+		},
+		{
+			language: language.French,
+			messages: french.Messages,
+			limits: map[int]string{
+				0: "Cette collection doit contenir {{ limit }} élément ou moins.",
+				1: "Cette collection doit contenir {{ limit }} élément ou moins.",
+				2: "Cette collection doit contenir {{ limit }} éléments ou moins.",
+			},
+		},
+		{
+			language: language.Spanish,
+			messages: spanish.Messages,
+			limits: map[int]string{
+				1: "Esta colección debe contener {{ limit }} elemento o menos.",
+				5: "Esta colección debe contener {{ limit }} elementos o menos.",
+			},
+		},
+		{
+			language: language.Portuguese,
+			messages: portuguese.Messages,
+			limits: map[int]string{
+				1: "Esta coleção deve conter {{ limit }} elemento ou menos.",
+				5: "Esta coleção deve conter {{ limit }} elementos ou menos.",
+			},
+		},
+		{
+			language: language.Italian,
+			messages: italian.Messages,
+			limits: map[int]string{
+				1: "Questa collezione dovrebbe contenere {{ limit }} elemento o meno.",
+				5: "Questa collezione dovrebbe contenere {{ limit }} elementi o meno.",
+			},
+		},
+		{
+			language: language.Polish,
+			messages: polish.Messages,
+			limits: map[int]string{
+				1:  "Ten zbiór powinien zawierać maksymalnie {{ limit }} element.",
+				3:  "Ten zbiór powinien zawierać maksymalnie {{ limit }} elementy.",
+				5:  "Ten zbiór powinien zawierać maksymalnie {{ limit }} elementów.",
+				12: "Ten zbiór powinien zawierać maksymalnie {{ limit }} elementów.",
+				22: "Ten zbiór powinien zawierać maksymalnie {{ limit }} elementy.",
+			},
+		},
+		{
+			language: language.Ukrainian,
+			messages: ukrainian.Messages,
+			limits: map[int]string{
+				1:  "Ця колекція повинна містити {{ limit }} елемент або менше.",
+				21: "Ця колекція повинна містити {{ limit }} елемент або менше.",
+				3:  "Ця колекція повинна містити {{ limit }} елементи або менше.",
+				11: "Ця колекція повинна містити {{ limit }} елементів або менше.",
+				25: "Ця колекція повинна містити {{ limit }} елементів або менше.",
+			},
+		},
+		{
+			language: language.Turkish,
+			messages: turkish.Messages,
+			limits: map[int]string{
+				1: "Bu koleksiyon {{ limit }} veya daha az öğe içermelidir.",
+				5: "Bu koleksiyon {{ limit }} veya daha az öğe içermelidir.",
+			},
+		},
+		{
+			language: language.Chinese,
+			messages: chinese.Messages,
+			limits: map[int]string{
+				1: "该集合最多只能包含 {{ limit }} 个元素。",
+				5: "该集合最多只能包含 {{ limit }} 个元素。",
+			},
+		},
+		{
+			language: language.Japanese,
+			messages: japanese.Messages,
+			limits: map[int]string{
+				1: "このコレクションは {{ limit }} 個以下の要素を含まなければなりません。",
+				5: "このコレクションは {{ limit }} 個以下の要素を含まなければなりません。",
+			},
+		},
+	}
+	for _, test := range tests {
+		v := newValidator(t, validation.Translations(test.messages))
+		for limit, template := range test.limits {
+			t.Run(test.language.String()+" plural form for "+strconv.Itoa(limit), func(t *testing.T) {
+				err := v.WithLanguage(test.language).Validate(
+					context.Background(),
+					validation.Countable(100, it.HasMaxCount(limit)),
+				)
+
+				validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+					WithMessage(strings.ReplaceAll(template, "{{ limit }}", strconv.Itoa(limit)))
+			})
+		}
+	}
+}
+
+func TestValidator_Validate_WhenFallbackLanguages_ExpectViolationTranslatedByFallbackLanguage(t *testing.T) {
+	v := newValidator(
+		t,
+		validation.Translations(portuguese.Messages),
+		validation.Translations(map[textlanguage.Tag]map[string]catalog.Message{
+			language.BrazilianPortuguese: {message.IsBlank: catalog.String("O valor é obrigatório.")},
+		}),
+		validation.FallbackLanguages(language.BrazilianPortuguese, language.Portuguese),
+	)
+
+	err := v.WithLanguage(language.BrazilianPortuguese).Validate(
+		context.Background(),
+		validation.String("", it.IsNotBlank()),
+		validation.Countable(10, it.HasMaxCount(1)),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithLen(2).
+		HasViolationAt(0).WithMessage("O valor é obrigatório.").WithLanguage(language.BrazilianPortuguese)
+	validationtest.Assert(t, err).IsViolationList().
+		HasViolationAt(1).WithMessage("Esta coleção deve conter 1 elemento ou menos.").WithLanguage(language.Portuguese)
+}
+
+func TestValidator_Validate_WhenLanguageMatchedToLoadedLanguage_ExpectViolationLanguageIsMatched(t *testing.T) {
+	v := newValidator(t, validation.Translations(russian.Messages))
+
+	err := v.WithLanguage(textlanguage.MustParse("ru-RU")).Validate(
+		context.Background(),
+		validation.String("", it.IsNotBlank()),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithMessage("Значение не должно быть пустым.").WithLanguage(language.Russian)
+}
+
+func TestValidator_Validate_WhenReloadableTranslatorReloaded_ExpectNewCatalogUsed(t *testing.T) {
+	translator, err := translations.NewReloadableTranslator(translations.SetTranslations(russian.Messages))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := newValidator(t, validation.SetTranslator(translator))
+	err = translator.Reload(translations.SetTranslations(map[textlanguage.Tag]map[string]catalog.Message{
+		language.Russian: {message.IsBlank: catalog.String("Заполните это поле.")},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = v.WithLanguage(language.Russian).Validate(context.Background(), validation.String("", it.IsNotBlank()))
+
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+		WithMessage("Заполните это поле.").WithLanguage(language.Russian)
+}
@@ -38,3 +38,160 @@ func TestWhenValidatorWithOverriddenNewViolation_ExpectCustomViolation(t *testin
 		assert.IsType(tb, &mockViolation{}, violations[0])
 	})
 }
+
+func TestValidate_WhenMessageOverridden_ExpectOverriddenMessage(t *testing.T) {
+	v := newValidator(t, validation.OverrideMessage(validation.ErrIsBlank, "This field is required."))
+
+	err := v.Validate(
+		context.Background(),
+		validation.StringProperty("default", "", it.IsNotBlank()),
+		validation.StringProperty("custom", "", it.IsNotBlank().WithMessage("Custom message.")),
+		validation.CountableProperty("count", 0, it.IsNotBlank()),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "This field is required.",
+			PropertyPath: "default",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "Custom message.",
+			PropertyPath: "custom",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "This field is required.",
+			PropertyPath: "count",
+		},
+	)
+}
+
+func TestValidate_WhenExplicitMessageEqualsDefault_ExpectMessageNotOverridden(t *testing.T) {
+	v := newValidator(
+		t,
+		validation.OverrideMessage(validation.ErrIsBlank, "This field is required."),
+		validation.OverrideMessage(validation.ErrTooFewElements, "Too few."),
+		validation.MapError(validation.ErrInvalidURL, errRequired),
+	)
+
+	err := v.Validate(
+		context.Background(),
+		validation.StringProperty("name", "", it.IsNotBlank().WithMessage(validation.ErrIsBlank.Message())),
+		validation.CountableProperty(
+			"tags",
+			0,
+			it.HasMinCount(1).WithMinMessage(validation.ErrTooFewElements.Message()),
+		),
+		validation.StringProperty("url", "invalid", it.IsURL().WithMessage(validation.ErrInvalidURL.Message())),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      validation.ErrIsBlank.Message(),
+			PropertyPath: "name",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrTooFewElements,
+			Message:      "This collection should contain 1 element or more.",
+			PropertyPath: "tags",
+		},
+		validationtest.ViolationAttributes{
+			Error:        errRequired,
+			Message:      validation.ErrInvalidURL.Message(),
+			PropertyPath: "url",
+		},
+	)
+}
+
+func TestValidate_WhenMessageOfStringFuncConstraintOverridden_ExpectOverriddenMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        *validation.Error
+		constraint validation.StringConstraint
+		value      string
+	}{
+		{"email", validation.ErrInvalidEmail, it.IsEmail(), "invalid"},
+		{"hostname", validation.ErrInvalidHostname, it.IsHostname(), "invalid"},
+		{"ULID", validation.ErrInvalidULID, it.IsULID(), "invalid"},
+		{"JSON", validation.ErrInvalidJSON, it.IsJSON(), "invalid"},
+		{"integer", validation.ErrNotInteger, it.IsInteger(), "invalid"},
+		{"EAN-8", validation.ErrInvalidEAN8, it.IsEAN8(), "invalid"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := newValidator(t, validation.OverrideMessage(test.err, "Overridden."))
+
+			err := v.Validate(context.Background(), validation.String(test.value, test.constraint))
+
+			validationtest.Assert(t, err).IsViolationList().WithOneViolation().
+				WithError(test.err).
+				WithMessage("Overridden.")
+		})
+	}
+}
+
+func TestBuildViolation_WhenMessageIsNotExplicit_ExpectDefaultMessageOverridden(t *testing.T) {
+	v := newValidator(t, validation.OverrideMessage(validation.ErrIsBlank, "This field is required."))
+	ctx := context.Background()
+
+	defaultViolation := v.BuildViolation(ctx, validation.ErrIsBlank, validation.ErrIsBlank.Message()).Create()
+	explicitViolation := v.BuildViolation(ctx, validation.ErrIsBlank, validation.ErrIsBlank.Message()).
+		WithExplicitMessage(true).
+		Create()
+
+	assert.Equal(t, "This field is required.", defaultViolation.Message())
+	assert.Equal(t, validation.ErrIsBlank.Message(), explicitViolation.Message())
+}
+
+var errRequired = validation.NewCodedError("test.required", "required", "This field is required.")
+
+func TestValidate_WhenErrorMapped_ExpectMappedErrorAndMessage(t *testing.T) {
+	v := newValidator(t, validation.MapError(validation.ErrIsBlank, errRequired))
+
+	err := v.Validate(
+		context.Background(),
+		validation.StringProperty("default", "", it.IsNotBlank()),
+		validation.StringProperty("custom", "", it.IsNotBlank().WithMessage("Custom message.")),
+	)
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        errRequired,
+			Message:      "This field is required.",
+			PropertyPath: "default",
+		},
+		validationtest.ViolationAttributes{
+			Error:        errRequired,
+			Message:      "Custom message.",
+			PropertyPath: "custom",
+		},
+	)
+}
+
+func TestValidate_WhenErrorMappedAndMessageOverridden_ExpectOverriddenMessage(t *testing.T) {
+	v := newValidator(
+		t,
+		validation.MapError(validation.ErrIsBlank, errRequired),
+		validation.OverrideMessage(errRequired, "Please, fill in this field."),
+	)
+
+	err := v.Validate(context.Background(), validation.String("", it.IsNotBlank()))
+
+	validationtest.Assert(t, err).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:   errRequired,
+			Message: "Please, fill in this field.",
+		},
+	)
+}
+
+func TestNewValidator_WhenOverrideNilError_ExpectError(t *testing.T) {
+	_, errOverride := validation.NewValidator(validation.OverrideMessage(nil, "message"))
+	_, errMap := validation.NewValidator(validation.MapError(validation.ErrIsBlank, nil))
+
+	assert.EqualError(t, errOverride, "override message: error must not be nil")
+	assert.EqualError(t, errMap, "map error: errors must not be nil")
+}
@@ -0,0 +1,93 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muonsoft/language"
+	"github.com/muonsoft/validation"
+	"github.com/muonsoft/validation/it"
+	"github.com/muonsoft/validation/message/translations/russian"
+	"github.com/muonsoft/validation/validationtest"
+	"github.com/stretchr/testify/assert"
+	textlanguage "golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestViolationList_Translate_ExpectMessagesRenderedInLanguage(t *testing.T) {
+	v := newValidator(
+		t,
+		validation.Translations(russian.Messages),
+		validation.Translations(map[textlanguage.Tag]map[string]catalog.Message{
+			language.Russian: {
+				"E-mail":                   catalog.String("Эл. почта"),
+				"{{ label }} is required.": catalog.String("{{ label }}: обязательное поле."),
+			},
+		}),
+	)
+	err := v.Validate(
+		context.Background(),
+		validation.StringProperty("email", "", it.IsNotBlank().WithMessage("{{ label }} is required.")).
+			WithLabel("E-mail"),
+		validation.CountableProperty("tags", 10, it.HasMaxCount(2)),
+	)
+	violations, ok := validation.UnwrapViolationList(err)
+	if !ok {
+		t.Fatal("violation list expected")
+	}
+
+	translated := violations.Translate(language.Russian)
+
+	validationtest.Assert(t, translated).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrIsBlank,
+			Message:      "Эл. почта: обязательное поле.",
+			PropertyPath: "email",
+		},
+		validationtest.ViolationAttributes{
+			Error:        validation.ErrTooManyElements,
+			Message:      "Эта коллекция должна содержать 2 элемента или меньше.",
+			PropertyPath: "tags",
+		},
+	)
+	validationtest.Assert(t, translated).IsViolationList().HasViolationAt(0).WithLanguage(language.Russian)
+	validationtest.Assert(t, err).IsViolationList().
+		HasViolationAt(0).WithMessage("E-mail is required.").WithLanguage(language.English)
+	validationtest.Assert(t, err).IsViolationList().
+		HasViolationAt(1).WithMessage("This collection should contain 2 elements or less.")
+}
+
+func TestViolation_MessageIn_ExpectMessageRenderedInLanguage(t *testing.T) {
+	v := newValidator(t, validation.Translations(russian.Messages))
+
+	err := v.WithLanguage(language.Russian).Validate(
+		context.Background(),
+		validation.Countable(10, it.HasMaxCount(5)),
+	)
+
+	violations, ok := validation.UnwrapViolationList(err)
+	if !ok {
+		t.Fatal("violation list expected")
+	}
+	violation := violations.First()
+	assert.Equal(t, "This collection should contain 5 elements or less.", violation.MessageIn(language.English))
+	assert.Equal(t, "Эта коллекция должна содержать 5 элементов или меньше.", violation.MessageIn(language.Russian))
+	assert.Equal(t, "Эта коллекция должна содержать 5 элементов или меньше.", violation.Message())
+}
+
+func TestViolation_WhenMessageIsNotRead_ExpectMessageNotTranslated(t *testing.T) {
+	translations := 0
+	v := newValidator(t, validation.SetTranslator(mockTranslator{
+		translate: func(tag textlanguage.Tag, message string, pluralCount int) string {
+			translations++
+			return message
+		},
+	}))
+
+	err := v.Validate(context.Background(), validation.String("", it.IsNotBlank()))
+
+	assert.Error(t, err)
+	assert.Equal(t, 0, translations)
+	validationtest.Assert(t, err).IsViolationList().WithOneViolation().WithMessage("This value should not be blank.")
+	assert.Equal(t, 1, translations)
+}
@@ -0,0 +1,89 @@
+package validation
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+)
+
+type translatorKey struct{}
+
+// ContextWithTranslator returns a copy of the context with the translator that takes precedence over
+// the translator of the validator for violations created with this context (e.g. a catalog with
+// custom wording of messages for a tenant). See [Validator.WithTranslator] for details.
+func ContextWithTranslator(ctx context.Context, translator Translator) context.Context {
+	return context.WithValue(ctx, translatorKey{}, translator)
+}
+
+// TranslatorFromContext returns the translator set by [ContextWithTranslator] or nil if it is not set.
+func TranslatorFromContext(ctx context.Context) Translator {
+	translator, _ := ctx.Value(translatorKey{}).(Translator)
+
+	return translator
+}
+
+// messageLookup is implemented by translators that are able to report that the message has no translation
+// (e.g. [github.com/muonsoft/validation/message/translations.Overlay]).
+type messageLookup interface {
+	Lookup(tag language.Tag, message string, pluralCount int) (string, language.Tag, bool)
+}
+
+// layeredTranslator translates messages by the overlay translator. If the overlay is able to report
+// that the message has no translation, then the message is translated by the base translator.
+type layeredTranslator struct {
+	overlay Translator
+	base    Translator
+}
+
+func (t *layeredTranslator) Translate(tag language.Tag, message string, pluralCount int) string {
+	translated, _ := t.TranslateWithLanguage(tag, message, pluralCount)
+
+	return translated
+}
+
+func (t *layeredTranslator) TranslateWithLanguage(
+	tag language.Tag,
+	message string,
+	pluralCount int,
+) (string, language.Tag) {
+	lookup, ok := t.overlay.(messageLookup)
+	if !ok {
+		return translateWithLanguage(t.overlay, tag, message, pluralCount)
+	}
+
+	lookupTag := tag
+	if lookupTag == language.Und {
+		lookupTag = t.DefaultLanguage()
+	}
+	if translated, used, found := lookup.Lookup(lookupTag, message, pluralCount); found {
+		return translated, used
+	}
+
+	return translateWithLanguage(t.base, tag, message, pluralCount)
+}
+
+func (t *layeredTranslator) DefaultLanguage() language.Tag {
+	if base, ok := t.base.(interface{ DefaultLanguage() language.Tag }); ok {
+		return base.DefaultLanguage()
+	}
+
+	return language.Und
+}
+
+// translateWithLanguage translates the message and returns the language used for translation, if the translator
+// is able to report it (see [github.com/muonsoft/validation/message/translations.Translator.TranslateWithLanguage]).
+func translateWithLanguage(
+	translator Translator,
+	tag language.Tag,
+	message string,
+	pluralCount int,
+) (string, language.Tag) {
+	type languageTranslator interface {
+		TranslateWithLanguage(tag language.Tag, message string, pluralCount int) (string, language.Tag)
+	}
+	if t, ok := translator.(languageTranslator); ok {
+		return t.TranslateWithLanguage(tag, message, pluralCount)
+	}
+
+	return translator.Translate(tag, message, pluralCount), tag
+}
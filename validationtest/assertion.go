@@ -12,6 +12,7 @@ import (
 
 	"github.com/muonsoft/validation"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
 )
 
 // TestingT is an interface wrapper around [testing.T].
@@ -341,6 +342,26 @@ func (a *ViolationAssertion) WithPropertyPath(path string) *ViolationAssertion {
 	return a
 }
 
+// WithLanguage checks that the message of the tested violation is translated into an expected language.
+func (a *ViolationAssertion) WithLanguage(tag language.Tag) *ViolationAssertion {
+	if a == nil {
+		return nil
+	}
+	a.t.Helper()
+
+	actual := a.violation.Language()
+	if actual != tag {
+		assert.Fail(a.t, fmt.Sprintf(
+			`failed asserting that violation%s has language "%s", actual is "%s"`,
+			a.atIndex(),
+			tag,
+			actual,
+		))
+	}
+
+	return a
+}
+
 // EqualTo checks that the tested assertion is equal to the expected one.
 func (a *ViolationAssertion) EqualTo(violation validation.Violation) *ViolationAssertion {
 	if a == nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/muonsoft/validation/validationtest"
 	"github.com/muonsoft/validation/validator"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
 )
 
 func TestAssertion_IsViolation(t *testing.T) {
@@ -21,6 +22,17 @@ func TestAssertion_IsViolation(t *testing.T) {
 	tester.AssertOneMessage(t, "failed asserting that err is a Violation")
 }
 
+func TestAssertion_IsViolation_WhenViolationList_ExpectFail(t *testing.T) {
+	tester := &Tester{}
+	violations := validation.NewViolationList(
+		validator.BuildViolation(context.Background(), errors.New("error"), "message").Create(),
+	)
+
+	validationtest.Assert(tester, violations).IsViolation()
+
+	tester.AssertOneMessage(t, "failed asserting that err is a Violation")
+}
+
 func TestAssertion_IsViolationList(t *testing.T) {
 	tester := &Tester{}
 
@@ -166,6 +178,15 @@ func TestViolationAssertion_WithPropertyPath(t *testing.T) {
 	tester.AssertOneMessage(t, `failed asserting that violation has property path "expected", actual is "path"`)
 }
 
+func TestViolationAssertion_WithLanguage(t *testing.T) {
+	tester := &Tester{}
+	violation := validator.BuildViolation(context.Background(), errors.New("error"), "message").Create()
+
+	validationtest.Assert(tester, violation).IsViolation().WithLanguage(language.Russian)
+
+	tester.AssertOneMessage(t, `failed asserting that violation has language "ru", actual is "en"`)
+}
+
 func TestViolationAssertion_EqualTo(t *testing.T) {
 	tester := &Tester{}
 	violation := validator.BuildViolation(context.Background(), errors.New("error"), "message").
@@ -3,6 +3,7 @@ package validation
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"time"
 
 	"github.com/muonsoft/language"
@@ -16,13 +17,21 @@ type Validator struct {
 	propertyPath     *PropertyPath
 	language         language.Tag
 	translator       Translator
+	scopedTranslator Translator
 	violationFactory ViolationFactory
 	groups           []string
+	redaction        redaction
+	overrides        *overrides
+	labels           labels
 }
 
 // Translator is used to translate violation messages. By default, validator uses an implementation from
 // [github.com/muonsoft/validation/message/translations] package based on [golang.org/x/text] package.
 // You can set up your own implementation by using [SetTranslator] option.
+//
+// If the translator also has the method TranslateWithLanguage(tag, message, pluralCount) (string, language.Tag),
+// then it is used to get the language of the translated message (see [Violation.Language]).
+// Otherwise, the requested language is used.
 type Translator interface {
 	Translate(tag language.Tag, message string, pluralCount int) string
 }
@@ -32,6 +41,11 @@ type ValidatorOptions struct {
 	translatorOptions []translations.TranslatorOption
 	translator        Translator
 	violationFactory  ViolationFactory
+	redactedPaths     []*PropertyPathPattern
+	messageOverrides  map[*Error]string
+	errorMappings     map[*Error]error
+	labels            labels
+	factoryOptions    []ViolationFactoryOption
 }
 
 func newValidatorOptions() *ValidatorOptions {
@@ -63,13 +77,19 @@ func NewValidator(options ...ValidatorOption) (*Validator, error) {
 			return nil, fmt.Errorf("set up default translator: %w", err)
 		}
 	}
+	if opts.violationFactory != nil && len(opts.factoryOptions) > 0 {
+		return nil, errFactoryOptionsDenied
+	}
 	if opts.violationFactory == nil {
-		opts.violationFactory = NewViolationFactory(opts.translator)
+		opts.violationFactory = NewViolationFactory(opts.translator, opts.factoryOptions...)
 	}
 
 	validator := &Validator{
 		translator:       opts.translator,
 		violationFactory: opts.violationFactory,
+		redaction:        redaction{paths: opts.redactedPaths},
+		overrides:        newOverrides(opts.messageOverrides, opts.errorMappings),
+		labels:           opts.labels,
 	}
 
 	return validator, nil
@@ -84,6 +104,19 @@ func DefaultLanguage(tag language.Tag) ValidatorOption {
 	}
 }
 
+// FallbackLanguages option sets up the chain of languages that are used to translate violation messages
+// when they have no translation into the language. For example, the chain "pt-PT", "es", "en" for
+// the "pt-BR" language means that messages that are not translated into Brazilian Portuguese will
+// be translated into European Portuguese, then into Spanish and then into English.
+// See [translations.FallbackLanguages] for details.
+func FallbackLanguages(tag language.Tag, fallbacks ...language.Tag) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.translatorOptions = append(options.translatorOptions, translations.FallbackLanguages(tag, fallbacks...))
+
+		return nil
+	}
+}
+
 // Translations option is used to load translation messages into the validator.
 //
 // By default, all violation messages are generated in the English language with pluralization capabilities.
@@ -98,6 +131,18 @@ func Translations(messages map[language.Tag]map[string]catalog.Message) Validato
 	}
 }
 
+// TranslationFiles option is used to load translation messages from the files of the file system
+// (e.g. [embed.FS]) matching the patterns. JSON, YAML, gettext PO and XLIFF formats are supported.
+// Message keys must correspond to the templates of the built-in messages or of the messages loaded
+// by the previous options. See [translations.ReadFiles] for details.
+func TranslationFiles(fsys fs.FS, patterns ...string) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.translatorOptions = append(options.translatorOptions, translations.LoadFiles(fsys, patterns...))
+
+		return nil
+	}
+}
+
 // SetTranslator option is used to set up the custom implementation of message violation translator.
 func SetTranslator(translator Translator) ValidatorOption {
 	return func(options *ValidatorOptions) error {
@@ -116,6 +161,57 @@ func SetViolationFactory(factory ViolationFactory) ValidatorOption {
 	}
 }
 
+// EnableParameterFormatting option enables formatting of numeric and duration values of template parameters
+// according to the language of the violation in the default violation factory. See [FormatParameters] for details.
+// This option cannot be used with the custom violation factory, use the [FormatParameters]
+// option of the [BuiltinViolationFactory] instead.
+func EnableParameterFormatting() ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.factoryOptions = append(options.factoryOptions, FormatParameters())
+
+		return nil
+	}
+}
+
+// SetDateTimeStyle option sets the style used by the default violation factory to format
+// date and time values of template parameters according to the language of the violation.
+// By default, the layouts of the constraints are used (see [DateTimeStyleLayout]).
+// This option also enables formatting of numeric and duration values (see [EnableParameterFormatting]).
+// This option cannot be used with the custom violation factory, use the [FormatDateTime]
+// option of the [BuiltinViolationFactory] instead.
+func SetDateTimeStyle(style DateTimeStyle) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.factoryOptions = append(options.factoryOptions, FormatDateTime(style))
+
+		return nil
+	}
+}
+
+// EnableMessageFormat option enables rendering of message templates by the ICU MessageFormat engine
+// in the default violation factory. See [UseMessageFormat] for details.
+// This option cannot be used with the custom violation factory, use the [UseMessageFormat]
+// option of the [BuiltinViolationFactory] instead.
+func EnableMessageFormat() ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.factoryOptions = append(options.factoryOptions, UseMessageFormat())
+
+		return nil
+	}
+}
+
+// RedactPaths option marks properties matching the patterns as sensitive. Invalid values of violations
+// at these properties and values of the "{{ value }}" and "{{ comparedValue }}" template parameters
+// are replaced by [RedactedValue].
+// See [PropertyPathPattern] for the pattern syntax. To mark a single argument as sensitive,
+// use the [ValidatorArgument.Sensitive] method.
+func RedactPaths(patterns ...*PropertyPathPattern) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.redactedPaths = append(options.redactedPaths, patterns...)
+
+		return nil
+	}
+}
+
 // Validate is the main validation method. It accepts validation arguments that can be
 // used to tune up the validation process or to pass values of a specific type.
 func (validator *Validator) Validate(ctx context.Context, arguments ...Argument) error {
@@ -266,6 +362,22 @@ func (validator *Validator) WithLanguage(tag language.Tag) *Validator {
 	return v
 }
 
+// WithTranslator method creates a new context validator with the translator that takes precedence over
+// the translator of the validator (e.g. a catalog with custom wording of messages for a tenant).
+// If the translator is able to report that the message has no translation (see [translations.Overlay]),
+// then such messages are translated by the translator of the validator key-by-key.
+// Otherwise, the translator is used for all messages.
+//
+// The translator can also be passed via context by [ContextWithTranslator]. The translator
+// of the context validator has priority over the translator from the context.
+// Scoped translators are applied only by the [BuiltinViolationFactory].
+func (validator *Validator) WithTranslator(translator Translator) *Validator {
+	v := validator.copy()
+	v.scopedTranslator = translator
+
+	return v
+}
+
 // At method creates a new context validator with appended property path.
 func (validator *Validator) At(path ...PropertyPathElement) *Validator {
 	v := validator.copy()
@@ -297,8 +409,11 @@ func (validator *Validator) CreateViolation(ctx context.Context, err error, mess
 
 // BuildViolation can be used to build a custom violation on the client-side.
 func (validator *Validator) BuildViolation(ctx context.Context, err error, message string) *ViolationBuilder {
-	b := NewViolationBuilder(validator.violationFactory).BuildViolation(err, message)
+	b := NewViolationBuilder(validator.violationFactoryFor(ctx)).BuildViolation(err, message)
 	b = b.SetPropertyPath(validator.propertyPath)
+	b.redaction = validator.redaction
+	b.overrides = validator.overrides
+	b.labels = validator.labels
 
 	if validator.language != language.Und {
 		b = b.WithLanguage(validator.language)
@@ -311,8 +426,11 @@ func (validator *Validator) BuildViolation(ctx context.Context, err error, messa
 
 // BuildViolationList can be used to build a custom violation list on the client-side.
 func (validator *Validator) BuildViolationList(ctx context.Context) *ViolationListBuilder {
-	b := NewViolationListBuilder(validator.violationFactory)
+	b := NewViolationListBuilder(validator.violationFactoryFor(ctx))
 	b = b.SetPropertyPath(validator.propertyPath)
+	b.redaction = validator.redaction
+	b.overrides = validator.overrides
+	b.labels = validator.labels
 
 	if validator.language != language.Und {
 		b = b.WithLanguage(validator.language)
@@ -323,12 +441,46 @@ func (validator *Validator) BuildViolationList(ctx context.Context) *ViolationLi
 	return b
 }
 
+// violationFactoryFor returns the violation factory with the scoped translator applied, if it is set.
+func (validator *Validator) violationFactoryFor(ctx context.Context) ViolationFactory {
+	translator := validator.scopedTranslator
+	if translator == nil && ctx != nil {
+		translator = TranslatorFromContext(ctx)
+	}
+	if translator == nil {
+		return validator.violationFactory
+	}
+	if factory, ok := validator.violationFactory.(*BuiltinViolationFactory); ok {
+		return factory.WithTranslator(translator)
+	}
+
+	return validator.violationFactory
+}
+
 func (validator *Validator) copy() *Validator {
 	return &Validator{
 		propertyPath:     validator.propertyPath,
 		language:         validator.language,
 		translator:       validator.translator,
+		scopedTranslator: validator.scopedTranslator,
 		violationFactory: validator.violationFactory,
 		groups:           validator.groups,
+		redaction:        validator.redaction,
+		overrides:        validator.overrides,
+		labels:           validator.labels,
 	}
 }
+
+func (validator *Validator) sensitive() *Validator {
+	v := validator.copy()
+	v.redaction = v.redaction.sensitive()
+
+	return v
+}
+
+func (validator *Validator) withLabel(label string) *Validator {
+	v := validator.copy()
+	v.labels = v.labels.with(propertyLabel{path: v.propertyPath, label: label})
+
+	return v
+}
@@ -110,6 +110,12 @@ func WithLanguage(tag language.Tag) *validation.Validator {
 	return validator.WithLanguage(tag)
 }
 
+// WithTranslator method creates a new context validator with the translator that takes precedence over
+// the translator of the validator. See [validation.Validator.WithTranslator] for details.
+func WithTranslator(translator validation.Translator) *validation.Validator {
+	return validator.WithTranslator(translator)
+}
+
 // At method creates a new context validator with appended property path.
 func At(path ...validation.PropertyPathElement) *validation.Validator {
 	return validator.At(path...)
@@ -6,9 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"iter"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
+	"github.com/muonsoft/validation/message/messageformat"
 	"golang.org/x/text/language"
 )
 
@@ -31,6 +36,11 @@ type Violation interface {
 	// even in patch versions.
 	Message() string
 
+	// MessageIn renders the message in the given language. It can be used to show the message
+	// in a language different from the one used for validation (e.g. in English for logs
+	// and in the language of the user for the response).
+	MessageIn(tag language.Tag) string
+
 	// MessageTemplate is a template for rendering message. Alongside parameters it can be used to
 	// render the message on the client-side of the library.
 	MessageTemplate() string
@@ -38,9 +48,18 @@ type Violation interface {
 	// Parameters is the map of the template variables and their values provided by the specific constraint.
 	Parameters() []TemplateParameter
 
+	// InvalidValue is the value that caused the violation. Built-in constraints always set this value,
+	// but it may be nil for custom violations. If the value belongs to a sensitive property,
+	// it is replaced by [RedactedValue]. See [RedactPaths] and [ValidatorArgument.Sensitive] for details.
+	InvalidValue() any
+
 	// PropertyPath is a path that points to the violated property.
 	// See [PropertyPath] type description for more info.
 	PropertyPath() *PropertyPath
+
+	// Language is the language in which the message was actually translated. It may differ from
+	// the requested language if the message has no translation into it and the fallback language was used.
+	Language() language.Tag
 }
 
 // ViolationFactory is the abstraction that can be used to create custom violations on the application side.
@@ -52,6 +71,7 @@ type ViolationFactory interface {
 		messageTemplate string,
 		pluralCount int,
 		parameters []TemplateParameter,
+		invalidValue any,
 		propertyPath *PropertyPath,
 		lang language.Tag,
 	) Violation
@@ -63,6 +83,7 @@ type NewViolationFunc func(
 	messageTemplate string,
 	pluralCount int,
 	parameters []TemplateParameter,
+	invalidValue any,
 	propertyPath *PropertyPath,
 	lang language.Tag,
 ) Violation
@@ -73,10 +94,11 @@ func (f NewViolationFunc) CreateViolation(
 	messageTemplate string,
 	pluralCount int,
 	parameters []TemplateParameter,
+	invalidValue any,
 	propertyPath *PropertyPath,
 	lang language.Tag,
 ) Violation {
-	return f(err, messageTemplate, pluralCount, parameters, propertyPath, lang)
+	return f(err, messageTemplate, pluralCount, parameters, invalidValue, propertyPath, lang)
 }
 
 // ViolationList is a linked list of violations. It is the usual type of error that is returned from a validator.
@@ -130,6 +152,42 @@ func (list *ViolationList) ForEach(f func(i int, violation Violation) error) err
 	return nil
 }
 
+// All returns an iterator over index-violation pairs of the list.
+//
+//	for i, violation := range violations.All() {
+//	    fmt.Println(i, violation.Message())
+//	}
+func (list *ViolationList) All() iter.Seq2[int, Violation] {
+	return func(yield func(int, Violation) bool) {
+		if list == nil {
+			return
+		}
+
+		i := 0
+		for e := list.first; e != nil; e = e.next {
+			if !yield(i, e.violation) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns an iterator over violations of the list.
+func (list *ViolationList) Values() iter.Seq[Violation] {
+	return func(yield func(Violation) bool) {
+		if list == nil {
+			return
+		}
+
+		for e := list.first; e != nil; e = e.next {
+			if !yield(e.violation) {
+				return
+			}
+		}
+	}
+}
+
 // First returns the first element of the linked list.
 func (list *ViolationList) First() *ViolationListElement {
 	return list.first
@@ -236,20 +294,87 @@ func (list *ViolationList) toString(delimiter string) string {
 // AppendFromError appends a single violation or a slice of violations into the end of a given slice.
 // If an error does not implement the [Violation] or [ViolationList] interface, it will return an error itself.
 // Otherwise nil will be returned.
+//
+// Errors produced by [errors.Join] (or any other error implementing the "Unwrap() []error" method) are
+// processed recursively: all contained violations and violation lists are appended. The violations of
+// the joined lists are copied, so the joined lists remain unchanged. If one of the joined errors
+// is not a violation, it will be returned.
 func (list *ViolationList) AppendFromError(err error) error {
-	if violation, ok := UnwrapViolation(err); ok {
+	return list.appendFromError(err, false)
+}
+
+func (list *ViolationList) appendFromError(err error, isJoined bool) error {
+	if err == nil {
+		return nil
+	}
+
+	for e := err; e != nil; {
+		switch wrapped := e.(type) {
+		case *ViolationList:
+			list.joinList(wrapped, isJoined)
+			return nil
+		case Violation:
+			list.Append(wrapped)
+			return nil
+		case interface{ Unwrap() []error }:
+			for _, joined := range wrapped.Unwrap() {
+				if fatal := list.appendFromError(joined, true); fatal != nil {
+					return fatal
+				}
+			}
+			return nil
+		}
+		e = errors.Unwrap(e)
+	}
+
+	if violationList, ok := UnwrapViolationList(err); ok {
+		list.joinList(violationList, isJoined)
+	} else if violation, ok := UnwrapViolation(err); ok {
 		list.Append(violation)
-	} else if violationList, ok := UnwrapViolationList(err); ok {
-		list.Join(violationList)
-	} else if err != nil {
+	} else {
 		return err
 	}
 
 	return nil
 }
 
+// joinList joins the violations or, if the list is one of several joined errors, appends copies
+// of its elements, because joining of several lists would link the elements of the previous list
+// to the next one.
+func (list *ViolationList) joinList(violations *ViolationList, isCopied bool) {
+	if !isCopied {
+		list.Join(violations)
+		return
+	}
+	for _, violation := range violations.All() {
+		list.Append(violation)
+	}
+}
+
+// Unwrap returns the violations of the list as a slice of errors. It allows functions
+// [errors.Is] and [errors.As] to inspect individual violations of the list, even if the list
+// is wrapped into an error produced by [errors.Join]. Note that [errors.As] with a target
+// of the [Violation] type finds the first violation of the list, use [UnwrapViolation] or
+// [IsViolation] to distinguish a single violation from the list.
+func (list *ViolationList) Unwrap() []error {
+	if list == nil {
+		return nil
+	}
+
+	errs := make([]error, 0, list.len)
+	for e := list.first; e != nil; e = e.next {
+		errs = append(errs, e.violation)
+	}
+
+	return errs
+}
+
 // Is used to check that at least one of the violations contains the specific static error.
 func (list *ViolationList) Is(target error) bool {
+	if list == nil {
+		return false
+	}
+
 	for e := list.first; e != nil; e = e.next {
 		if e.violation.Is(target) {
 			return true
@@ -260,20 +385,216 @@ func (list *ViolationList) Is(target error) bool {
 }
 
 // Filter returns a new list of violations with violations of given codes.
+// Errors produced by [errors.Join] are expanded into the joined errors.
 func (list *ViolationList) Filter(errs ...error) *ViolationList {
+	errs = expandJoined(errs)
+
+	return list.FilterFunc(func(violation Violation) bool {
+		return isAny(violation, errs)
+	})
+}
+
+// FilterByPath returns a new list of violations with property paths matching the pattern.
+// See [PropertyPathPattern] for the pattern syntax.
+func (list *ViolationList) FilterByPath(pattern *PropertyPathPattern) *ViolationList {
 	filtered := &ViolationList{}
+	if list == nil {
+		return filtered
+	}
 
 	for e := list.first; e != nil; e = e.next {
-		for _, err := range errs {
-			if e.violation.Is(err) {
-				filtered.Append(e.violation)
-			}
+		if pattern.Match(e.violation.PropertyPath()) {
+			filtered.Append(e.violation)
+		}
+	}
+
+	return filtered
+}
+
+// FilterFunc returns a new list of violations for which the predicate returns true.
+func (list *ViolationList) FilterFunc(predicate func(violation Violation) bool) *ViolationList {
+	filtered := &ViolationList{}
+	if list == nil {
+		return filtered
+	}
+
+	for e := list.first; e != nil; e = e.next {
+		if predicate(e.violation) {
+			filtered.Append(e.violation)
 		}
 	}
 
 	return filtered
 }
 
+// Remove returns a new list of violations without violations of given codes.
+// It is the opposite of the [ViolationList.Filter] method.
+func (list *ViolationList) Remove(errs ...error) *ViolationList {
+	errs = expandJoined(errs)
+
+	return list.FilterFunc(func(violation Violation) bool {
+		return !isAny(violation, errs)
+	})
+}
+
+func isAny(violation Violation, errs []error) bool {
+	for _, err := range errs {
+		if violation.Is(err) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandJoined replaces the errors produced by [errors.Join] (or any other error implementing
+// the "Unwrap() []error" method) with the joined errors.
+func expandJoined(errs []error) []error {
+	expanded := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			expanded = append(expanded, expandJoined(joined.Unwrap())...)
+		} else {
+			expanded = append(expanded, err)
+		}
+	}
+
+	return expanded
+}
+
+// Map returns a new list of violations built by applying the function to each violation of the list.
+// It can be used to rewrite violations, for example, to change property paths. If the function
+// returns nil, then the violation is removed from the resulting list.
+func (list *ViolationList) Map(f func(violation Violation) Violation) *ViolationList {
+	mapped := &ViolationList{}
+	if list == nil {
+		return mapped
+	}
+
+	for e := list.first; e != nil; e = e.next {
+		if violation := f(e.violation); violation != nil {
+			mapped.Append(violation)
+		}
+	}
+
+	return mapped
+}
+
+// Translate returns a new list of violations with messages rendered in the given language.
+// Messages are rendered lazily on the first access. Violations created by the [BuiltinViolationFactory]
+// are re-rendered by its translator, other violations are added to the resulting list as is.
+func (list *ViolationList) Translate(tag language.Tag) *ViolationList {
+	return list.Map(func(violation Violation) Violation {
+		if v, ok := violation.(*internalViolation); ok {
+			return v.translate(tag)
+		}
+		return violation
+	})
+}
+
+// FirstBy returns the first violation for which the predicate returns true.
+// If there is no such violation, it returns false as the second value.
+func (list *ViolationList) FirstBy(predicate func(violation Violation) bool) (Violation, bool) {
+	if list == nil {
+		return nil, false
+	}
+
+	for e := list.first; e != nil; e = e.next {
+		if predicate(e.violation) {
+			return e.violation, true
+		}
+	}
+
+	return nil, false
+}
+
+// LastBy returns the last violation for which the predicate returns true.
+// If there is no such violation, it returns false as the second value.
+func (list *ViolationList) LastBy(predicate func(violation Violation) bool) (Violation, bool) {
+	if list == nil {
+		return nil, false
+	}
+
+	var last Violation
+	for e := list.first; e != nil; e = e.next {
+		if predicate(e.violation) {
+			last = e.violation
+		}
+	}
+
+	return last, last != nil
+}
+
+// GroupByPath groups violations by their property paths. Keys of the map are string
+// representations of the property paths. Violations without a property path are grouped
+// under the empty key. Order of violations within the group is preserved.
+func (list *ViolationList) GroupByPath() map[string][]Violation {
+	groups := make(map[string][]Violation, list.Len())
+	if list == nil {
+		return groups
+	}
+
+	for e := list.first; e != nil; e = e.next {
+		path := e.violation.PropertyPath().String()
+		groups[path] = append(groups[path], e.violation)
+	}
+
+	return groups
+}
+
+// ToFieldMessages returns messages of violations grouped by the string representations
+// of the property paths. It can be useful to render errors next to the fields of server-rendered forms.
+// Messages of violations without a property path are grouped under the empty key.
+func (list *ViolationList) ToFieldMessages() map[string][]string {
+	messages := make(map[string][]string, list.Len())
+	if list == nil {
+		return messages
+	}
+
+	for e := list.first; e != nil; e = e.next {
+		path := e.violation.PropertyPath().String()
+		messages[path] = append(messages[path], e.violation.Message())
+	}
+
+	return messages
+}
+
+// SortByPath returns a new list of violations sorted by property paths. Property names are compared
+// lexicographically, array indices are compared numerically, and an array index goes before a property name.
+// Shorter paths go before longer ones with the same prefix. The sort is stable, so violations
+// with equal paths keep their original order.
+func (list *ViolationList) SortByPath() *ViolationList {
+	violations := list.AsSlice()
+	sort.SliceStable(violations, func(i, j int) bool {
+		return comparePropertyPaths(violations[i].PropertyPath(), violations[j].PropertyPath()) < 0
+	})
+
+	return NewViolationList(violations...)
+}
+
+// Deduplicate returns a new list of violations without duplicates. Violations are considered
+// duplicates if they have the same underlying error, property path, and message.
+// The first occurrence of the violation is kept.
+func (list *ViolationList) Deduplicate() *ViolationList {
+	type key struct {
+		path    string
+		message string
+	}
+	seen := make(map[key][]error, list.Len())
+
+	return list.FilterFunc(func(violation Violation) bool {
+		k := key{path: violation.PropertyPath().String(), message: violation.Message()}
+		err := violation.Unwrap()
+		for _, e := range seen[k] {
+			if isSameError(e, err) {
+				return false
+			}
+		}
+		seen[k] = append(seen[k], err)
+		return true
+	})
+}
+
 // AsError converts the list of violations to an error. This method correctly handles cases where
 // the list of violations is empty. It returns nil on an empty list, indicating that the validation was successful.
 func (list *ViolationList) AsError() error {
@@ -286,6 +607,10 @@ func (list *ViolationList) AsError() error {
 
 // AsSlice converts underlying linked list into slice of [Violation].
 func (list *ViolationList) AsSlice() []Violation {
+	if list == nil {
+		return []Violation{}
+	}
+
 	violations := make([]Violation, list.len)
 
 	i := 0
@@ -348,6 +673,10 @@ func (element *ViolationListElement) Message() string {
 	return element.violation.Message()
 }
 
+func (element *ViolationListElement) MessageIn(tag language.Tag) string {
+	return element.violation.MessageIn(tag)
+}
+
 func (element *ViolationListElement) MessageTemplate() string {
 	return element.violation.MessageTemplate()
 }
@@ -360,11 +689,20 @@ func (element *ViolationListElement) PropertyPath() *PropertyPath {
 	return element.violation.PropertyPath()
 }
 
+func (element *ViolationListElement) InvalidValue() any {
+	return element.violation.InvalidValue()
+}
+
+func (element *ViolationListElement) Language() language.Tag {
+	return element.violation.Language()
+}
+
 // IsViolation can be used to verify that the error implements the [Violation] interface.
+// The violations contained in the [ViolationList] are not taken into account, so the list is not a violation.
 func IsViolation(err error) bool {
-	var violation Violation
+	_, is := UnwrapViolation(err)
 
-	return errors.As(err, &violation)
+	return is
 }
 
 // IsViolationList can be used to verify that the error implements the [ViolationList].
@@ -374,13 +712,32 @@ func IsViolationList(err error) bool {
 	return errors.As(err, &violations)
 }
 
-// UnwrapViolation is a short function to unwrap [Violation] from the error.
+// UnwrapViolation is a short function to unwrap [Violation] from the error. Unlike [errors.As],
+// it does not look into the [ViolationList], so the first violation of the list is not returned.
 func UnwrapViolation(err error) (Violation, bool) {
-	var violation Violation
-
-	as := errors.As(err, &violation)
+	for err != nil {
+		switch e := err.(type) {
+		case *ViolationList:
+			return nil, false
+		case Violation:
+			return e, true
+		case interface{ As(any) bool }:
+			var violation Violation
+			if e.As(&violation) {
+				return violation, true
+			}
+		case interface{ Unwrap() []error }:
+			for _, joined := range e.Unwrap() {
+				if violation, ok := UnwrapViolation(joined); ok {
+					return violation, true
+				}
+			}
+			return nil, false
+		}
+		err = errors.Unwrap(err)
+	}
 
-	return violation, as
+	return nil, false
 }
 
 // UnwrapViolationList is a short function to unwrap [ViolationList] from the error.
@@ -392,12 +749,26 @@ func UnwrapViolationList(err error) (*ViolationList, bool) {
 	return violations, as
 }
 
+// internalViolation is created by the [BuiltinViolationFactory]. The message is rendered
+// on the first access, so messages that are never read are never translated.
 type internalViolation struct {
 	err             error
-	message         string
 	messageTemplate string
+	pluralCount     int
 	parameters      []TemplateParameter
+	invalidValue    any
 	propertyPath    *PropertyPath
+	language        language.Tag
+	factory         *BuiltinViolationFactory
+
+	render   sync.Once
+	rendered renderedMessage
+}
+
+type renderedMessage struct {
+	message    string
+	parameters []TemplateParameter
+	language   language.Tag
 }
 
 func (v *internalViolation) Unwrap() error {
@@ -421,24 +792,58 @@ func (v *internalViolation) writeToBuilder(s *strings.Builder) {
 	if v.propertyPath != nil {
 		s.WriteString(` at "` + v.propertyPath.String() + `"`)
 	}
-	s.WriteString(`: "` + v.message + `"`)
+	s.WriteString(`: "` + v.Message() + `"`)
 }
 
-func (v *internalViolation) Message() string                 { return v.message }
+func (v *internalViolation) Message() string                 { return v.renderedMessage().message }
 func (v *internalViolation) MessageTemplate() string         { return v.messageTemplate }
-func (v *internalViolation) Parameters() []TemplateParameter { return v.parameters }
+func (v *internalViolation) Parameters() []TemplateParameter { return v.renderedMessage().parameters }
 func (v *internalViolation) PropertyPath() *PropertyPath     { return v.propertyPath }
+func (v *internalViolation) InvalidValue() any               { return v.invalidValue }
+func (v *internalViolation) Language() language.Tag          { return v.renderedMessage().language }
+
+func (v *internalViolation) MessageIn(tag language.Tag) string {
+	if tag == v.language {
+		return v.Message()
+	}
+
+	return v.factory.render(tag, v.messageTemplate, v.pluralCount, v.parameters, v.propertyPath).message
+}
+
+func (v *internalViolation) renderedMessage() *renderedMessage {
+	v.render.Do(func() {
+		v.rendered = v.factory.render(v.language, v.messageTemplate, v.pluralCount, v.parameters, v.propertyPath)
+	})
+
+	return &v.rendered
+}
+
+// translate returns the copy of the violation that is rendered in the given language.
+func (v *internalViolation) translate(tag language.Tag) *internalViolation {
+	return &internalViolation{
+		err:             v.err,
+		messageTemplate: v.messageTemplate,
+		pluralCount:     v.pluralCount,
+		parameters:      v.parameters,
+		invalidValue:    v.invalidValue,
+		propertyPath:    v.propertyPath,
+		language:        tag,
+		factory:         v.factory,
+	}
+}
 
 func (v *internalViolation) MarshalJSON() ([]byte, error) {
 	data := struct {
+		Code         string        `json:"code,omitempty"`
 		Error        string        `json:"error,omitempty"`
 		Message      string        `json:"message"`
 		PropertyPath *PropertyPath `json:"propertyPath,omitempty"`
 	}{
-		Message:      v.message,
+		Message:      v.Message(),
 		PropertyPath: v.propertyPath,
 	}
 	if v.err != nil {
+		data.Code = errorCode(v.err)
 		data.Error = v.err.Error()
 	}
 
@@ -446,50 +851,183 @@ func (v *internalViolation) MarshalJSON() ([]byte, error) {
 }
 
 // BuiltinViolationFactory used as a default factory for creating a violations.
-// It translates and renders message templates.
+// It translates and renders message templates. Numeric, duration, date and time values of template parameters
+// (see [TemplateParameter.RawValue]) can be formatted according to the language of the violation
+// by the [FormatParameters] and [FormatDateTime] options.
 type BuiltinViolationFactory struct {
-	translator Translator
+	translator    Translator
+	formatter     parameterFormatter
+	messageFormat *messageformat.Cache
+}
+
+// ViolationFactoryOption is used to configure the [BuiltinViolationFactory].
+type ViolationFactoryOption func(factory *BuiltinViolationFactory)
+
+// FormatParameters option enables formatting of numeric and duration values of template parameters
+// according to the language of the violation (e.g. "1,000,000" in English and "1 000 000" in Russian).
+// By default, the values are rendered as is (e.g. "1000000").
+func FormatParameters() ViolationFactoryOption {
+	return func(factory *BuiltinViolationFactory) {
+		factory.formatter.isEnabled = true
+	}
+}
+
+// FormatDateTime option sets the style used to format date and time values of template parameters.
+// By default, the layouts of the constraints are used (see [DateTimeStyleLayout]).
+// This option also enables formatting of numeric and duration values (see [FormatParameters]).
+func FormatDateTime(style DateTimeStyle) ViolationFactoryOption {
+	return func(factory *BuiltinViolationFactory) {
+		factory.formatter.isEnabled = true
+		factory.formatter.dateTimeStyle = style
+	}
+}
+
+// UseMessageFormat option enables rendering of message templates by the ICU MessageFormat engine.
+// Template parameters are passed into the engine as arguments named by the parameter keys without
+// curly braces (e.g. "{{ limit }}" is available as "limit"). Typed values (see [TemplateParameter.RawValue])
+// are passed as is, so they can be used for plural selection and formatting:
+//
+//	"{count, plural, one {# element is} other {# elements are}} selected, but only {limit, number} allowed."
+//
+// Successfully parsed templates are cached (see [messageformat.Cache] for the limit of the cache).
+// Placeholders in double curly braces are rendered as usual.
+// If the template cannot be parsed, then it is rendered as a plain template.
+// See [github.com/muonsoft/validation/message/messageformat] package for the syntax.
+func UseMessageFormat() ViolationFactoryOption {
+	return func(factory *BuiltinViolationFactory) {
+		factory.messageFormat = &messageformat.Cache{}
+	}
 }
 
 // NewViolationFactory creates a new [BuiltinViolationFactory] for creating a violations.
-func NewViolationFactory(translator Translator) *BuiltinViolationFactory {
-	return &BuiltinViolationFactory{translator: translator}
+func NewViolationFactory(translator Translator, options ...ViolationFactoryOption) *BuiltinViolationFactory {
+	factory := &BuiltinViolationFactory{translator: translator}
+	for _, setOption := range options {
+		setOption(factory)
+	}
+
+	return factory
+}
+
+// WithTranslator returns a copy of the factory that translates messages by the translator.
+// If the translator is able to report that the message has no translation (like
+// [github.com/muonsoft/validation/message/translations.Overlay]), then such messages are translated
+// by the translator of the factory. Otherwise, the translator replaces the translator of the factory.
+func (factory *BuiltinViolationFactory) WithTranslator(translator Translator) *BuiltinViolationFactory {
+	return &BuiltinViolationFactory{
+		translator:    &layeredTranslator{overlay: translator, base: factory.translator},
+		formatter:     factory.formatter,
+		messageFormat: factory.messageFormat,
+	}
 }
 
-// CreateViolation creates a new instance of [Violation].
+// CreateViolation creates a new instance of [Violation]. The message of the violation is translated
+// and rendered on the first access, so it is not rendered at all if it is never read.
+// It can also be rendered in another language by [Violation.MessageIn] or [ViolationList.Translate].
 func (factory *BuiltinViolationFactory) CreateViolation(
 	err error,
 	messageTemplate string,
 	pluralCount int,
 	parameters []TemplateParameter,
+	invalidValue any,
 	propertyPath *PropertyPath,
 	lang language.Tag,
 ) Violation {
-	message := factory.translator.Translate(lang, messageTemplate, pluralCount)
+	return &internalViolation{
+		err:             err,
+		messageTemplate: messageTemplate,
+		pluralCount:     pluralCount,
+		parameters:      parameters,
+		invalidValue:    invalidValue,
+		propertyPath:    propertyPath,
+		language:        lang,
+		factory:         factory,
+	}
+}
+
+// render translates the message template and injects the parameters. Parameters are copied,
+// so the source values remain available for rendering in other languages. If the translated message
+// references the "{{ label }}" or "{{ property }}" parameters that are not set, they are added from the path.
+func (factory *BuiltinViolationFactory) render(
+	lang language.Tag,
+	messageTemplate string,
+	pluralCount int,
+	sourceParameters []TemplateParameter,
+	path *PropertyPath,
+) renderedMessage {
+	formatLanguage := lang
+	if formatLanguage == language.Und {
+		if t, ok := factory.translator.(interface{ DefaultLanguage() language.Tag }); ok {
+			formatLanguage = t.DefaultLanguage()
+		}
+	}
+	message, messageLanguage := translateWithLanguage(factory.translator, lang, messageTemplate, pluralCount)
+	if messageLanguage == language.Und {
+		messageLanguage = formatLanguage
+	}
 
+	var parameters []TemplateParameter
+	if sourceParameters != nil {
+		parameters = make([]TemplateParameter, len(sourceParameters), len(sourceParameters)+2)
+		copy(parameters, sourceParameters)
+	}
+	parameters = pathParameters(message, path, parameters)
 	for i := range parameters {
 		if parameters[i].NeedsTranslation {
 			parameters[i].Value = factory.translator.Translate(lang, parameters[i].Value, 0)
+		} else if parameters[i].RawValue != nil {
+			if value, ok := factory.formatter.format(formatLanguage, parameters[i].RawValue); ok {
+				parameters[i].Value = value
+			}
 		}
 	}
+	message = unlabelledMessage(message, parameters)
+	if factory.messageFormat != nil {
+		message = factory.formatMessage(messageLanguage, message, parameters)
+	}
 
-	return &internalViolation{
-		err:             err,
-		message:         renderMessage(message, parameters),
-		messageTemplate: messageTemplate,
-		parameters:      parameters,
-		propertyPath:    propertyPath,
+	return renderedMessage{
+		message:    renderMessage(message, parameters),
+		parameters: parameters,
+		language:   messageLanguage,
+	}
+}
+
+func (factory *BuiltinViolationFactory) formatMessage(
+	lang language.Tag,
+	message string,
+	parameters []TemplateParameter,
+) string {
+	m, err := factory.messageFormat.Compile(message)
+	if err != nil {
+		return message
+	}
+
+	arguments := make(map[string]any, len(parameters))
+	for _, parameter := range parameters {
+		if parameter.RawValue != nil {
+			arguments[parameterName(parameter.Key)] = parameter.RawValue
+		} else {
+			arguments[parameterName(parameter.Key)] = parameter.Value
+		}
 	}
+
+	return m.Format(lang, arguments)
 }
 
 // ViolationBuilder used to build an instance of a [Violation].
 type ViolationBuilder struct {
-	err             error
-	messageTemplate string
-	pluralCount     int
-	parameters      []TemplateParameter
-	propertyPath    *PropertyPath
-	language        language.Tag
+	err               error
+	messageTemplate   string
+	isExplicitMessage bool
+	pluralCount       int
+	parameters        []TemplateParameter
+	invalidValue      any
+	propertyPath      *PropertyPath
+	language          language.Tag
+	redaction         redaction
+	overrides         *overrides
+	labels            labels
 
 	violationFactory ViolationFactory
 }
@@ -529,6 +1067,14 @@ func (b *ViolationBuilder) WithParameter(name, value string) *ViolationBuilder {
 	return b
 }
 
+// WithInvalidValue sets the value that caused the violation.
+// See [Violation.InvalidValue] for details.
+func (b *ViolationBuilder) WithInvalidValue(value any) *ViolationBuilder {
+	b.invalidValue = value
+
+	return b
+}
+
 // At appends a property path of violated attribute.
 func (b *ViolationBuilder) At(path ...PropertyPathElement) *ViolationBuilder {
 	b.propertyPath = b.propertyPath.With(path...)
@@ -564,14 +1110,28 @@ func (b *ViolationBuilder) WithLanguage(tag language.Tag) *ViolationBuilder {
 	return b
 }
 
+// WithExplicitMessage marks the message template as explicitly set by the user (e.g. by the "WithMessage"
+// method of the constraint). The explicit message is never replaced by the [OverrideMessage] and [MapError]
+// options, even if it is equal to the default message of the error.
+func (b *ViolationBuilder) WithExplicitMessage(isExplicit bool) *ViolationBuilder {
+	b.isExplicitMessage = isExplicit
+
+	return b
+}
+
 // Create creates a new violation with given parameters and returns it.
 // Violation is created by calling the [ViolationFactory.CreateViolation].
 func (b *ViolationBuilder) Create() Violation {
+	err, template := b.overrides.apply(b.err, b.messageTemplate, b.isExplicitMessage)
+	parameters := b.labels.labelParameters(b.propertyPath, b.parameters)
+	parameters, invalidValue := b.redaction.apply(b.propertyPath, parameters, b.invalidValue)
+
 	return b.violationFactory.CreateViolation(
-		b.err,
-		b.messageTemplate,
+		err,
+		template,
 		b.pluralCount,
-		b.parameters,
+		parameters,
+		invalidValue,
 		b.propertyPath,
 		b.language,
 	)
@@ -584,6 +1144,9 @@ type ViolationListBuilder struct {
 
 	propertyPath *PropertyPath
 	language     language.Tag
+	redaction    redaction
+	overrides    *overrides
+	labels       labels
 }
 
 // ViolationListElementBuilder is used to build [Violation] that will be added into [ViolationList]
@@ -591,11 +1154,13 @@ type ViolationListBuilder struct {
 type ViolationListElementBuilder struct {
 	listBuilder *ViolationListBuilder
 
-	err             error
-	messageTemplate string
-	pluralCount     int
-	parameters      []TemplateParameter
-	propertyPath    *PropertyPath
+	err               error
+	messageTemplate   string
+	isExplicitMessage bool
+	pluralCount       int
+	parameters        []TemplateParameter
+	invalidValue      any
+	propertyPath      *PropertyPath
 }
 
 // NewViolationListBuilder creates a new [ViolationListBuilder].
@@ -616,7 +1181,7 @@ func (b *ViolationListBuilder) BuildViolation(err error, message string) *Violat
 // AddViolation can be used to quickly add a new violation using only code, message
 // and optional property path elements.
 func (b *ViolationListBuilder) AddViolation(err error, message string, path ...PropertyPathElement) *ViolationListBuilder {
-	return b.add(err, message, 0, nil, b.propertyPath.With(path...))
+	return b.add(err, message, false, 0, nil, nil, b.propertyPath.With(path...))
 }
 
 // SetPropertyPath resets a base property path of violated attributes.
@@ -655,15 +1220,21 @@ func (b *ViolationListBuilder) Create() *ViolationList {
 func (b *ViolationListBuilder) add(
 	err error,
 	template string,
+	isExplicitMessage bool,
 	count int,
 	parameters []TemplateParameter,
+	invalidValue any,
 	path *PropertyPath,
 ) *ViolationListBuilder {
+	err, template = b.overrides.apply(err, template, isExplicitMessage)
+	parameters = b.labels.labelParameters(path, parameters)
+	parameters, invalidValue = b.redaction.apply(path, parameters, invalidValue)
 	b.violations.Append(b.violationFactory.CreateViolation(
 		err,
 		template,
 		count,
 		parameters,
+		invalidValue,
 		path,
 		b.language,
 	))
@@ -692,6 +1263,14 @@ func (b *ViolationListElementBuilder) WithParameter(name, value string) *Violati
 	return b
 }
 
+// WithInvalidValue sets the value that caused the violation.
+// See [Violation.InvalidValue] for details.
+func (b *ViolationListElementBuilder) WithInvalidValue(value any) *ViolationListElementBuilder {
+	b.invalidValue = value
+
+	return b
+}
+
 // At appends a property path of violated attribute.
 func (b *ViolationListElementBuilder) At(path ...PropertyPathElement) *ViolationListElementBuilder {
 	b.propertyPath = b.propertyPath.With(path...)
@@ -720,10 +1299,40 @@ func (b *ViolationListElementBuilder) WithPluralCount(pluralCount int) *Violatio
 	return b
 }
 
+// WithExplicitMessage marks the message template as explicitly set by the user.
+// See [ViolationBuilder.WithExplicitMessage] for details.
+func (b *ViolationListElementBuilder) WithExplicitMessage(isExplicit bool) *ViolationListElementBuilder {
+	b.isExplicitMessage = isExplicit
+
+	return b
+}
+
 // Add creates a [Violation] and appends it into the end of the [ViolationList].
 // It returns a [ViolationListBuilder] to continue process of creating a [ViolationList].
 func (b *ViolationListElementBuilder) Add() *ViolationListBuilder {
-	return b.listBuilder.add(b.err, b.messageTemplate, b.pluralCount, b.parameters, b.propertyPath)
+	return b.listBuilder.add(
+		b.err,
+		b.messageTemplate,
+		b.isExplicitMessage,
+		b.pluralCount,
+		b.parameters,
+		b.invalidValue,
+		b.propertyPath,
+	)
+}
+
+// isSameError compares the errors without panicking on the errors that are not comparable.
+// The comparability is checked for the values, because a comparable struct with an interface field
+// holding a slice or a map panics on comparison.
+func isSameError(a, b error) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if !reflect.ValueOf(a).Comparable() || !reflect.ValueOf(b).Comparable() {
+		return false
+	}
+
+	return a == b
 }
 
 func unwrapViolationList(err error) (*ViolationList, error) {
@@ -85,6 +85,138 @@ func TestViolationList_ForEach_WhenErrorReturned_ExpectLoopBreak(t *testing.T) {
 	assert.Equal(t, []string{"first"}, iterated)
 }
 
+func TestViolationList_All_WhenMultipleViolations_ExpectAllIterated(t *testing.T) {
+	violations := newViolationList(t, errors.New("first"), errors.New("second"), errors.New("third"))
+	iterated := make([]string, 0)
+	indices := make([]int, 0)
+
+	for i, violation := range violations.All() {
+		iterated = append(iterated, violation.Unwrap().Error())
+		indices = append(indices, i)
+	}
+
+	assert.Equal(t, []string{"first", "second", "third"}, iterated)
+	assert.Equal(t, []int{0, 1, 2}, indices)
+}
+
+func TestViolationList_All_WhenBreak_ExpectLoopBreak(t *testing.T) {
+	violations := newViolationList(t, errors.New("first"), errors.New("second"))
+	iterated := make([]string, 0)
+
+	for _, violation := range violations.All() {
+		iterated = append(iterated, violation.Unwrap().Error())
+		break
+	}
+
+	assert.Equal(t, []string{"first"}, iterated)
+}
+
+func TestViolationList_Values_WhenNil_ExpectNoIterations(t *testing.T) {
+	var violations *validation.ViolationList
+	count := 0
+
+	for range violations.Values() {
+		count++
+	}
+
+	assert.Equal(t, 0, count)
+}
+
+func TestViolationList_Unwrap(t *testing.T) {
+	errA := errors.New("alpha")
+	errB := errors.New("beta")
+	violations := newViolationList(t, errA, errB)
+
+	errs := violations.Unwrap()
+
+	if assert.Len(t, errs, 2) {
+		assert.ErrorIs(t, errs[0], errA)
+		assert.ErrorIs(t, errs[1], errB)
+	}
+	assert.Nil(t, (*validation.ViolationList)(nil).Unwrap())
+}
+
+func TestViolationList_WhenJoinedByErrorsJoin_ExpectViolationsVisible(t *testing.T) {
+	errA := errors.New("alpha")
+	errB := errors.New("beta")
+	joined := errors.Join(newViolationList(t, errA), fmt.Errorf("wrapped: %w", newViolationList(t, errB)))
+
+	var violation validation.Violation
+	assert.ErrorIs(t, joined, errA)
+	assert.ErrorIs(t, joined, errB)
+	if assert.ErrorAs(t, joined, &violation) {
+		assert.Equal(t, errA, violation.Unwrap())
+	}
+}
+
+func TestViolationList_AppendFromError_WhenJoinedViolationLists_ExpectAllAppended(t *testing.T) {
+	errA := errors.New("alpha")
+	errB := errors.New("beta")
+	errC := errors.New("gamma")
+	joined := errors.Join(
+		newViolationList(t, errA, errB),
+		fmt.Errorf("wrapped: %w", errors.Join(newViolationWithError(t, errC))),
+	)
+	violations := validation.NewViolationList()
+
+	err := violations.AppendFromError(joined)
+
+	assert.NoError(t, err)
+	if assert.Equal(t, 3, violations.Len()) {
+		slice := violations.AsSlice()
+		assert.Equal(t, errA, slice[0].Unwrap())
+		assert.Equal(t, errB, slice[1].Unwrap())
+		assert.Equal(t, errC, slice[2].Unwrap())
+	}
+}
+
+func TestViolationList_AppendFromError_WhenJoinedViolationLists_ExpectSourceListsUnchanged(t *testing.T) {
+	errA := errors.New("alpha")
+	errB := errors.New("beta")
+	a := newViolationList(t, errA)
+	b := newViolationList(t, errB)
+	violations := validation.NewViolationList()
+
+	err := violations.AppendFromError(errors.Join(a, b))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, violations.Len())
+	if assert.Len(t, a.AsSlice(), 1) {
+		assert.Equal(t, errA, a.AsSlice()[0].Unwrap())
+	}
+	if assert.Len(t, b.AsSlice(), 1) {
+		assert.Equal(t, errB, b.AsSlice()[0].Unwrap())
+	}
+	count := 0
+	for range a.All() {
+		count++
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestViolationList_AppendFromError_WhenJoinedWithFatalError_ExpectFatalError(t *testing.T) {
+	fatal := errors.New("fatal")
+	joined := errors.Join(newViolationList(t, ErrTest), fatal)
+
+	err := validation.NewViolationList().AppendFromError(joined)
+
+	assert.Same(t, fatal, err)
+}
+
+func TestFilter_WhenJoinedViolationLists_ExpectSingleViolationList(t *testing.T) {
+	errA := errors.New("alpha")
+	errB := errors.New("beta")
+
+	err := validation.Filter(errors.Join(newViolationList(t, errA), newViolationList(t, errB)), nil)
+
+	violations, ok := validation.UnwrapViolationList(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, 2, violations.Len())
+		assert.True(t, violations.Is(errA))
+		assert.True(t, violations.Is(errB))
+	}
+}
+
 func TestViolationList_Join(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -249,6 +381,232 @@ func TestViolationList_Filter_ViolationsWithCodes_FilteredList(t *testing.T) {
 	}
 }
 
+func TestViolationList_Filter_WhenErrorsJoined_ExpectJoinedErrorsFiltered(t *testing.T) {
+	errA := errors.New("alpha")
+	errB := errors.New("beta")
+	errC := errors.New("gamma")
+	violations := newViolationList(t, errA, errB, errC)
+
+	filtered := violations.Filter(errors.Join(errA, errC), errA).AsSlice()
+
+	if assert.Len(t, filtered, 2) {
+		assert.Equal(t, errA, filtered[0].Unwrap())
+		assert.Equal(t, errC, filtered[1].Unwrap())
+	}
+}
+
+func TestViolationList_Remove_WhenErrorsJoined_ExpectJoinedErrorsRemoved(t *testing.T) {
+	errA := errors.New("alpha")
+	errB := errors.New("beta")
+	errC := errors.New("gamma")
+	violations := newViolationList(t, errA, errB, errC)
+
+	removed := violations.Remove(errors.Join(errA, errC)).AsSlice()
+
+	if assert.Len(t, removed, 1) {
+		assert.Equal(t, errB, removed[0].Unwrap())
+	}
+}
+
+func TestViolationList_FilterByPath(t *testing.T) {
+	validator := newValidator(t)
+	ctx := context.Background()
+	violations := validation.NewViolationList(
+		validator.CreateViolation(ctx, ErrTest, "first", validation.PropertyName("items"), validation.ArrayIndex(0), validation.PropertyName("price")),
+		validator.CreateViolation(ctx, ErrTest, "second", validation.PropertyName("items"), validation.ArrayIndex(0), validation.PropertyName("name")),
+		validator.CreateViolation(ctx, ErrTest, "third", validation.PropertyName("items"), validation.ArrayIndex(1), validation.PropertyName("price")),
+		validator.CreateViolation(ctx, ErrTest, "fourth"),
+	)
+
+	filtered := violations.FilterByPath(validation.MustCompilePropertyPathPattern("items[*].price"))
+
+	validationtest.Assert(t, filtered).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: ErrTest, Message: "first", PropertyPath: "items[0].price"},
+		validationtest.ViolationAttributes{Error: ErrTest, Message: "third", PropertyPath: "items[1].price"},
+	)
+}
+
+func TestViolationList_FilterByPath_WhenNil_ExpectEmptyList(t *testing.T) {
+	var violations *validation.ViolationList
+
+	filtered := violations.FilterByPath(validation.MustCompilePropertyPathPattern("**"))
+
+	assert.Equal(t, 0, filtered.Len())
+}
+
+func TestViolationList_FilterFunc(t *testing.T) {
+	errA := errors.New("alpha")
+	errB := errors.New("beta")
+	violations := newViolationList(t, errA, errB, errA)
+
+	filtered := violations.FilterFunc(func(violation validation.Violation) bool {
+		return violation.Is(errA)
+	})
+
+	assert.Equal(t, 2, filtered.Len())
+	assert.False(t, filtered.Is(errB))
+}
+
+func TestViolationList_Remove(t *testing.T) {
+	errA := errors.New("alpha")
+	errB := errors.New("beta")
+	errC := errors.New("gamma")
+	violations := newViolationList(t, errA, errB, errC)
+
+	removed := violations.Remove(errA, errC).AsSlice()
+
+	if assert.Len(t, removed, 1) {
+		assert.Equal(t, errB, removed[0].Unwrap())
+	}
+}
+
+func TestViolationList_Map(t *testing.T) {
+	validator := newValidator(t)
+	ctx := context.Background()
+	violations := validation.NewViolationList(
+		validator.CreateViolation(ctx, ErrTest, "first", validation.PropertyName("body"), validation.PropertyName("name")),
+		validator.CreateViolation(ctx, ErrTest, "second", validation.PropertyName("query")),
+	)
+	body := validation.NewPropertyPath(validation.PropertyName("body"))
+
+	mapped := violations.Map(func(violation validation.Violation) validation.Violation {
+		if !violation.PropertyPath().HasPrefix(body) {
+			return nil
+		}
+		return validator.BuildViolation(ctx, violation.Unwrap(), violation.MessageTemplate()).
+			SetPropertyPath(violation.PropertyPath().TrimPrefix(body)).
+			Create()
+	})
+
+	validationtest.Assert(t, mapped).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: ErrTest, Message: "first", PropertyPath: "name"},
+	)
+}
+
+func TestViolationList_FirstBy_LastBy(t *testing.T) {
+	validator := newValidator(t)
+	ctx := context.Background()
+	violations := validation.NewViolationList(
+		validator.CreateViolation(ctx, ErrTest, "first"),
+		validator.CreateViolation(ctx, ErrTest, "second"),
+		validator.CreateViolation(ctx, errors.New("other"), "third"),
+	)
+	isTest := func(violation validation.Violation) bool { return violation.Is(ErrTest) }
+	isMissing := func(violation validation.Violation) bool { return false }
+
+	first, firstFound := violations.FirstBy(isTest)
+	last, lastFound := violations.LastBy(isTest)
+	missing, missingFound := violations.FirstBy(isMissing)
+	_, nilFound := (*validation.ViolationList)(nil).LastBy(isTest)
+
+	if assert.True(t, firstFound) {
+		assert.Equal(t, "first", first.Message())
+	}
+	if assert.True(t, lastFound) {
+		assert.Equal(t, "second", last.Message())
+	}
+	assert.False(t, missingFound)
+	assert.Nil(t, missing)
+	assert.False(t, nilFound)
+}
+
+func TestViolationList_GroupByPath_ToFieldMessages(t *testing.T) {
+	validator := newValidator(t)
+	ctx := context.Background()
+	violations := validation.NewViolationList(
+		validator.CreateViolation(ctx, ErrTest, "first", validation.PropertyName("email")),
+		validator.CreateViolation(ctx, ErrTest, "second", validation.PropertyName("password")),
+		validator.CreateViolation(ctx, ErrTest, "third", validation.PropertyName("email")),
+		validator.CreateViolation(ctx, ErrTest, "fourth"),
+	)
+
+	groups := violations.GroupByPath()
+	messages := violations.ToFieldMessages()
+
+	assert.Len(t, groups, 3)
+	assert.Len(t, groups["email"], 2)
+	assert.Len(t, groups["password"], 1)
+	assert.Len(t, groups[""], 1)
+	assert.Equal(t, map[string][]string{
+		"email":    {"first", "third"},
+		"password": {"second"},
+		"":         {"fourth"},
+	}, messages)
+	assert.Empty(t, (*validation.ViolationList)(nil).ToFieldMessages())
+}
+
+func TestViolationList_SortByPath(t *testing.T) {
+	validator := newValidator(t)
+	ctx := context.Background()
+	violations := validation.NewViolationList(
+		validator.CreateViolation(ctx, ErrTest, "1", validation.PropertyName("items"), validation.ArrayIndex(10)),
+		validator.CreateViolation(ctx, ErrTest, "2", validation.PropertyName("b")),
+		validator.CreateViolation(ctx, ErrTest, "3", validation.PropertyName("items"), validation.ArrayIndex(2)),
+		validator.CreateViolation(ctx, ErrTest, "4"),
+		validator.CreateViolation(ctx, ErrTest, "5", validation.PropertyName("a")),
+		validator.CreateViolation(ctx, ErrTest, "6", validation.PropertyName("b")),
+		validator.CreateViolation(ctx, ErrTest, "7", validation.PropertyName("items")),
+	)
+
+	sorted := violations.SortByPath()
+
+	messages := make([]string, 0, sorted.Len())
+	for _, violation := range sorted.AsSlice() {
+		messages = append(messages, violation.Message())
+	}
+	assert.Equal(t, []string{"4", "5", "2", "6", "7", "3", "1"}, messages)
+	assert.Equal(t, 7, violations.Len())
+}
+
+func TestViolationList_Deduplicate(t *testing.T) {
+	validator := newValidator(t)
+	ctx := context.Background()
+	otherErr := errors.New("other")
+	violations := validation.NewViolationList(
+		validator.CreateViolation(ctx, ErrTest, "message", validation.PropertyName("a")),
+		validator.CreateViolation(ctx, ErrTest, "message", validation.PropertyName("a")),
+		validator.CreateViolation(ctx, otherErr, "message", validation.PropertyName("a")),
+		validator.CreateViolation(ctx, ErrTest, "other message", validation.PropertyName("a")),
+		validator.CreateViolation(ctx, ErrTest, "message", validation.PropertyName("b")),
+		validator.CreateViolation(ctx, ErrTest, "message", validation.PropertyName("b")),
+	)
+
+	deduplicated := violations.Deduplicate()
+
+	validationtest.Assert(t, deduplicated).IsViolationList().WithAttributes(
+		validationtest.ViolationAttributes{Error: ErrTest, Message: "message", PropertyPath: "a"},
+		validationtest.ViolationAttributes{Error: otherErr, Message: "message", PropertyPath: "a"},
+		validationtest.ViolationAttributes{Error: ErrTest, Message: "other message", PropertyPath: "a"},
+		validationtest.ViolationAttributes{Error: ErrTest, Message: "message", PropertyPath: "b"},
+	)
+}
+
+func TestViolationList_Deduplicate_WhenErrorHasUncomparableField_ExpectNoPanic(t *testing.T) {
+	validator := newValidator(t)
+	ctx := context.Background()
+	err := detailedError{details: []string{"detail"}}
+	violations := validation.NewViolationList(
+		validator.CreateViolation(ctx, err, "message", validation.PropertyName("a")),
+		validator.CreateViolation(ctx, err, "message", validation.PropertyName("a")),
+	)
+
+	var deduplicated *validation.ViolationList
+	assert.NotPanics(t, func() {
+		deduplicated = violations.Deduplicate()
+	})
+
+	assert.Equal(t, 2, deduplicated.Len())
+}
+
+// detailedError is a comparable struct type, but its values holding a slice panic on comparison.
+type detailedError struct {
+	details any
+}
+
+func (err detailedError) Error() string {
+	return "detailed error"
+}
+
 func TestViolation_Error_MessageAndPropertyPath_ErrorWithPropertyPathAndMessage(t *testing.T) {
 	validator := newValidator(t)
 	violation := validator.BuildViolation(context.Background(), ErrTest, "message").
@@ -392,6 +750,22 @@ func TestIsViolation_Violation_True(t *testing.T) {
 	assert.True(t, is)
 }
 
+func TestIsViolation_ViolationList_False(t *testing.T) {
+	err := fmt.Errorf("%w", validation.NewViolationList(newViolationWithError(t, ErrTest)))
+
+	is := validation.IsViolation(err)
+
+	assert.False(t, is)
+}
+
+func TestIsViolation_JoinedViolation_True(t *testing.T) {
+	err := errors.Join(validation.NewViolationList(), newViolationWithError(t, ErrTest))
+
+	is := validation.IsViolation(err)
+
+	assert.True(t, is)
+}
+
 func TestIsViolationList_CustomError_False(t *testing.T) {
 	err := errors.New("error")
 
@@ -418,6 +792,15 @@ func TestUnwrapViolation_WrappedViolation_UnwrappedViolation(t *testing.T) {
 	assert.Equal(t, wrapped, unwrapped)
 }
 
+func TestUnwrapViolation_ViolationList_NoViolationAndFalse(t *testing.T) {
+	err := validation.NewViolationList(newViolationWithError(t, ErrTest))
+
+	unwrapped, ok := validation.UnwrapViolation(err)
+
+	assert.Nil(t, unwrapped)
+	assert.False(t, ok)
+}
+
 func TestUnwrapViolationList_WrappedViolationList_UnwrappedViolationList(t *testing.T) {
 	wrapped := validation.NewViolationList(newViolationWithError(t, ErrTest))
 	err := fmt.Errorf("error: %w", wrapped)